@@ -4,11 +4,19 @@ import (
 	"context"
 	"encoding/json"
 	"net/http"
+	"time"
 )
 
 // GetAccountService get account info
 type GetAccountService struct {
-	c *Client
+	c                *Client
+	omitZeroBalances *bool
+}
+
+// OmitZeroBalances set omitZeroBalances, when true only non-zero balances are returned
+func (s *GetAccountService) OmitZeroBalances(omitZeroBalances bool) *GetAccountService {
+	s.omitZeroBalances = &omitZeroBalances
+	return s
 }
 
 // Do send request
@@ -18,6 +26,9 @@ func (s *GetAccountService) Do(ctx context.Context, opts ...RequestOption) (res
 		endpoint: "/api/v3/account",
 		secType:  secTypeSigned,
 	}
+	if s.omitZeroBalances != nil {
+		r.setParam("omitZeroBalances", *s.omitZeroBalances)
+	}
 	data, err := s.c.callAPI(ctx, r, opts...)
 	if err != nil {
 		return nil, err
@@ -32,17 +43,21 @@ func (s *GetAccountService) Do(ctx context.Context, opts ...RequestOption) (res
 
 // Account define account info
 type Account struct {
-	MakerCommission  int64     `json:"makerCommission"`
-	TakerCommission  int64     `json:"takerCommission"`
-	BuyerCommission  int64     `json:"buyerCommission"`
-	SellerCommission int64     `json:"sellerCommission"`
-	CanTrade         bool      `json:"canTrade"`
-	CanWithdraw      bool      `json:"canWithdraw"`
-	CanDeposit       bool      `json:"canDeposit"`
-	UpdateTime       uint64    `json:"updateTime"`
-	AccountType      string    `json:"accountType"`
-	Balances         []Balance `json:"balances"`
-	Permissions      []string  `json:"permissions"`
+	MakerCommission            int64           `json:"makerCommission"`
+	TakerCommission            int64           `json:"takerCommission"`
+	BuyerCommission            int64           `json:"buyerCommission"`
+	SellerCommission           int64           `json:"sellerCommission"`
+	CommissionRates            CommissionRates `json:"commissionRates"`
+	CanTrade                   bool            `json:"canTrade"`
+	CanWithdraw                bool            `json:"canWithdraw"`
+	CanDeposit                 bool            `json:"canDeposit"`
+	Brokered                   bool            `json:"brokered"`
+	RequireSelfTradePrevention bool            `json:"requireSelfTradePrevention"`
+	UpdateTime                 uint64          `json:"updateTime"`
+	AccountType                string          `json:"accountType"`
+	Balances                   []Balance       `json:"balances"`
+	Permissions                []string        `json:"permissions"`
+	UID                        int64           `json:"uid"`
 }
 
 // Balance define user balance of your account
@@ -73,12 +88,22 @@ func (s *GetAccountSnapshotService) StartTime(startTime int64) *GetAccountSnapsh
 	return s
 }
 
+// StartTimeFrom set startTime from t, converted to epoch milliseconds
+func (s *GetAccountSnapshotService) StartTimeFrom(t time.Time) *GetAccountSnapshotService {
+	return s.StartTime(FormatTimestamp(t))
+}
+
 // EndTime set endtime
 func (s *GetAccountSnapshotService) EndTime(endTime int64) *GetAccountSnapshotService {
 	s.endTime = &endTime
 	return s
 }
 
+// EndTimeFrom set endTime from t, converted to epoch milliseconds
+func (s *GetAccountSnapshotService) EndTimeFrom(t time.Time) *GetAccountSnapshotService {
+	return s.EndTime(FormatTimestamp(t))
+}
+
 // Limit set limit
 func (s *GetAccountSnapshotService) Limit(limit int) *GetAccountSnapshotService {
 	s.limit = &limit
@@ -213,3 +238,175 @@ type APIKeyPermission struct {
 	EnableSpotAndMarginTrading     bool   `json:"enableSpotAndMarginTrading"`
 	TradingAuthorityExpirationTime uint64 `json:"tradingAuthorityExpirationTime"`
 }
+
+// GetAccountStatusService get account status
+type GetAccountStatusService struct {
+	c *Client
+}
+
+// Do send request
+func (s *GetAccountStatusService) Do(ctx context.Context, opts ...RequestOption) (res *AccountStatus, err error) {
+	r := &request{
+		method:   http.MethodGet,
+		endpoint: "/sapi/v1/account/status",
+		secType:  secTypeSigned,
+	}
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(AccountStatus)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// AccountStatus define account status, Data is "Normal" unless the account is restricted
+type AccountStatus struct {
+	Data string `json:"data"`
+}
+
+// GetAPITradingStatusService get API trading status, including the
+// indicators which caused automatic trading restrictions
+type GetAPITradingStatusService struct {
+	c *Client
+}
+
+// Do send request
+func (s *GetAPITradingStatusService) Do(ctx context.Context, opts ...RequestOption) (res *APITradingStatus, err error) {
+	r := &request{
+		method:   http.MethodGet,
+		endpoint: "/sapi/v1/account/apiTradingStatus",
+		secType:  secTypeSigned,
+	}
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(APITradingStatus)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// APITradingStatus define API trading status
+type APITradingStatus struct {
+	Data APITradingStatusData `json:"data"`
+}
+
+// APITradingStatusData define API trading status detail
+type APITradingStatusData struct {
+	IsLocked           bool                             `json:"isLocked"`
+	PlannedRecoverTime int64                            `json:"plannedRecoverTime"`
+	TriggerCondition   APITradingTriggerCondition       `json:"triggerCondition"`
+	Indicators         map[string][]APITradingIndicator `json:"indicators"`
+	UpdateTime         int64                            `json:"updateTime"`
+}
+
+// APITradingTriggerCondition define the thresholds which lock API trading
+type APITradingTriggerCondition struct {
+	GCR  int64 `json:"GCR"`
+	IFER int64 `json:"IFER"`
+	UFR  int64 `json:"UFR"`
+}
+
+// APITradingIndicator define an indicator of a symbol
+type APITradingIndicator struct {
+	Indicator    string  `json:"i"`
+	Count        int64   `json:"c"`
+	CurrentValue float64 `json:"v"`
+	TriggerValue float64 `json:"t"`
+}
+
+// GetOrderCountUsageService get the current order count usage for all intervals
+type GetOrderCountUsageService struct {
+	c *Client
+}
+
+// Do send request
+func (s *GetOrderCountUsageService) Do(ctx context.Context, opts ...RequestOption) (res []*OrderCountUsage, err error) {
+	r := &request{
+		method:   http.MethodGet,
+		endpoint: "/api/v3/rateLimit/order",
+		secType:  secTypeSigned,
+	}
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return []*OrderCountUsage{}, err
+	}
+	res = make([]*OrderCountUsage, 0)
+	err = json.Unmarshal(data, &res)
+	if err != nil {
+		return []*OrderCountUsage{}, err
+	}
+	return res, nil
+}
+
+// OrderCountUsage define order count usage of a rate limit interval
+type OrderCountUsage struct {
+	RateLimitType string `json:"rateLimitType"`
+	Interval      string `json:"interval"`
+	IntervalNum   int64  `json:"intervalNum"`
+	Limit         int64  `json:"limit"`
+	Count         int64  `json:"count"`
+}
+
+// GetAccountCommissionService get current account commission rates of a symbol
+type GetAccountCommissionService struct {
+	c      *Client
+	symbol string
+}
+
+// Symbol set symbol
+func (s *GetAccountCommissionService) Symbol(symbol string) *GetAccountCommissionService {
+	s.symbol = symbol
+	return s
+}
+
+// Do send request
+func (s *GetAccountCommissionService) Do(ctx context.Context, opts ...RequestOption) (res *AccountCommission, err error) {
+	r := &request{
+		method:   http.MethodGet,
+		endpoint: "/api/v3/account/commission",
+		secType:  secTypeSigned,
+	}
+	r.setParam("symbol", s.symbol)
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(AccountCommission)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// AccountCommission define account commission rates of a symbol
+type AccountCommission struct {
+	Symbol             string             `json:"symbol"`
+	StandardCommission CommissionRates    `json:"standardCommission"`
+	TaxCommission      CommissionRates    `json:"taxCommission"`
+	Discount           CommissionDiscount `json:"discount"`
+}
+
+// CommissionRates define commission rates
+type CommissionRates struct {
+	Maker  string `json:"maker"`
+	Taker  string `json:"taker"`
+	Buyer  string `json:"buyer"`
+	Seller string `json:"seller"`
+}
+
+// CommissionDiscount define commission discount when paying fees with the discount asset
+type CommissionDiscount struct {
+	EnabledForAccount bool   `json:"enabledForAccount"`
+	EnabledForSymbol  bool   `json:"enabledForSymbol"`
+	DiscountAsset     string `json:"discountAsset"`
+	Discount          string `json:"discount"`
+}
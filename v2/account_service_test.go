@@ -77,6 +77,75 @@ func (s *accountServiceTestSuite) TestGetAccount() {
 	s.assertAccountEqual(e, res)
 }
 
+func (s *accountServiceTestSuite) TestGetAccountOmitZeroBalances() {
+	data := []byte(`{
+		"makerCommission": 10,
+		"takerCommission": 10,
+		"buyerCommission": 0,
+		"sellerCommission": 0,
+		"commissionRates": {
+			"maker": "0.00100000",
+			"taker": "0.00100000",
+			"buyer": "0.00000000",
+			"seller": "0.00000000"
+		},
+		"canTrade": true,
+		"canWithdraw": false,
+		"canDeposit": true,
+		"brokered": false,
+		"requireSelfTradePrevention": true,
+		"updateTime": 123456789,
+		"accountType": "SPOT",
+		"balances": [
+			{
+				"asset": "BTC",
+				"free": "0.50000000",
+				"locked": "0.00000000"
+			}
+		],
+		"permissions": [
+			"SPOT",
+			"MARGIN"
+		],
+		"uid": 354937868
+	}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setParam("omitZeroBalances", true)
+		s.assertRequestEqual(e, r)
+	})
+
+	res, err := s.client.NewGetAccountService().OmitZeroBalances(true).Do(newContext())
+	s.r().NoError(err)
+	e := &Account{
+		MakerCommission: 10,
+		TakerCommission: 10,
+		CommissionRates: CommissionRates{
+			Maker:  "0.00100000",
+			Taker:  "0.00100000",
+			Buyer:  "0.00000000",
+			Seller: "0.00000000",
+		},
+		CanTrade:                   true,
+		CanWithdraw:                false,
+		CanDeposit:                 true,
+		RequireSelfTradePrevention: true,
+		UpdateTime:                 123456789,
+		AccountType:                "SPOT",
+		Balances: []Balance{
+			{
+				Asset:  "BTC",
+				Free:   "0.50000000",
+				Locked: "0.00000000",
+			},
+		},
+		Permissions: []string{"SPOT", "MARGIN"},
+		UID:         354937868,
+	}
+	s.assertAccountEqual(e, res)
+}
+
 func (s *accountServiceTestSuite) assertAccountEqual(e, a *Account) {
 	r := s.r()
 	r.Equal(e.MakerCommission, a.MakerCommission, "MakerCommission")
@@ -86,6 +155,11 @@ func (s *accountServiceTestSuite) assertAccountEqual(e, a *Account) {
 	r.Equal(e.CanTrade, a.CanTrade, "CanTrade")
 	r.Equal(e.CanWithdraw, a.CanWithdraw, "CanWithdraw")
 	r.Equal(e.CanDeposit, a.CanDeposit, "CanDeposit")
+	r.Equal(e.CommissionRates, a.CommissionRates, "CommissionRates")
+	r.Equal(e.Brokered, a.Brokered, "Brokered")
+	r.Equal(e.RequireSelfTradePrevention, a.RequireSelfTradePrevention, "RequireSelfTradePrevention")
+	r.Equal(e.Permissions, a.Permissions, "Permissions")
+	r.Equal(e.UID, a.UID, "UID")
 	r.Len(a.Balances, len(e.Balances))
 	for i := 0; i < len(a.Balances); i++ {
 		r.Equal(e.Balances[i].Asset, a.Balances[i].Asset, "Asset")
@@ -228,3 +302,196 @@ func (s *accountServiceTestSuite) assertAPIKeyPermissionEqual(e, a *APIKeyPermis
 	r.Equal(e.EnableSpotAndMarginTrading, a.EnableSpotAndMarginTrading, "EnableSpotAndMarginTrading")
 	r.Equal(e.TradingAuthorityExpirationTime, a.TradingAuthorityExpirationTime, "TradingAuthorityExpirationTime")
 }
+
+func (s *accountServiceTestSuite) TestGetOrderCountUsage() {
+	data := []byte(`[
+		{
+			"rateLimitType": "ORDERS",
+			"interval": "SECOND",
+			"intervalNum": 10,
+			"limit": 50,
+			"count": 0
+		},
+		{
+			"rateLimitType": "ORDERS",
+			"interval": "DAY",
+			"intervalNum": 1,
+			"limit": 160000,
+			"count": 100
+		}
+	]`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+	s.assertReq(func(r *request) {
+		e := newSignedRequest()
+		s.assertRequestEqual(e, r)
+	})
+
+	res, err := s.client.NewGetOrderCountUsageService().Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	r.Len(res, 2)
+	e := []*OrderCountUsage{
+		{
+			RateLimitType: "ORDERS",
+			Interval:      "SECOND",
+			IntervalNum:   10,
+			Limit:         50,
+			Count:         0,
+		},
+		{
+			RateLimitType: "ORDERS",
+			Interval:      "DAY",
+			IntervalNum:   1,
+			Limit:         160000,
+			Count:         100,
+		},
+	}
+	for i := range e {
+		s.assertOrderCountUsageEqual(e[i], res[i])
+	}
+}
+
+func (s *accountServiceTestSuite) assertOrderCountUsageEqual(e, a *OrderCountUsage) {
+	r := s.r()
+	r.Equal(e.RateLimitType, a.RateLimitType, "RateLimitType")
+	r.Equal(e.Interval, a.Interval, "Interval")
+	r.Equal(e.IntervalNum, a.IntervalNum, "IntervalNum")
+	r.Equal(e.Limit, a.Limit, "Limit")
+	r.Equal(e.Count, a.Count, "Count")
+}
+
+func (s *accountServiceTestSuite) TestGetAccountCommission() {
+	data := []byte(`{
+		"symbol": "BTCUSDT",
+		"standardCommission": {
+			"maker": "0.00000010",
+			"taker": "0.00000020",
+			"buyer": "0.00000030",
+			"seller": "0.00000040"
+		},
+		"taxCommission": {
+			"maker": "0.00000112",
+			"taker": "0.00000114",
+			"buyer": "0.00000118",
+			"seller": "0.00000116"
+		},
+		"discount": {
+			"enabledForAccount": true,
+			"enabledForSymbol": true,
+			"discountAsset": "BNB",
+			"discount": "0.75000000"
+		}
+	}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	symbol := "BTCUSDT"
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setParam("symbol", symbol)
+		s.assertRequestEqual(e, r)
+	})
+
+	res, err := s.client.NewGetAccountCommissionService().Symbol(symbol).Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	e := &AccountCommission{
+		Symbol: "BTCUSDT",
+		StandardCommission: CommissionRates{
+			Maker:  "0.00000010",
+			Taker:  "0.00000020",
+			Buyer:  "0.00000030",
+			Seller: "0.00000040",
+		},
+		TaxCommission: CommissionRates{
+			Maker:  "0.00000112",
+			Taker:  "0.00000114",
+			Buyer:  "0.00000118",
+			Seller: "0.00000116",
+		},
+		Discount: CommissionDiscount{
+			EnabledForAccount: true,
+			EnabledForSymbol:  true,
+			DiscountAsset:     "BNB",
+			Discount:          "0.75000000",
+		},
+	}
+	r.Equal(e, res)
+}
+
+func (s *accountServiceTestSuite) TestGetAccountStatus() {
+	data := []byte(`{"data": "Normal"}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+	s.assertReq(func(r *request) {
+		e := newSignedRequest()
+		s.assertRequestEqual(e, r)
+	})
+
+	res, err := s.client.NewGetAccountStatusService().Do(newContext())
+	s.r().NoError(err)
+	s.r().Equal(&AccountStatus{Data: "Normal"}, res)
+}
+
+func (s *accountServiceTestSuite) TestGetAccountStatusRestricted() {
+	data := []byte(`{"data": "Trading restricted: account is under review"}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+	s.assertReq(func(r *request) {
+		e := newSignedRequest()
+		s.assertRequestEqual(e, r)
+	})
+
+	res, err := s.client.NewGetAccountStatusService().Do(newContext())
+	s.r().NoError(err)
+	s.r().Equal(&AccountStatus{Data: "Trading restricted: account is under review"}, res)
+}
+
+func (s *accountServiceTestSuite) TestGetAPITradingStatus() {
+	data := []byte(`{
+		"data": {
+			"isLocked": true,
+			"plannedRecoverTime": 1547630471725,
+			"triggerCondition": {
+				"GCR": 150,
+				"IFER": 150,
+				"UFR": 300
+			},
+			"indicators": {
+				"BTCUSDT": [
+					{"i": "UFR", "c": 20, "v": 0.95, "t": 0.99},
+					{"i": "IFER", "c": 20, "v": 0.99, "t": 0.99}
+				]
+			},
+			"updateTime": 1547630471725
+		}
+	}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+	s.assertReq(func(r *request) {
+		e := newSignedRequest()
+		s.assertRequestEqual(e, r)
+	})
+
+	res, err := s.client.NewGetAPITradingStatusService().Do(newContext())
+	s.r().NoError(err)
+	e := &APITradingStatus{
+		Data: APITradingStatusData{
+			IsLocked:           true,
+			PlannedRecoverTime: 1547630471725,
+			TriggerCondition: APITradingTriggerCondition{
+				GCR:  150,
+				IFER: 150,
+				UFR:  300,
+			},
+			Indicators: map[string][]APITradingIndicator{
+				"BTCUSDT": {
+					{Indicator: "UFR", Count: 20, CurrentValue: 0.95, TriggerValue: 0.99},
+					{Indicator: "IFER", Count: 20, CurrentValue: 0.99, TriggerValue: 0.99},
+				},
+			},
+			UpdateTime: 1547630471725,
+		},
+	}
+	s.r().Equal(e, res)
+}
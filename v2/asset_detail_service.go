@@ -3,7 +3,11 @@ package binance
 import (
 	"context"
 	"encoding/json"
+	"fmt"
+	"math/big"
 	"net/http"
+
+	"github.com/adshao/go-binance/v2/common"
 )
 
 // GetAssetDetailService fetches all asset detail.
@@ -66,6 +70,43 @@ func (s *GetAllCoinsInfoService) Do(ctx context.Context) (res []*CoinInfo, err e
 	return res, nil
 }
 
+// CheapestWithdrawNetwork return the network of coin with the lowest withdraw
+// fee among the networks which currently allow withdrawals.
+func (c *Client) CheapestWithdrawNetwork(ctx context.Context, coin string) (network string, fee string, err error) {
+	coins, err := c.NewGetAllCoinsInfoService().Do(ctx)
+	if err != nil {
+		return "", "", err
+	}
+	var info *CoinInfo
+	for _, ci := range coins {
+		if ci.Coin == coin {
+			info = ci
+			break
+		}
+	}
+	if info == nil {
+		return "", "", fmt.Errorf("coin %s not found", coin)
+	}
+	var cheapest *big.Rat
+	for _, n := range info.NetworkList {
+		if !n.WithdrawEnable {
+			continue
+		}
+		f, err := common.ParseDecimal(n.WithdrawFee)
+		if err != nil {
+			return "", "", err
+		}
+		if cheapest == nil || f.Cmp(cheapest) < 0 {
+			cheapest = f
+			network, fee = n.Network, n.WithdrawFee
+		}
+	}
+	if cheapest == nil {
+		return "", "", fmt.Errorf("no network of %s allows withdrawals", coin)
+	}
+	return network, fee, nil
+}
+
 // AssetDetail represents the detail of an asset
 type AssetDetail struct {
 	MinWithdrawAmount string `json:"minWithdrawAmount"`
@@ -120,3 +120,47 @@ func (s *assetDetailServiceTestSuite) TestGetAllCoinsInfo() {
 	s.r().Equal(res[0].NetworkList[0].WithdrawEnable, false, "withdrawEnable")
 	s.r().Equal(res[0].NetworkList[1].MinConfirm, 1, "minConfirm")
 }
+
+func (s *assetDetailServiceTestSuite) TestCheapestWithdrawNetwork() {
+	data := []byte(`[
+		{
+			"coin": "BTC",
+			"networkList": [
+				{"coin": "BTC", "network": "BTC", "withdrawEnable": true, "withdrawFee": "0.00050000"}
+			]
+		},
+		{
+			"coin": "USDT",
+			"networkList": [
+				{"coin": "USDT", "network": "ETH", "withdrawEnable": true, "withdrawFee": "4.5"},
+				{"coin": "USDT", "network": "TRX", "withdrawEnable": false, "withdrawFee": "0"},
+				{"coin": "USDT", "network": "BSC", "withdrawEnable": true, "withdrawFee": "0.29"},
+				{"coin": "USDT", "network": "MATIC", "withdrawEnable": true, "withdrawFee": "1"}
+			]
+		}
+	]`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	network, fee, err := s.client.CheapestWithdrawNetwork(newContext(), "USDT")
+	s.r().NoError(err)
+	s.r().Equal("BSC", network)
+	s.r().Equal("0.29", fee)
+}
+
+func (s *assetDetailServiceTestSuite) TestCheapestWithdrawNetworkNoneEnabled() {
+	data := []byte(`[
+		{
+			"coin": "USDT",
+			"networkList": [
+				{"coin": "USDT", "network": "ETH", "withdrawEnable": false, "withdrawFee": "4.5"},
+				{"coin": "USDT", "network": "TRX", "withdrawEnable": false, "withdrawFee": "1"}
+			]
+		}
+	]`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	_, _, err := s.client.CheapestWithdrawNetwork(newContext(), "USDT")
+	s.r().EqualError(err, "no network of USDT allows withdrawals")
+}
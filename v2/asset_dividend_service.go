@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"net/http"
+	"time"
 )
 
 // AssetDividendService fetches the saving purchases
@@ -34,6 +35,11 @@ func (s *AssetDividendService) StartTime(startTime int64) *AssetDividendService
 	return s
 }
 
+// StartTimeFrom set startTime from t, converted to epoch milliseconds
+func (s *AssetDividendService) StartTimeFrom(t time.Time) *AssetDividendService {
+	return s.StartTime(FormatTimestamp(t))
+}
+
 // EndTime sets the endTime parameter.
 // If present, StartTime MUST be specified. The difference between EndTime - StartTime MUST be between 0-90 days.
 func (s *AssetDividendService) EndTime(endTime int64) *AssetDividendService {
@@ -41,6 +47,11 @@ func (s *AssetDividendService) EndTime(endTime int64) *AssetDividendService {
 	return s
 }
 
+// EndTimeFrom set endTime from t, converted to epoch milliseconds
+func (s *AssetDividendService) EndTimeFrom(t time.Time) *AssetDividendService {
+	return s.EndTime(FormatTimestamp(t))
+}
+
 // Do sends the request.
 func (s *AssetDividendService) Do(ctx context.Context) (*DividendResponseWrapper, error) {
 	r := &request{
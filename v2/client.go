@@ -6,13 +6,18 @@ import (
 	"crypto/hmac"
 	"crypto/sha256"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io/ioutil"
 	"log"
+	"net"
 	"net/http"
 	"net/url"
 	"os"
 	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/adshao/go-binance/v2/common"
@@ -45,12 +50,31 @@ type SymbolStatusType string
 // SymbolFilterType define symbol filter type
 type SymbolFilterType string
 
+// ExchangeFilterType define exchange filter type
+type ExchangeFilterType string
+
+// SelfTradePreventionMode define how an order matching an order of the same
+// account is prevented
+type SelfTradePreventionMode string
+
+// TickerType define the ticker response type, full or mini
+type TickerType string
+
+// UserUniversalTransferType define the from and to accounts of a user universal transfer
+type UserUniversalTransferType string
+
 // UserDataEventType define spot user data event type
 type UserDataEventType string
 
 // MarginTransferType define margin transfer type
 type MarginTransferType int
 
+// MarginTransferHistoryType define type of margin transfer history records
+type MarginTransferHistoryType string
+
+// IsolatedMarginTransferAccountType define isolated margin transfer account type
+type IsolatedMarginTransferAccountType string
+
 // MarginLoanStatusType define margin loan status type
 type MarginLoanStatusType string
 
@@ -69,9 +93,21 @@ type FuturesTransferType int
 // TransactionType define transaction type
 type TransactionType string
 
+// WithdrawStatusType define withdraw status type
+type WithdrawStatusType int
+
 // LendingType define the type of lending (flexible saving, activity, ...)
 type LendingType string
 
+// ConvertExpiredType define how long a convert limit order stays open
+type ConvertExpiredType string
+
+// DualInvestmentOptionType define the option type of dual investment products
+type DualInvestmentOptionType string
+
+// DualInvestmentAutoCompoundPlan define the auto compound plan of dual investment
+type DualInvestmentAutoCompoundPlan string
+
 // Endpoints
 const (
 	baseAPIMainURL    = "https://api.binance.com"
@@ -102,7 +138,16 @@ const (
 	NewOrderRespTypeRESULT NewOrderRespType = "RESULT"
 	NewOrderRespTypeFULL   NewOrderRespType = "FULL"
 
+	TickerTypeFull TickerType = "FULL"
+	TickerTypeMini TickerType = "MINI"
+
+	SelfTradePreventionModeNone        SelfTradePreventionMode = "NONE"
+	SelfTradePreventionModeExpireTaker SelfTradePreventionMode = "EXPIRE_TAKER"
+	SelfTradePreventionModeExpireMaker SelfTradePreventionMode = "EXPIRE_MAKER"
+	SelfTradePreventionModeExpireBoth  SelfTradePreventionMode = "EXPIRE_BOTH"
+
 	OrderStatusTypeNew             OrderStatusType = "NEW"
+	OrderStatusTypePendingNew      OrderStatusType = "PENDING_NEW"
 	OrderStatusTypePartiallyFilled OrderStatusType = "PARTIALLY_FILLED"
 	OrderStatusTypeFilled          OrderStatusType = "FILLED"
 	OrderStatusTypeCanceled        OrderStatusType = "CANCELED"
@@ -124,18 +169,77 @@ const (
 	SymbolFilterTypePriceFilter      SymbolFilterType = "PRICE_FILTER"
 	SymbolFilterTypePercentPrice     SymbolFilterType = "PERCENT_PRICE"
 	SymbolFilterTypeMinNotional      SymbolFilterType = "MIN_NOTIONAL"
+	SymbolFilterTypeNotional         SymbolFilterType = "NOTIONAL"
 	SymbolFilterTypeIcebergParts     SymbolFilterType = "ICEBERG_PARTS"
 	SymbolFilterTypeMarketLotSize    SymbolFilterType = "MARKET_LOT_SIZE"
 	SymbolFilterTypeMaxNumAlgoOrders SymbolFilterType = "MAX_NUM_ALGO_ORDERS"
+	SymbolFilterTypeMaxNumOrders     SymbolFilterType = "MAX_NUM_ORDERS"
+
+	ExchangeFilterTypeExchangeMaxNumOrders ExchangeFilterType = "EXCHANGE_MAX_NUM_ORDERS"
 
 	UserDataEventTypeOutboundAccountPosition UserDataEventType = "outboundAccountPosition"
 	UserDataEventTypeBalanceUpdate           UserDataEventType = "balanceUpdate"
 	UserDataEventTypeExecutionReport         UserDataEventType = "executionReport"
-	UserDataEventTypeListStatus              UserDataEventType = "ListStatus"
+	UserDataEventTypeListStatus              UserDataEventType = "listStatus"
+	UserDataEventTypeListenKeyExpired        UserDataEventType = "listenKeyExpired"
 
 	MarginTransferTypeToMargin MarginTransferType = 1
 	MarginTransferTypeToMain   MarginTransferType = 2
 
+	MarginTransferHistoryTypeRollIn  MarginTransferHistoryType = "ROLL_IN"
+	MarginTransferHistoryTypeRollOut MarginTransferHistoryType = "ROLL_OUT"
+
+	IsolatedMarginTransferAccountTypeSpot           IsolatedMarginTransferAccountType = "SPOT"
+	IsolatedMarginTransferAccountTypeIsolatedMargin IsolatedMarginTransferAccountType = "ISOLATED_MARGIN"
+
+	UserUniversalTransferTypeMainUMFuture                 UserUniversalTransferType = "MAIN_UMFUTURE"
+	UserUniversalTransferTypeMainCMFuture                 UserUniversalTransferType = "MAIN_CMFUTURE"
+	UserUniversalTransferTypeMainMargin                   UserUniversalTransferType = "MAIN_MARGIN"
+	UserUniversalTransferTypeUMFutureMain                 UserUniversalTransferType = "UMFUTURE_MAIN"
+	UserUniversalTransferTypeUMFutureMargin               UserUniversalTransferType = "UMFUTURE_MARGIN"
+	UserUniversalTransferTypeCMFutureMain                 UserUniversalTransferType = "CMFUTURE_MAIN"
+	UserUniversalTransferTypeCMFutureMargin               UserUniversalTransferType = "CMFUTURE_MARGIN"
+	UserUniversalTransferTypeMarginMain                   UserUniversalTransferType = "MARGIN_MAIN"
+	UserUniversalTransferTypeMarginUMFuture               UserUniversalTransferType = "MARGIN_UMFUTURE"
+	UserUniversalTransferTypeMarginCMFuture               UserUniversalTransferType = "MARGIN_CMFUTURE"
+	UserUniversalTransferTypeIsolatedMarginMargin         UserUniversalTransferType = "ISOLATEDMARGIN_MARGIN"
+	UserUniversalTransferTypeMarginIsolatedMargin         UserUniversalTransferType = "MARGIN_ISOLATEDMARGIN"
+	UserUniversalTransferTypeIsolatedMarginIsolatedMargin UserUniversalTransferType = "ISOLATEDMARGIN_ISOLATEDMARGIN"
+	UserUniversalTransferTypeMainFunding                  UserUniversalTransferType = "MAIN_FUNDING"
+	UserUniversalTransferTypeFundingMain                  UserUniversalTransferType = "FUNDING_MAIN"
+	UserUniversalTransferTypeFundingUMFuture              UserUniversalTransferType = "FUNDING_UMFUTURE"
+	UserUniversalTransferTypeUMFutureFunding              UserUniversalTransferType = "UMFUTURE_FUNDING"
+	UserUniversalTransferTypeMarginFunding                UserUniversalTransferType = "MARGIN_FUNDING"
+	UserUniversalTransferTypeFundingMargin                UserUniversalTransferType = "FUNDING_MARGIN"
+	UserUniversalTransferTypeFundingCMFuture              UserUniversalTransferType = "FUNDING_CMFUTURE"
+	UserUniversalTransferTypeCMFutureFunding              UserUniversalTransferType = "CMFUTURE_FUNDING"
+	UserUniversalTransferTypeMainOption                   UserUniversalTransferType = "MAIN_OPTION"
+	UserUniversalTransferTypeOptionMain                   UserUniversalTransferType = "OPTION_MAIN"
+	UserUniversalTransferTypeUMFutureOption               UserUniversalTransferType = "UMFUTURE_OPTION"
+	UserUniversalTransferTypeOptionUMFuture               UserUniversalTransferType = "OPTION_UMFUTURE"
+	UserUniversalTransferTypeMarginOption                 UserUniversalTransferType = "MARGIN_OPTION"
+	UserUniversalTransferTypeOptionMargin                 UserUniversalTransferType = "OPTION_MARGIN"
+	UserUniversalTransferTypeFundingOption                UserUniversalTransferType = "FUNDING_OPTION"
+	UserUniversalTransferTypeOptionFunding                UserUniversalTransferType = "OPTION_FUNDING"
+	UserUniversalTransferTypeMainPortfolioMargin          UserUniversalTransferType = "MAIN_PORTFOLIO_MARGIN"
+	UserUniversalTransferTypePortfolioMarginMain          UserUniversalTransferType = "PORTFOLIO_MARGIN_MAIN"
+	UserUniversalTransferTypeMainC2C                      UserUniversalTransferType = "MAIN_C2C"
+	UserUniversalTransferTypeC2CMain                      UserUniversalTransferType = "C2C_MAIN"
+	UserUniversalTransferTypeC2CUMFuture                  UserUniversalTransferType = "C2C_UMFUTURE"
+	UserUniversalTransferTypeUMFutureC2C                  UserUniversalTransferType = "UMFUTURE_C2C"
+	UserUniversalTransferTypeC2CMargin                    UserUniversalTransferType = "C2C_MARGIN"
+	UserUniversalTransferTypeMarginC2C                    UserUniversalTransferType = "MARGIN_C2C"
+	UserUniversalTransferTypeC2CMining                    UserUniversalTransferType = "C2C_MINING"
+	UserUniversalTransferTypeMiningC2C                    UserUniversalTransferType = "MINING_C2C"
+	UserUniversalTransferTypeMainMining                   UserUniversalTransferType = "MAIN_MINING"
+	UserUniversalTransferTypeMiningMain                   UserUniversalTransferType = "MINING_MAIN"
+	UserUniversalTransferTypeMiningUMFuture               UserUniversalTransferType = "MINING_UMFUTURE"
+	UserUniversalTransferTypeUMFutureMining               UserUniversalTransferType = "UMFUTURE_MINING"
+	UserUniversalTransferTypeMarginMining                 UserUniversalTransferType = "MARGIN_MINING"
+	UserUniversalTransferTypeMiningMargin                 UserUniversalTransferType = "MINING_MARGIN"
+	UserUniversalTransferTypeMainPay                      UserUniversalTransferType = "MAIN_PAY"
+	UserUniversalTransferTypePayMain                      UserUniversalTransferType = "PAY_MAIN"
+
 	FuturesTransferTypeToFutures FuturesTransferType = 1
 	FuturesTransferTypeToMain    FuturesTransferType = 2
 
@@ -151,28 +255,45 @@ const (
 	FuturesTransferStatusTypeConfirmed FuturesTransferStatusType = "CONFIRMED"
 	FuturesTransferStatusTypeFailed    FuturesTransferStatusType = "FAILED"
 
-	SideEffectTypeNoSideEffect SideEffectType = "NO_SIDE_EFFECT"
-	SideEffectTypeMarginBuy    SideEffectType = "MARGIN_BUY"
-	SideEffectTypeAutoRepay    SideEffectType = "AUTO_REPAY"
+	SideEffectTypeNoSideEffect    SideEffectType = "NO_SIDE_EFFECT"
+	SideEffectTypeMarginBuy       SideEffectType = "MARGIN_BUY"
+	SideEffectTypeAutoRepay       SideEffectType = "AUTO_REPAY"
+	SideEffectTypeAutoBorrowRepay SideEffectType = "AUTO_BORROW_REPAY"
 
 	TransactionTypeDeposit  TransactionType = "0"
 	TransactionTypeWithdraw TransactionType = "1"
 	TransactionTypeBuy      TransactionType = "0"
 	TransactionTypeSell     TransactionType = "1"
 
+	WithdrawStatusTypeEmailSent        WithdrawStatusType = 0
+	WithdrawStatusTypeCancelled        WithdrawStatusType = 1
+	WithdrawStatusTypeAwaitingApproval WithdrawStatusType = 2
+	WithdrawStatusTypeRejected         WithdrawStatusType = 3
+	WithdrawStatusTypeProcessing       WithdrawStatusType = 4
+	WithdrawStatusTypeFailure          WithdrawStatusType = 5
+	WithdrawStatusTypeCompleted        WithdrawStatusType = 6
+
 	LendingTypeFlexible LendingType = "DAILY"
 	LendingTypeFixed    LendingType = "CUSTOMIZED_FIXED"
 	LendingTypeActivity LendingType = "ACTIVITY"
 
+	ConvertExpiredType1D  ConvertExpiredType = "1_D"
+	ConvertExpiredType3D  ConvertExpiredType = "3_D"
+	ConvertExpiredType7D  ConvertExpiredType = "7_D"
+	ConvertExpiredType30D ConvertExpiredType = "30_D"
+
+	DualInvestmentOptionTypeCall DualInvestmentOptionType = "CALL"
+	DualInvestmentOptionTypePut  DualInvestmentOptionType = "PUT"
+
+	DualInvestmentAutoCompoundPlanNone     DualInvestmentAutoCompoundPlan = "NONE"
+	DualInvestmentAutoCompoundPlanStandard DualInvestmentAutoCompoundPlan = "STANDARD"
+	DualInvestmentAutoCompoundPlanAdvanced DualInvestmentAutoCompoundPlan = "ADVANCED"
+
 	timestampKey  = "timestamp"
 	signatureKey  = "signature"
 	recvWindowKey = "recvWindow"
 )
 
-func currentTimestamp() int64 {
-	return FormatTimestamp(time.Now())
-}
-
 // FormatTimestamp formats a time into Unix timestamp in milliseconds, as requested by Binance.
 func FormatTimestamp(t time.Time) int64 {
 	return t.UnixNano() / int64(time.Millisecond)
@@ -231,7 +352,114 @@ type Client struct {
 	Logger     *log.Logger
 	TimeOffset int64
 	do         doFunc
+	nowFunc    func() time.Time
 	weight     int
+
+	signatureTTL   time.Duration
+	signatureCache SignatureCache
+	limiter        RequestLimiter
+
+	baseURLs     []string
+	baseURLIndex uint32
+
+	deprecationsLogged sync.Map
+	secretDigests      sync.Map
+
+	symbolInfoOnce sync.Once
+	symbolInfo     *SymbolInfoCache
+}
+
+// SetUserAgent set the User-Agent header sent with every request,
+// WithHeader can still override it per request
+func (c *Client) SetUserAgent(userAgent string) {
+	c.UserAgent = userAgent
+}
+
+// SetClock set the clock read for request timestamps, e.g. a fixed time in
+// tests. A nil now restores the system clock
+func (c *Client) SetClock(now func() time.Time) {
+	c.nowFunc = now
+}
+
+// SetSignatureTTL set how long a signed request may wait before being sent,
+// e.g. queued by the RequestLimiter or while failing over the hosts of
+// SetBaseURLs. A request signed longer than ttl ago is signed again with a
+// fresh timestamp right before being sent and a warning is logged, so it is
+// not rejected with -1021. A zero ttl disables the check
+func (c *Client) SetSignatureTTL(ttl time.Duration) {
+	c.signatureTTL = ttl
+}
+
+// RequestLimiter queue requests, e.g. a *rate.Limiter of golang.org/x/time/rate
+type RequestLimiter interface {
+	// Wait block until the request may be sent or ctx is done
+	Wait(ctx context.Context) error
+}
+
+// SetRequestLimiter set the limiter every request waits for once built and
+// signed, nil disables it
+func (c *Client) SetRequestLimiter(limiter RequestLimiter) {
+	c.limiter = limiter
+}
+
+// SetSignatureCache set the cache of the signatures of payloads, nil disables
+// it. As payloads hold the timestamp, signatures are only reused by identical
+// requests signed within the same millisecond, e.g. when polling with SetClock
+// ticking at a coarser step
+func (c *Client) SetSignatureCache(cache SignatureCache) {
+	c.signatureCache = cache
+}
+
+// SetBaseURLs set mirror hosts of the API, e.g. https://api1.binance.com, the
+// first one also becomes BaseURL. A request failing to connect to a host is
+// sent again to the next one, which is then used for later requests.
+func (c *Client) SetBaseURLs(baseURLs []string) {
+	c.baseURLs = baseURLs
+	atomic.StoreUint32(&c.baseURLIndex, 0)
+	if len(baseURLs) > 0 {
+		c.BaseURL = baseURLs[0]
+	}
+}
+
+// baseURLOrder return the base urls to try a request with, starting with the
+// host in use
+func (c *Client) baseURLOrder() []string {
+	if len(c.baseURLs) == 0 {
+		return []string{c.BaseURL}
+	}
+	start := int(atomic.LoadUint32(&c.baseURLIndex))
+	order := make([]string, 0, len(c.baseURLs))
+	for i := range c.baseURLs {
+		order = append(order, c.baseURLs[(start+i)%len(c.baseURLs)])
+	}
+	return order
+}
+
+// useNextBaseURL switch to the host after baseURL, unless another request did already
+func (c *Client) useNextBaseURL(baseURL string) {
+	n := len(c.baseURLs)
+	current := atomic.LoadUint32(&c.baseURLIndex)
+	if n == 0 || c.baseURLs[int(current)%n] != baseURL {
+		return
+	}
+	atomic.CompareAndSwapUint32(&c.baseURLIndex, current, uint32((int(current)+1)%n))
+}
+
+// isDialError check err happened before the request reached the server, so
+// it is safe to send it again
+func isDialError(ctx context.Context, err error) bool {
+	if ctx.Err() != nil {
+		return false
+	}
+	var opErr *net.OpError
+	return errors.As(err, &opErr) && opErr.Op == "dial"
+}
+
+func (c *Client) now() time.Time {
+	if c.nowFunc != nil {
+		return c.nowFunc()
+	}
+	return time.Now()
 }
 
 func (c *Client) debug(format string, v ...interface{}) {
@@ -240,6 +468,14 @@ func (c *Client) debug(format string, v ...interface{}) {
 	}
 }
 
+// logDeprecated log once that the service name is deprecated in favor of replacement
+func (c *Client) logDeprecated(name string, replacement string) {
+	if _, logged := c.deprecationsLogged.LoadOrStore(name, true); logged || c.Logger == nil {
+		return
+	}
+	c.Logger.Printf("deprecated: %s calls the Simple Earn API, use %s instead", name, replacement)
+}
+
 func (c *Client) parseRequest(r *request, opts ...RequestOption) (err error) {
 	// set request options from user
 	for _, opt := range opts {
@@ -249,13 +485,24 @@ func (c *Client) parseRequest(r *request, opts ...RequestOption) (err error) {
 	if err != nil {
 		return err
 	}
+	return c.signRequest(r)
+}
+
+// signRequest build the full url, header and body of r, with a fresh
+// timestamp and signature if r is signed
+func (c *Client) signRequest(r *request) (err error) {
+	apiKey, secretKey := c.APIKey, c.SecretKey
+	if r.apiKey != "" {
+		apiKey, secretKey = r.apiKey, r.secretKey
+	}
 
 	fullURL := fmt.Sprintf("%s%s", c.BaseURL, r.endpoint)
 	if r.recvWindow > 0 {
 		r.setParam(recvWindowKey, r.recvWindow)
 	}
 	if r.secType == secTypeSigned {
-		r.setParam(timestampKey, currentTimestamp()-c.TimeOffset)
+		r.signedAt = c.now()
+		r.setParam(timestampKey, FormatTimestamp(r.signedAt)-c.TimeOffset)
 	}
 	queryString := r.query.Encode()
 	body := &bytes.Buffer{}
@@ -264,23 +511,29 @@ func (c *Client) parseRequest(r *request, opts ...RequestOption) (err error) {
 	if r.header != nil {
 		header = r.header.Clone()
 	}
+	if c.UserAgent != "" && header.Get("User-Agent") == "" {
+		header.Set("User-Agent", c.UserAgent)
+	}
 	if bodyString != "" {
 		header.Set("Content-Type", "application/x-www-form-urlencoded")
 		body = bytes.NewBufferString(bodyString)
 	}
 	if r.secType == secTypeAPIKey || r.secType == secTypeSigned {
-		header.Set("X-MBX-APIKEY", c.APIKey)
+		header.Set("X-MBX-APIKEY", apiKey)
 	}
 
 	if r.secType == secTypeSigned {
+		// url.Values.Encode sorts the params by key, so the payload is stable
 		raw := fmt.Sprintf("%s%s", queryString, bodyString)
-		mac := hmac.New(sha256.New, []byte(c.SecretKey))
-		_, err = mac.Write([]byte(raw))
+		if r.signDebug != nil {
+			r.signDebug(raw)
+		}
+		signature, err := c.sign(apiKey, secretKey, raw)
 		if err != nil {
 			return err
 		}
 		v := url.Values{}
-		v.Set(signatureKey, fmt.Sprintf("%x", (mac.Sum(nil))))
+		v.Set(signatureKey, signature)
 		if queryString == "" {
 			queryString = v.Encode()
 		} else {
@@ -298,25 +551,90 @@ func (c *Client) parseRequest(r *request, opts ...RequestOption) (err error) {
 	return nil
 }
 
+// sign return the hex encoded HMAC SHA256 of payload, from the signature
+// cache if one is set
+func (c *Client) sign(apiKey, secretKey, payload string) (string, error) {
+	var key string
+	if c.signatureCache != nil {
+		key = apiKey + "\n" + c.secretDigest(secretKey) + "\n" + payload
+		if signature, ok := c.signatureCache.Get(key); ok {
+			return signature, nil
+		}
+	}
+	mac := hmac.New(sha256.New, []byte(secretKey))
+	_, err := mac.Write([]byte(payload))
+	if err != nil {
+		return "", err
+	}
+	signature := fmt.Sprintf("%x", mac.Sum(nil))
+	if c.signatureCache != nil {
+		c.signatureCache.Add(key, signature)
+	}
+	return signature, nil
+}
+
+// secretDigest return the hex encoded SHA256 of secretKey, so cached
+// signatures are keyed by the secret without handing it to the cache
+func (c *Client) secretDigest(secretKey string) string {
+	if digest, ok := c.secretDigests.Load(secretKey); ok {
+		return digest.(string)
+	}
+	digest := fmt.Sprintf("%x", sha256.Sum256([]byte(secretKey)))
+	c.secretDigests.Store(secretKey, digest)
+	return digest
+}
+
 func (c *Client) callAPI(ctx context.Context, r *request, opts ...RequestOption) (data []byte, err error) {
 	err = c.parseRequest(r, opts...)
 	if err != nil {
 		return []byte{}, err
 	}
-	req, err := http.NewRequest(r.method, r.fullURL, r.body)
+	body, err := ioutil.ReadAll(r.body)
 	if err != nil {
 		return []byte{}, err
 	}
-	req = req.WithContext(ctx)
-	req.Header = r.header
-	c.debug("request: %#v", req)
+	if c.limiter != nil {
+		if err = c.limiter.Wait(ctx); err != nil {
+			return []byte{}, common.WrapRequestError(ctx, r.endpoint, err)
+		}
+	}
 	f := c.do
 	if f == nil {
 		f = c.HTTPClient.Do
 	}
-	res, err := f(req)
+	var res *http.Response
+	for _, baseURL := range c.baseURLOrder() {
+		if c.signatureExpired(r) {
+			if c.Logger != nil {
+				c.Logger.Printf("signature of %s expired after %s, signing again", r.endpoint, c.now().Sub(r.signedAt))
+			}
+			err = c.signRequest(r)
+			if err != nil {
+				return []byte{}, err
+			}
+			body, err = ioutil.ReadAll(r.body)
+			if err != nil {
+				return []byte{}, err
+			}
+		}
+		path := strings.TrimPrefix(r.fullURL, c.BaseURL)
+		var req *http.Request
+		req, err = http.NewRequest(r.method, baseURL+path, bytes.NewReader(body))
+		if err != nil {
+			return []byte{}, err
+		}
+		req = req.WithContext(ctx)
+		req.Header = r.header
+		c.debug("request: %#v", req)
+		res, err = f(req)
+		if err == nil || !isDialError(ctx, err) {
+			break
+		}
+		c.debug("failed to connect to %s: %s", baseURL, err)
+		c.useNextBaseURL(baseURL)
+	}
 	if err != nil {
-		return []byte{}, err
+		return []byte{}, common.WrapRequestError(ctx, r.endpoint, err)
 	}
 	mbxWeight := res.Header["X-Mbx-Used-Weight"]
 	if len(mbxWeight) > 0 {
@@ -330,7 +648,10 @@ func (c *Client) callAPI(ctx context.Context, r *request, opts ...RequestOption)
 
 	data, err = ioutil.ReadAll(res.Body)
 	if err != nil {
-		return []byte{}, err
+		return []byte{}, common.WrapRequestError(ctx, r.endpoint, err)
+	}
+	if r.rawResponse != nil {
+		*r.rawResponse = data
 	}
 	defer func() {
 		cerr := res.Body.Close()
@@ -355,6 +676,11 @@ func (c *Client) callAPI(ctx context.Context, r *request, opts ...RequestOption)
 	return data, nil
 }
 
+// signatureExpired check r was signed longer than the signature TTL ago
+func (c *Client) signatureExpired(r *request) bool {
+	return c.signatureTTL > 0 && r.secType == secTypeSigned && c.now().Sub(r.signedAt) > c.signatureTTL
+}
+
 // NewPingService init ping service
 func (c *Client) NewPingService() *PingService {
 	return &PingService{c: c}
@@ -415,6 +741,16 @@ func (c *Client) NewCreateOCOService() *CreateOCOService {
 	return &CreateOCOService{c: c}
 }
 
+// NewCreateOrderListOCOService init creating OCO service of the orderList endpoint
+func (c *Client) NewCreateOrderListOCOService() *CreateOrderListOCOService {
+	return &CreateOrderListOCOService{c: c}
+}
+
+// NewCreateOTOCOService init creating OTOCO service
+func (c *Client) NewCreateOTOCOService() *CreateOTOCOService {
+	return &CreateOTOCOService{c: c}
+}
+
 // NewCancelOCOService init cancel OCO service
 func (c *Client) NewCancelOCOService() *CancelOCOService {
 	return &CancelOCOService{c: c}
@@ -460,6 +796,26 @@ func (c *Client) NewGetAPIKeyPermission() *GetAPIKeyPermission {
 	return &GetAPIKeyPermission{c: c}
 }
 
+// NewGetAccountStatusService init getting account status
+func (c *Client) NewGetAccountStatusService() *GetAccountStatusService {
+	return &GetAccountStatusService{c: c}
+}
+
+// NewGetAPITradingStatusService init getting API trading status
+func (c *Client) NewGetAPITradingStatusService() *GetAPITradingStatusService {
+	return &GetAPITradingStatusService{c: c}
+}
+
+// NewGetOrderCountUsageService init getting order count usage service
+func (c *Client) NewGetOrderCountUsageService() *GetOrderCountUsageService {
+	return &GetOrderCountUsageService{c: c}
+}
+
+// NewGetAccountCommissionService init getting account commission service
+func (c *Client) NewGetAccountCommissionService() *GetAccountCommissionService {
+	return &GetAccountCommissionService{c: c}
+}
+
 // NewListSavingsFlexibleProductsService get flexible products list (Savings)
 func (c *Client) NewListSavingsFlexibleProductsService() *ListSavingsFlexibleProductsService {
 	return &ListSavingsFlexibleProductsService{c: c}
@@ -475,6 +831,26 @@ func (c *Client) NewRedeemSavingsFlexibleProductService() *RedeemSavingsFlexible
 	return &RedeemSavingsFlexibleProductService{c: c}
 }
 
+// NewListSimpleEarnFlexibleProductsService get flexible products list (Simple Earn)
+func (c *Client) NewListSimpleEarnFlexibleProductsService() *ListSimpleEarnFlexibleProductsService {
+	return &ListSimpleEarnFlexibleProductsService{c: c}
+}
+
+// NewSubscribeSimpleEarnFlexibleProductService subscribe a flexible product (Simple Earn)
+func (c *Client) NewSubscribeSimpleEarnFlexibleProductService() *SubscribeSimpleEarnFlexibleProductService {
+	return &SubscribeSimpleEarnFlexibleProductService{c: c}
+}
+
+// NewRedeemSimpleEarnFlexibleProductService redeem a flexible product (Simple Earn)
+func (c *Client) NewRedeemSimpleEarnFlexibleProductService() *RedeemSimpleEarnFlexibleProductService {
+	return &RedeemSimpleEarnFlexibleProductService{c: c}
+}
+
+// NewGetSimpleEarnFlexiblePersonalLeftQuotaService get personal left quota of a flexible product (Simple Earn)
+func (c *Client) NewGetSimpleEarnFlexiblePersonalLeftQuotaService() *GetSimpleEarnFlexiblePersonalLeftQuotaService {
+	return &GetSimpleEarnFlexiblePersonalLeftQuotaService{c: c}
+}
+
 // NewListSavingsFixedAndActivityProductsService get fixed and activity product list (Savings)
 func (c *Client) NewListSavingsFixedAndActivityProductsService() *ListSavingsFixedAndActivityProductsService {
 	return &ListSavingsFixedAndActivityProductsService{c: c}
@@ -505,6 +881,21 @@ func (c *Client) NewGetDepositAddressService() *GetDepositsAddressService {
 	return &GetDepositsAddressService{c: c}
 }
 
+// NewGetDepositAddressListService init getting deposit address list service
+func (c *Client) NewGetDepositAddressListService() *GetDepositAddressListService {
+	return &GetDepositAddressListService{c: c}
+}
+
+// NewGetSubAccountDepositAddressService init getting sub-account deposit address service
+func (c *Client) NewGetSubAccountDepositAddressService() *GetSubAccountDepositAddressService {
+	return &GetSubAccountDepositAddressService{c: c}
+}
+
+// NewGetSubAccountDepositHistoryService init listing sub-account deposits service
+func (c *Client) NewGetSubAccountDepositHistoryService() *GetSubAccountDepositHistoryService {
+	return &GetSubAccountDepositHistoryService{c: c}
+}
+
 // NewCreateWithdrawService init creating withdraw service
 func (c *Client) NewCreateWithdrawService() *CreateWithdrawService {
 	return &CreateWithdrawService{c: c}
@@ -550,6 +941,11 @@ func (c *Client) NewMarginTransferService() *MarginTransferService {
 	return &MarginTransferService{c: c}
 }
 
+// NewIsolatedMarginTransferService init isolated margin account transfer service
+func (c *Client) NewIsolatedMarginTransferService() *IsolatedMarginTransferService {
+	return &IsolatedMarginTransferService{c: c}
+}
+
 // NewMarginLoanService init margin account loan service
 func (c *Client) NewMarginLoanService() *MarginLoanService {
 	return &MarginLoanService{c: c}
@@ -585,6 +981,41 @@ func (c *Client) NewGetMarginOrderService() *GetMarginOrderService {
 	return &GetMarginOrderService{c: c}
 }
 
+// NewGetMarginTransferHistoryService init margin transfer history service
+func (c *Client) NewGetMarginTransferHistoryService() *GetMarginTransferHistoryService {
+	return &GetMarginTransferHistoryService{c: c}
+}
+
+// NewGetMarginForceLiquidationService init margin force liquidation record service
+func (c *Client) NewGetMarginForceLiquidationService() *GetMarginForceLiquidationService {
+	return &GetMarginForceLiquidationService{c: c}
+}
+
+// NewMarginSmallLiabilityExchangeService init margin small liability exchange service
+func (c *Client) NewMarginSmallLiabilityExchangeService() *MarginSmallLiabilityExchangeService {
+	return &MarginSmallLiabilityExchangeService{c: c}
+}
+
+// NewGetMarginSmallLiabilityListService init margin small liability list service
+func (c *Client) NewGetMarginSmallLiabilityListService() *GetMarginSmallLiabilityListService {
+	return &GetMarginSmallLiabilityListService{c: c}
+}
+
+// NewGetMarginInterestRateService init get margin next hourly interest rate service
+func (c *Client) NewGetMarginInterestRateService() *GetMarginInterestRateService {
+	return &GetMarginInterestRateService{c: c}
+}
+
+// NewToggleBnbBurnService init toggle BNB burn service
+func (c *Client) NewToggleBnbBurnService() *ToggleBnbBurnService {
+	return &ToggleBnbBurnService{c: c}
+}
+
+// NewGetBnbBurnStatusService init get BNB burn status service
+func (c *Client) NewGetBnbBurnStatusService() *GetBnbBurnStatusService {
+	return &GetBnbBurnStatusService{c: c}
+}
+
 // NewListMarginLoansService init list margin loan service
 func (c *Client) NewListMarginLoansService() *ListMarginLoansService {
 	return &ListMarginLoansService{c: c}
@@ -700,6 +1131,11 @@ func (c *Client) NewDustTransferService() *DustTransferService {
 	return &DustTransferService{c: c}
 }
 
+// NewGetDustableAssetsService init get dustable assets service
+func (c *Client) NewGetDustableAssetsService() *GetDustableAssetsService {
+	return &GetDustableAssetsService{c: c}
+}
+
 // NewTransferToSubAccountService transfer to subaccount service
 func (c *Client) NewTransferToSubAccountService() *TransferToSubAccountService {
 	return &TransferToSubAccountService{c: c}
@@ -715,6 +1151,21 @@ func (c *Client) NewSubaccountSpotSummaryService() *SubaccountSpotSummaryService
 	return &SubaccountSpotSummaryService{c: c}
 }
 
+// NewSubAccountEnableFuturesService init enable sub-account futures service
+func (c *Client) NewSubAccountEnableFuturesService() *SubAccountEnableFuturesService {
+	return &SubAccountEnableFuturesService{c: c}
+}
+
+// NewSubAccountEnableMarginService init enable sub-account margin service
+func (c *Client) NewSubAccountEnableMarginService() *SubAccountEnableMarginService {
+	return &SubAccountEnableMarginService{c: c}
+}
+
+// NewGetSubAccountFuturesSummaryService init sub-account futures summary service
+func (c *Client) NewGetSubAccountFuturesSummaryService() *GetSubAccountFuturesSummaryService {
+	return &GetSubAccountFuturesSummaryService{c: c}
+}
+
 // NewAssetDividendService init the asset dividend list service
 func (c *Client) NewAssetDividendService() *AssetDividendService {
 	return &AssetDividendService{c: c}
@@ -750,16 +1201,46 @@ func (c *Client) NewSpotRebateHistoryService() *SpotRebateHistoryService {
 	return &SpotRebateHistoryService{c: c}
 }
 
+// NewGetBrokerRebateRecentRecordService init the broker rebate recent record service
+func (c *Client) NewGetBrokerRebateRecentRecordService() *GetBrokerRebateRecentRecordService {
+	return &GetBrokerRebateRecentRecordService{c: c}
+}
+
+// NewGetBrokerSubAccountCommissionService init the broker sub account commission service
+func (c *Client) NewGetBrokerSubAccountCommissionService() *GetBrokerSubAccountCommissionService {
+	return &GetBrokerSubAccountCommissionService{c: c}
+}
+
 // NewConvertTradeHistoryService init the convert trade history service
 func (c *Client) NewConvertTradeHistoryService() *ConvertTradeHistoryService {
 	return &ConvertTradeHistoryService{c: c}
 }
 
+// NewConvertPlaceLimitOrderService init the convert place limit order service
+func (c *Client) NewConvertPlaceLimitOrderService() *ConvertPlaceLimitOrderService {
+	return &ConvertPlaceLimitOrderService{c: c}
+}
+
+// NewConvertCancelLimitOrderService init the convert cancel limit order service
+func (c *Client) NewConvertCancelLimitOrderService() *ConvertCancelLimitOrderService {
+	return &ConvertCancelLimitOrderService{c: c}
+}
+
+// NewConvertQueryLimitOpenOrdersService init the convert query limit open orders service
+func (c *Client) NewConvertQueryLimitOpenOrdersService() *ConvertQueryLimitOpenOrdersService {
+	return &ConvertQueryLimitOpenOrdersService{c: c}
+}
+
 // NewGetIsolatedMarginAllPairsService init get isolated margin all pairs service
 func (c *Client) NewGetIsolatedMarginAllPairsService() *GetIsolatedMarginAllPairsService {
 	return &GetIsolatedMarginAllPairsService{c: c}
 }
 
+// NewGetCrossMarginCollateralRatioService init get cross margin collateral ratio service
+func (c *Client) NewGetCrossMarginCollateralRatioService() *GetCrossMarginCollateralRatioService {
+	return &GetCrossMarginCollateralRatioService{c: c}
+}
+
 // NewInterestHistoryService init the interest history service
 func (c *Client) NewInterestHistoryService() *InterestHistoryService {
 	return &InterestHistoryService{c: c}
@@ -799,3 +1280,28 @@ func (c *Client) NewGetStakingHistory() *GetStakingHistory {
 func (c *Client) NewGetStakingLeftQuota() *GetStakingLeftDailyPurchaseQuota {
 	return &GetStakingLeftDailyPurchaseQuota{c: c}
 }
+
+// NewDualInvestmentListService init the dual investment product list service
+func (c *Client) NewDualInvestmentListService() *DualInvestmentListService {
+	return &DualInvestmentListService{c: c}
+}
+
+// NewDualInvestmentSubscribeService init the dual investment subscribe service
+func (c *Client) NewDualInvestmentSubscribeService() *DualInvestmentSubscribeService {
+	return &DualInvestmentSubscribeService{c: c}
+}
+
+// NewVipLoanOngoingOrdersService init the vip loan ongoing orders service
+func (c *Client) NewVipLoanOngoingOrdersService() *VipLoanOngoingOrdersService {
+	return &VipLoanOngoingOrdersService{c: c}
+}
+
+// NewVipLoanBorrowService init the vip loan borrow service
+func (c *Client) NewVipLoanBorrowService() *VipLoanBorrowService {
+	return &VipLoanBorrowService{c: c}
+}
+
+// NewVipLoanRepayService init the vip loan repay service
+func (c *Client) NewVipLoanRepayService() *VipLoanRepayService {
+	return &VipLoanRepayService{c: c}
+}
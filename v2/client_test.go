@@ -3,12 +3,23 @@ package binance
 import (
 	"bytes"
 	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"errors"
+	"fmt"
 	"io/ioutil"
+	"log"
+	"net"
 	"net/http"
+	"net/http/httptest"
 	"net/url"
+	"strconv"
+	"strings"
+	"sync"
 	"testing"
 	"time"
 
+	"github.com/adshao/go-binance/v2/common"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
@@ -125,6 +136,7 @@ func newMockedClient(apiKey, secretKey string) *mockedClient {
 func (m *mockedClient) do(req *http.Request) (*http.Response, error) {
 	if m.assertReq != nil {
 		r := newRequest()
+		r.endpoint = req.URL.Path
 		r.query = req.URL.Query()
 		if req.Body != nil {
 			bs := make([]byte, req.ContentLength)
@@ -167,3 +179,292 @@ func TestFormatTimestamp(t *testing.T) {
 	tm, _ := time.Parse("2006-01-02 15:04:05", "2018-06-01 01:01:01")
 	assert.Equal(t, int64(1527814861000), FormatTimestamp(tm))
 }
+
+func TestWithCredentials(t *testing.T) {
+	secrets := map[string]string{
+		"defaultKey": "defaultSecret",
+		"keyA":       "secretA",
+		"keyB":       "secretB",
+	}
+	c := NewClient("defaultKey", "defaultSecret")
+	var mu sync.Mutex
+	used := map[string]bool{}
+	c.do = func(req *http.Request) (*http.Response, error) {
+		apiKey := req.Header.Get("X-MBX-APIKEY")
+		query := req.URL.RawQuery
+		i := strings.Index(query, "&"+signatureKey+"=")
+		if i < 0 {
+			return nil, fmt.Errorf("missing signature")
+		}
+		mac := hmac.New(sha256.New, []byte(secrets[apiKey]))
+		mac.Write([]byte(query[:i]))
+		if fmt.Sprintf("%x", mac.Sum(nil)) != query[i+len(signatureKey)+2:] {
+			return nil, fmt.Errorf("invalid signature for %s", apiKey)
+		}
+		mu.Lock()
+		used[apiKey] = true
+		mu.Unlock()
+		return newHTTPResponse([]byte(`{}`), http.StatusOK), nil
+	}
+
+	var wg sync.WaitGroup
+	errs := make([]error, 3)
+	for i, opt := range []RequestOption{WithCredentials("keyA", "secretA"), WithCredentials("keyB", "secretB"), WithRecvWindow(5000)} {
+		wg.Add(1)
+		go func(i int, opt RequestOption) {
+			defer wg.Done()
+			_, errs[i] = c.NewGetAccountService().Do(newContext(), opt)
+		}(i, opt)
+	}
+	wg.Wait()
+	for _, err := range errs {
+		assert.NoError(t, err)
+	}
+	assert.Equal(t, map[string]bool{"defaultKey": true, "keyA": true, "keyB": true}, used)
+}
+
+func TestCustomHeaders(t *testing.T) {
+	headerC := make(chan http.Header, 1)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		headerC <- r.Header
+		w.Write([]byte(`{}`))
+	}))
+	defer srv.Close()
+
+	c := NewClient("dummyAPIKey", "dummySecretKey")
+	c.BaseURL = srv.URL
+	c.SetUserAgent("my-bot/1.0")
+	_, err := c.NewGetAccountService().Do(newContext(),
+		WithHeader("X-Route", "gateway-a", false),
+		WithHeader("X-MBX-APIKEY", "overridden", true))
+	r := require.New(t)
+	r.NoError(err)
+	header := <-headerC
+	r.Equal("my-bot/1.0", header.Get("User-Agent"))
+	r.Equal("gateway-a", header.Get("X-Route"))
+	r.Equal([]string{"dummyAPIKey"}, header[http.CanonicalHeaderKey("X-MBX-APIKEY")])
+
+	_, err = c.NewGetAccountService().Do(newContext(), WithHeader("User-Agent", "override/2.0", true))
+	r.NoError(err)
+	r.Equal("override/2.0", (<-headerC).Get("User-Agent"))
+}
+
+func TestWithSignDebug(t *testing.T) {
+	c := NewClient("dummyAPIKey", "dummySecretKey")
+	var signature string
+	c.do = func(req *http.Request) (*http.Response, error) {
+		signature = req.URL.Query().Get(signatureKey)
+		return newHTTPResponse([]byte(`[]`), http.StatusOK), nil
+	}
+
+	var payload string
+	_, err := c.NewListTradesService().Symbol("BNBBTC").Limit(3).FromID(1).
+		Do(newContext(), WithRecvWindow(5000), WithSignDebug(func(p string) {
+			payload = p
+		}))
+	r := require.New(t)
+	r.NoError(err)
+	r.Regexp(`^fromId=1&limit=3&recvWindow=5000&symbol=BNBBTC&timestamp=\d+$`, payload)
+	mac := hmac.New(sha256.New, []byte("dummySecretKey"))
+	mac.Write([]byte(payload))
+	r.Equal(fmt.Sprintf("%x", mac.Sum(nil)), signature)
+}
+
+func TestWithRawResponse(t *testing.T) {
+	body := []byte(`{"serverTime": 1499827319559, "newField": "not modeled"}`)
+	status := http.StatusOK
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(status)
+		w.Write(body)
+	}))
+	defer srv.Close()
+
+	c := NewClient("dummyAPIKey", "dummySecretKey")
+	c.BaseURL = srv.URL
+	var raw []byte
+	serverTime, err := c.NewServerTimeService().Do(newContext(), WithRawResponse(&raw))
+	r := require.New(t)
+	r.NoError(err)
+	r.Equal(int64(1499827319559), serverTime)
+	r.Equal(body, raw)
+
+	body = []byte(`{"code":-1121,"msg":"Invalid symbol."}`)
+	status = http.StatusBadRequest
+	_, err = c.NewServerTimeService().Do(newContext(), WithRawResponse(&raw))
+	r.Error(err)
+	r.Equal(body, raw)
+}
+
+func TestCallAPIContextErrors(t *testing.T) {
+	unblock := make(chan struct{})
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-unblock:
+		case <-r.Context().Done():
+		}
+	}))
+	defer srv.Close()
+	defer close(unblock)
+
+	c := NewClient("dummyAPIKey", "dummySecretKey")
+	c.BaseURL = srv.URL
+	r := require.New(t)
+
+	ctx, cancel := context.WithTimeout(newContext(), 20*time.Millisecond)
+	defer cancel()
+	_, err := c.NewGetAccountService().Do(ctx)
+	r.True(errors.Is(err, common.ErrRequestTimeout), "%v", err)
+	r.False(errors.Is(err, common.ErrRequestCanceled))
+	r.True(errors.Is(err, context.DeadlineExceeded))
+	var reqErr *common.RequestError
+	r.True(errors.As(err, &reqErr))
+	r.Equal("/api/v3/account", reqErr.Endpoint)
+
+	ctx, cancel = context.WithCancel(newContext())
+	time.AfterFunc(20*time.Millisecond, cancel)
+	_, err = c.NewGetAccountService().Do(ctx)
+	r.True(errors.Is(err, common.ErrRequestCanceled), "%v", err)
+	r.True(errors.Is(err, context.Canceled))
+	r.Contains(err.Error(), "/api/v3/account")
+}
+
+func TestSetClock(t *testing.T) {
+	c := NewClient("dummyAPIKey", "dummySecretKey")
+	var timestamp string
+	c.do = func(req *http.Request) (*http.Response, error) {
+		timestamp = req.URL.Query().Get(timestampKey)
+		return newHTTPResponse([]byte(`[]`), http.StatusOK), nil
+	}
+	c.SetClock(func() time.Time {
+		return time.Date(2021, 3, 4, 5, 6, 7, 890000000, time.UTC)
+	})
+	c.TimeOffset = 1000
+
+	_, err := c.NewListTradesService().Symbol("BNBBTC").Do(newContext())
+	r := require.New(t)
+	r.NoError(err)
+	r.Equal("1614834366890", timestamp)
+
+	c.SetClock(nil)
+	_, err = c.NewListTradesService().Symbol("BNBBTC").Do(newContext())
+	r.NoError(err)
+	r.NotEqual("1614834366890", timestamp)
+}
+
+func TestSetBaseURLsFailover(t *testing.T) {
+	down := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	downURL := down.URL
+	down.Close()
+	var paths []string
+	up := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		paths = append(paths, r.URL.Path)
+		w.Write([]byte(`{"serverTime": 1499827319559}`))
+	}))
+	defer up.Close()
+
+	c := NewClient("dummyAPIKey", "dummySecretKey")
+	c.SetBaseURLs([]string{downURL, up.URL})
+	r := require.New(t)
+	r.Equal(downURL, c.BaseURL)
+
+	serverTime, err := c.NewServerTimeService().Do(newContext())
+	r.NoError(err)
+	r.Equal(int64(1499827319559), serverTime)
+	r.Equal([]string{"/api/v3/time"}, paths)
+	r.Equal([]string{up.URL, downURL}, c.baseURLOrder())
+
+	// later requests go to the working host first
+	_, err = c.NewServerTimeService().Do(newContext())
+	r.NoError(err)
+	r.Len(paths, 2)
+}
+
+func TestSetSignatureTTL(t *testing.T) {
+	c := NewClient("dummyAPIKey", "dummySecretKey")
+	c.Logger = log.New(ioutil.Discard, "", 0)
+	now := time.Date(2021, 3, 4, 5, 6, 7, 0, time.UTC)
+	c.SetClock(func() time.Time {
+		return now
+	})
+	c.SetSignatureTTL(time.Second)
+	c.SetBaseURLs([]string{"http://down.binance.test", "http://up.binance.test"})
+	var query url.Values
+	c.do = func(req *http.Request) (*http.Response, error) {
+		if req.URL.Host == "down.binance.test" {
+			// failing to connect takes longer than the signature TTL
+			now = now.Add(2 * time.Second)
+			return nil, &net.OpError{Op: "dial", Err: errors.New("connection refused")}
+		}
+		query = req.URL.Query()
+		return newHTTPResponse([]byte(`[]`), http.StatusOK), nil
+	}
+
+	_, err := c.NewListTradesService().Symbol("BNBBTC").Do(newContext())
+	r := require.New(t)
+	r.NoError(err)
+	r.Equal("1614834369000", query.Get(timestampKey))
+	payload := fmt.Sprintf("symbol=BNBBTC&timestamp=%s", query.Get(timestampKey))
+	mac := hmac.New(sha256.New, []byte("dummySecretKey"))
+	mac.Write([]byte(payload))
+	r.Equal(fmt.Sprintf("%x", mac.Sum(nil)), query.Get(signatureKey))
+
+	// within the TTL the original timestamp is kept
+	c.SetSignatureTTL(time.Minute)
+	c.SetBaseURLs([]string{"http://down.binance.test", "http://up.binance.test"})
+	_, err = c.NewListTradesService().Symbol("BNBBTC").Do(newContext())
+	r.NoError(err)
+	r.Equal("1614834369000", query.Get(timestampKey))
+}
+
+// sleepLimiter queue every request for delay
+type sleepLimiter struct {
+	delay time.Duration
+}
+
+func (l sleepLimiter) Wait(ctx context.Context) error {
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-time.After(l.delay):
+		return nil
+	}
+}
+
+func TestSetSignatureTTLQueuedRequest(t *testing.T) {
+	c := NewClient("dummyAPIKey", "dummySecretKey")
+	c.Logger = log.New(ioutil.Discard, "", 0)
+	c.SetSignatureTTL(10 * time.Millisecond)
+	c.SetRequestLimiter(sleepLimiter{delay: 50 * time.Millisecond})
+	var sent url.Values
+	c.do = func(req *http.Request) (*http.Response, error) {
+		sent = req.URL.Query()
+		return newHTTPResponse([]byte(`[]`), http.StatusOK), nil
+	}
+
+	var payloads []string
+	_, err := c.NewListTradesService().Symbol("BNBBTC").Do(newContext(), WithSignDebug(func(p string) {
+		payloads = append(payloads, p)
+	}))
+	r := require.New(t)
+	r.NoError(err)
+	r.Len(payloads, 2)
+	built, err := strconv.ParseInt(strings.TrimPrefix(payloads[0], "symbol=BNBBTC&timestamp="), 10, 64)
+	r.NoError(err)
+	resigned, err := strconv.ParseInt(sent.Get(timestampKey), 10, 64)
+	r.NoError(err)
+	r.GreaterOrEqual(resigned-built, int64(50))
+	r.Equal(payloads[1], fmt.Sprintf("symbol=BNBBTC&timestamp=%d", resigned))
+	mac := hmac.New(sha256.New, []byte("dummySecretKey"))
+	mac.Write([]byte(payloads[1]))
+	r.Equal(fmt.Sprintf("%x", mac.Sum(nil)), sent.Get(signatureKey))
+
+	// without TTL the request is sent as it was signed
+	c.SetSignatureTTL(0)
+	payloads = nil
+	_, err = c.NewListTradesService().Symbol("BNBBTC").Do(newContext(), WithSignDebug(func(p string) {
+		payloads = append(payloads, p)
+	}))
+	r.NoError(err)
+	r.Len(payloads, 1)
+	r.Equal(payloads[0], fmt.Sprintf("symbol=BNBBTC&timestamp=%s", sent.Get(timestampKey)))
+}
@@ -0,0 +1,63 @@
+package common
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+// ParseDecimal parse a decimal string of the API exactly, an empty string
+// is zero
+func ParseDecimal(s string) (*big.Rat, error) {
+	if s == "" {
+		return new(big.Rat), nil
+	}
+	r, ok := new(big.Rat).SetString(s)
+	if !ok {
+		return nil, fmt.Errorf("invalid decimal %q", s)
+	}
+	return r, nil
+}
+
+// StepDecimals return the number of decimals of step, e.g. 2 for "0.01000000"
+func StepDecimals(step string) int {
+	i := strings.IndexByte(step, '.')
+	if i < 0 {
+		return 0
+	}
+	return len(strings.TrimRight(step[i+1:], "0"))
+}
+
+// RoundToFilter round value down to a multiple of step and check it is
+// within min and max, a zero step, min or max disables the check. name and
+// symbol are only used in the returned errors
+func RoundToFilter(value, step, min, max, name, symbol string) (*big.Rat, error) {
+	v, err := ParseDecimal(value)
+	if err != nil {
+		return nil, err
+	}
+	d, err := ParseDecimal(step)
+	if err != nil {
+		return nil, err
+	}
+	if d.Sign() > 0 {
+		steps := new(big.Rat).Quo(v, d)
+		n := new(big.Int).Quo(steps.Num(), steps.Denom())
+		v = new(big.Rat).Mul(new(big.Rat).SetInt(n), d)
+	}
+	lo, err := ParseDecimal(min)
+	if err != nil {
+		return nil, err
+	}
+	if v.Cmp(lo) < 0 || v.Sign() <= 0 {
+		return nil, fmt.Errorf("%s %s of %s below min %s %s", name, value, symbol, name, min)
+	}
+	hi, err := ParseDecimal(max)
+	if err != nil {
+		return nil, err
+	}
+	if hi.Sign() > 0 && v.Cmp(hi) > 0 {
+		return nil, fmt.Errorf("%s %s of %s above max %s %s", name, value, symbol, name, max)
+	}
+	return v, nil
+}
@@ -0,0 +1,33 @@
+package common
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRoundToFilter(t *testing.T) {
+	r := require.New(t)
+	v, err := RoundToFilter("1.23456", "0.01000000", "0.01", "1000", "price", "BTCUSDT")
+	r.NoError(err)
+	r.Equal("1.23", v.FloatString(StepDecimals("0.01000000")))
+
+	_, err = RoundToFilter("0.001", "0.01", "0.01", "1000", "price", "BTCUSDT")
+	r.EqualError(err, "price 0.001 of BTCUSDT below min price 0.01")
+
+	_, err = RoundToFilter("2000", "0.01", "0.01", "1000", "price", "BTCUSDT")
+	r.EqualError(err, "price 2000 of BTCUSDT above max price 1000")
+
+	_, err = RoundToFilter("1.5", "0", "0", "0", "quantity", "BTCUSDT")
+	r.NoError(err)
+
+	_, err = ParseDecimal("abc")
+	r.EqualError(err, `invalid decimal "abc"`)
+}
+
+func TestStepDecimals(t *testing.T) {
+	r := require.New(t)
+	r.Equal(2, StepDecimals("0.01000000"))
+	r.Equal(0, StepDecimals("1.00000000"))
+	r.Equal(0, StepDecimals("10"))
+}
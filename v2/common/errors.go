@@ -1,7 +1,10 @@
 package common
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"net"
 )
 
 // APIError define API error when response status is 4xx or 5xx
@@ -20,3 +23,52 @@ func IsAPIError(e error) bool {
 	_, ok := e.(*APIError)
 	return ok
 }
+
+// Kinds of RequestError
+var (
+	ErrRequestTimeout  = errors.New("request timed out")
+	ErrRequestCanceled = errors.New("request canceled")
+)
+
+// RequestError define error of a request which did not complete because it
+// timed out or its context was canceled
+type RequestError struct {
+	Endpoint string
+	// Kind is ErrRequestTimeout or ErrRequestCanceled
+	Kind error
+	// Err is the error returned by the http client
+	Err error
+}
+
+// Error return endpoint and kind of the error
+func (e *RequestError) Error() string {
+	return fmt.Sprintf("<RequestError> endpoint=%s, %v: %v", e.Endpoint, e.Kind, e.Err)
+}
+
+// Is report whether target is the kind of e, so errors.Is(err, ErrRequestTimeout) works
+func (e *RequestError) Is(target error) bool {
+	return target == e.Kind
+}
+
+// Unwrap return the error of the http client, so errors.Is(err, context.Canceled) still works
+func (e *RequestError) Unwrap() error {
+	return e.Err
+}
+
+// WrapRequestError return err as a *RequestError of endpoint when ctx is done
+// or err is a timeout, otherwise err unchanged
+func WrapRequestError(ctx context.Context, endpoint string, err error) error {
+	if err == nil {
+		return nil
+	}
+	switch ctxErr := ctx.Err(); {
+	case ctxErr == context.Canceled:
+		return &RequestError{Endpoint: endpoint, Kind: ErrRequestCanceled, Err: ctxErr}
+	case ctxErr == context.DeadlineExceeded:
+		return &RequestError{Endpoint: endpoint, Kind: ErrRequestTimeout, Err: ctxErr}
+	}
+	if netErr, ok := err.(net.Error); ok && netErr.Timeout() {
+		return &RequestError{Endpoint: endpoint, Kind: ErrRequestTimeout, Err: err}
+	}
+	return err
+}
@@ -0,0 +1,40 @@
+package common
+
+import "fmt"
+
+// PageLimit return the page size to iterate with, max when limit is nil and
+// max when limit exceeds it, as the server would cap the page and the short
+// page would end the iteration early. A limit <= 0 is an error.
+func PageLimit(limit *int, max int) (int, error) {
+	if limit == nil {
+		return max, nil
+	}
+	if *limit <= 0 {
+		return 0, fmt.Errorf("invalid page limit %d", *limit)
+	}
+	if *limit > max {
+		return max, nil
+	}
+	return *limit, nil
+}
+
+// PageForward page forward through a listing ordered by an increasing int64
+// cursor, e.g. a trade id or an open time. fetchPage is first called with a
+// nil from, then with the cursor of the last row of the previous page + 1.
+// fetchPage return the number of rows of its page, the cursor of the last
+// one and whether the iteration must stop. Paging stops on an error, on stop
+// or after a page shorter than limit, which must be > 0.
+func PageForward(limit int, fetchPage func(from *int64) (n int, last int64, stop bool, err error)) error {
+	if limit <= 0 {
+		return fmt.Errorf("invalid page limit %d", limit)
+	}
+	var from *int64
+	for {
+		n, last, stop, err := fetchPage(from)
+		if err != nil || stop || n < limit {
+			return err
+		}
+		next := last + 1
+		from = &next
+	}
+}
@@ -0,0 +1,73 @@
+package common
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPageForward(t *testing.T) {
+	r := require.New(t)
+	pages := [][]int64{{1, 2, 3}, {4, 5, 6}, {7}}
+	var froms []interface{}
+	err := PageForward(3, func(from *int64) (int, int64, bool, error) {
+		if from == nil {
+			froms = append(froms, nil)
+		} else {
+			froms = append(froms, *from)
+		}
+		page := pages[len(froms)-1]
+		return len(page), page[len(page)-1], false, nil
+	})
+	r.NoError(err)
+	r.Equal([]interface{}{nil, int64(4), int64(7)}, froms)
+
+	calls := 0
+	err = PageForward(3, func(from *int64) (int, int64, bool, error) {
+		calls++
+		return 3, 3, true, nil
+	})
+	r.NoError(err)
+	r.Equal(1, calls)
+
+	fetchErr := errors.New("fetch failed")
+	err = PageForward(3, func(from *int64) (int, int64, bool, error) {
+		return 3, 3, false, fetchErr
+	})
+	r.Equal(fetchErr, err)
+}
+
+func TestPageForwardInvalidLimit(t *testing.T) {
+	r := require.New(t)
+	calls := 0
+	err := PageForward(0, func(from *int64) (int, int64, bool, error) {
+		calls++
+		return 0, 0, false, nil
+	})
+	r.Error(err)
+	r.Equal(0, calls)
+}
+
+func TestPageLimit(t *testing.T) {
+	r := require.New(t)
+	limit, err := PageLimit(nil, 1000)
+	r.NoError(err)
+	r.Equal(1000, limit)
+
+	for _, l := range []int{1, 500, 1000} {
+		limit, err = PageLimit(&l, 1000)
+		r.NoError(err)
+		r.Equal(l, limit)
+	}
+
+	over := 5000
+	limit, err = PageLimit(&over, 1000)
+	r.NoError(err)
+	r.Equal(1000, limit)
+
+	for _, l := range []int{0, -1} {
+		_, err = PageLimit(&l, 1000)
+		r.Error(err)
+	}
+}
@@ -0,0 +1,375 @@
+package common
+
+import (
+	"crypto/tls"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"sync/atomic"
+	"time"
+
+	"github.com/gorilla/websocket"
+)
+
+// WsHandler handle raw websocket message
+type WsHandler func(message []byte)
+
+// ErrHandler handles errors
+type ErrHandler func(err error)
+
+// WsConfig webservice configuration
+type WsConfig struct {
+	Endpoint string
+	// Dialer used to connect, websocket.DefaultDialer if nil
+	Dialer *websocket.Dialer
+	// Keepalive enables sending a ping every Timeout, the connection is closed
+	// when no pong is received within Timeout
+	Keepalive bool
+	Timeout   time.Duration
+	// ReadTimeout closes the connection when nothing, including server pings,
+	// is received for this duration. Zero means no read deadline
+	ReadTimeout time.Duration
+	// WriteTimeout is the deadline for writing ping and pong messages
+	WriteTimeout time.Duration
+	// BufferSize is the number of messages queued between the connection and
+	// a slow handler, BufferPolicy decides what happens when the queue is
+	// full. Zero means the handler is called from the read loop directly
+	BufferSize   int
+	BufferPolicy WsBufferPolicy
+	// Dropped, if not nil, is incremented for every message dropped by
+	// BufferPolicy
+	Dropped *int64
+	// EventHandler, if not nil, is called after the typed handler with the
+	// typed event and the message it was parsed from
+	EventHandler WsEventHandler
+	// MaxDialRetries is the number of times a failed dial is retried before
+	// the error is returned, waiting DialBackoff doubled after every failure
+	// up to MaxDialBackoff
+	MaxDialRetries int
+	DialBackoff    time.Duration
+	MaxDialBackoff time.Duration
+}
+
+// WsEvent is a typed event along with the message it was parsed from, so
+// fields not modeled by the typed event yet can be read from Raw
+type WsEvent struct {
+	// Event is the event given to the typed handler, e.g. *WsTradeEvent
+	Event interface{}
+	Raw   json.RawMessage
+}
+
+// WsEventHandler handle typed events along with their raw message
+type WsEventHandler func(event *WsEvent)
+
+// WsBufferPolicy define what to do when the message buffer is full
+type WsBufferPolicy string
+
+// Buffer policies
+const (
+	// WsBufferPolicyBlock block reading until the handler catches up
+	WsBufferPolicyBlock WsBufferPolicy = "block"
+	// WsBufferPolicyDropOldest drop the oldest queued message
+	WsBufferPolicyDropOldest WsBufferPolicy = "dropOldest"
+	// WsBufferPolicyDropNewest drop the message just received
+	WsBufferPolicyDropNewest WsBufferPolicy = "dropNewest"
+)
+
+// WsOption define option of websocket connections
+type WsOption func(*WsConfig)
+
+// WithWsKeepalive send a ping every interval and close the connection when
+// no pong is received within interval
+func WithWsKeepalive(interval time.Duration) WsOption {
+	return func(cfg *WsConfig) {
+		cfg.Keepalive = true
+		cfg.Timeout = interval
+	}
+}
+
+// WithWsReadTimeout set read deadline of websocket connections
+func WithWsReadTimeout(timeout time.Duration) WsOption {
+	return func(cfg *WsConfig) {
+		cfg.ReadTimeout = timeout
+	}
+}
+
+// WithWsWriteTimeout set write deadline of ping and pong messages
+func WithWsWriteTimeout(timeout time.Duration) WsOption {
+	return func(cfg *WsConfig) {
+		cfg.WriteTimeout = timeout
+	}
+}
+
+// WithWsBuffer queue up to size messages for the handler so a slow handler
+// does not block reading, policy decides what happens when the queue is full.
+// dropped may be nil, otherwise it counts the dropped messages and should be
+// read with atomic.LoadInt64
+func WithWsBuffer(size int, policy WsBufferPolicy, dropped *int64) WsOption {
+	return func(cfg *WsConfig) {
+		cfg.BufferSize = size
+		cfg.BufferPolicy = policy
+		cfg.Dropped = dropped
+	}
+}
+
+// WithWsDialer set the dialer used to connect
+func WithWsDialer(dialer *websocket.Dialer) WsOption {
+	return func(cfg *WsConfig) {
+		cfg.Dialer = dialer
+	}
+}
+
+// WithWsProxy connect through the http proxy, credentials of an
+// authenticated proxy are taken from the user info of proxyURL
+func WithWsProxy(proxyURL *url.URL) WsOption {
+	return func(cfg *WsConfig) {
+		cfg.copyDialer().Proxy = http.ProxyURL(proxyURL)
+	}
+}
+
+// WithWsTLSConfig set the tls config used to connect, e.g. to pin a CA
+func WithWsTLSConfig(tlsConfig *tls.Config) WsOption {
+	return func(cfg *WsConfig) {
+		cfg.copyDialer().TLSClientConfig = tlsConfig
+	}
+}
+
+// copyDialer replace the dialer by a copy the options can modify without
+// touching websocket.DefaultDialer or a dialer given by WithWsDialer
+func (cfg *WsConfig) copyDialer() *websocket.Dialer {
+	d := *websocket.DefaultDialer
+	if cfg.Dialer != nil {
+		d = *cfg.Dialer
+	}
+	cfg.Dialer = &d
+	return cfg.Dialer
+}
+
+// WithWsMaxDialRetries retry a failed dial up to n times before returning the
+// error, waiting as set by WithWsDialBackoff between attempts
+func WithWsMaxDialRetries(n int) WsOption {
+	return func(cfg *WsConfig) {
+		cfg.MaxDialRetries = n
+	}
+}
+
+// WithWsDialBackoff wait base after the first failed dial, doubled after
+// every following failure up to max
+func WithWsDialBackoff(base, max time.Duration) WsOption {
+	return func(cfg *WsConfig) {
+		cfg.DialBackoff = base
+		cfg.MaxDialBackoff = max
+	}
+}
+
+// WithWsEventHandler call handler with every typed event and its raw message,
+// after the typed handler of the stream
+func WithWsEventHandler(handler WsEventHandler) WsOption {
+	return func(cfg *WsConfig) {
+		cfg.EventHandler = handler
+	}
+}
+
+// HandleEvent pass event and its raw message to the EventHandler, if any
+func (cfg *WsConfig) HandleEvent(message []byte, event interface{}) {
+	if cfg.EventHandler != nil {
+		cfg.EventHandler(&WsEvent{Event: event, Raw: message})
+	}
+}
+
+// NewWsConfig return the config of a connection to endpoint with the
+// keepalive defaults of the package serving it, modified by opts
+func NewWsConfig(endpoint string, keepalive bool, timeout time.Duration, opts ...WsOption) *WsConfig {
+	cfg := &WsConfig{
+		Endpoint:     endpoint,
+		Keepalive:    keepalive,
+		Timeout:      timeout,
+		WriteTimeout: 10 * time.Second,
+		// dial retries are disabled unless set by WithWsMaxDialRetries
+		DialBackoff:    time.Second,
+		MaxDialBackoff: 30 * time.Second,
+	}
+	for _, opt := range opts {
+		opt(cfg)
+	}
+	return cfg
+}
+
+// WsServe connect to cfg.Endpoint and call handler with every message until
+// stopC is closed or the connection fails, errHandler is called with the error
+// of a failed connection. doneC is closed once serving stopped.
+func WsServe(cfg *WsConfig, handler WsHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+	c, err := DialWs(cfg)
+	if err != nil {
+		return nil, nil, err
+	}
+	doneC = make(chan struct{})
+	stopC = make(chan struct{})
+	go func() {
+		// This function will exit either on error from
+		// websocket.Conn.ReadMessage or when the stopC channel is
+		// closed by the client.
+		defer close(doneC)
+		if cfg.Keepalive {
+			KeepAlive(c, cfg)
+		}
+		if cfg.BufferSize > 0 {
+			buf := newWsBuffer(cfg, handler)
+			defer buf.close()
+			handler = buf.push
+		}
+		// Wait for the stopC channel to be closed.  We do that in a
+		// separate goroutine because ReadMessage is a blocking
+		// operation.
+		silent := false
+		go func() {
+			select {
+			case <-stopC:
+				silent = true
+			case <-doneC:
+			}
+			c.Close()
+		}()
+		for {
+			ExtendReadDeadline(c, cfg)
+			_, message, err := c.ReadMessage()
+			if err != nil {
+				if !silent {
+					errHandler(err)
+				}
+				return
+			}
+			handler(message)
+		}
+	}()
+	return
+}
+
+// DialWs connect to cfg.Endpoint, retrying as set by cfg.MaxDialRetries, and
+// answer server pings
+func DialWs(cfg *WsConfig) (*websocket.Conn, error) {
+	dialer := websocket.DefaultDialer
+	if cfg.Dialer != nil {
+		dialer = cfg.Dialer
+	}
+	c, _, err := dialer.Dial(cfg.Endpoint, nil)
+	backoff := cfg.DialBackoff
+	for retry := 0; err != nil && retry < cfg.MaxDialRetries; retry++ {
+		time.Sleep(backoff)
+		if backoff *= 2; cfg.MaxDialBackoff > 0 && backoff > cfg.MaxDialBackoff {
+			backoff = cfg.MaxDialBackoff
+		}
+		c, _, err = dialer.Dial(cfg.Endpoint, nil)
+	}
+	if err != nil {
+		return nil, err
+	}
+	c.SetReadLimit(655350)
+	// Server pings are answered right away, and count as activity for the
+	// read deadline.
+	c.SetPingHandler(func(msg string) error {
+		ExtendReadDeadline(c, cfg)
+		err := c.WriteControl(websocket.PongMessage, []byte(msg), time.Now().Add(cfg.WriteTimeout))
+		if err == websocket.ErrCloseSent {
+			return nil
+		}
+		return err
+	})
+	return c, nil
+}
+
+// ExtendReadDeadline move the read deadline of c ReadTimeout ahead, if set
+func ExtendReadDeadline(c *websocket.Conn, cfg *WsConfig) {
+	if cfg.ReadTimeout > 0 {
+		c.SetReadDeadline(time.Now().Add(cfg.ReadTimeout))
+	}
+}
+
+// KeepAlive ping c every cfg.Timeout and close it when no pong is received
+// within cfg.Timeout
+func KeepAlive(c *websocket.Conn, cfg *WsConfig) {
+	ticker := time.NewTicker(cfg.Timeout)
+
+	lastResponse := time.Now().UnixNano()
+	c.SetPongHandler(func(msg string) error {
+		atomic.StoreInt64(&lastResponse, time.Now().UnixNano())
+		ExtendReadDeadline(c, cfg)
+		return nil
+	})
+
+	go func() {
+		defer ticker.Stop()
+		for {
+			deadline := time.Now().Add(cfg.WriteTimeout)
+			err := c.WriteControl(websocket.PingMessage, []byte{}, deadline)
+			if err != nil {
+				return
+			}
+			<-ticker.C
+			if time.Since(time.Unix(0, atomic.LoadInt64(&lastResponse))) > cfg.Timeout {
+				c.Close()
+				return
+			}
+		}
+	}()
+}
+
+// wsBuffer feed messages to the handler from a separate goroutine
+type wsBuffer struct {
+	cfg   *WsConfig
+	msgC  chan []byte
+	doneC chan struct{}
+}
+
+func newWsBuffer(cfg *WsConfig, handler WsHandler) *wsBuffer {
+	b := &wsBuffer{
+		cfg:   cfg,
+		msgC:  make(chan []byte, cfg.BufferSize),
+		doneC: make(chan struct{}),
+	}
+	go func() {
+		defer close(b.doneC)
+		for message := range b.msgC {
+			handler(message)
+		}
+	}()
+	return b
+}
+
+func (b *wsBuffer) push(message []byte) {
+	switch b.cfg.BufferPolicy {
+	case WsBufferPolicyDropNewest:
+		select {
+		case b.msgC <- message:
+		default:
+			b.drop()
+		}
+	case WsBufferPolicyDropOldest:
+		for {
+			select {
+			case b.msgC <- message:
+				return
+			default:
+			}
+			select {
+			case <-b.msgC:
+				b.drop()
+			default:
+			}
+		}
+	default:
+		b.msgC <- message
+	}
+}
+
+func (b *wsBuffer) drop() {
+	if b.cfg.Dropped != nil {
+		atomic.AddInt64(b.cfg.Dropped, 1)
+	}
+}
+
+// close wait for the handler to finish the queued messages
+func (b *wsBuffer) close() {
+	close(b.msgC)
+	<-b.doneC
+}
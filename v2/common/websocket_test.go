@@ -0,0 +1,300 @@
+package common
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/base64"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/require"
+)
+
+// wsTestHandler upgrade every request to a websocket connection running serve
+func wsTestHandler(serve func(c *websocket.Conn)) http.Handler {
+	upgrader := websocket.Upgrader{}
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		c, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		defer c.Close()
+		serve(c)
+	})
+}
+
+// newWsTestServer start a websocket server running serve on every connection
+func newWsTestServer(serve func(c *websocket.Conn)) (srv *httptest.Server, endpoint string) {
+	srv = httptest.NewServer(wsTestHandler(serve))
+	return srv, "ws" + strings.TrimPrefix(srv.URL, "http")
+}
+
+// serveHello send a hello message then wait for the connection to be closed
+func serveHello(c *websocket.Conn) {
+	c.WriteMessage(websocket.TextMessage, []byte("hello"))
+	for {
+		if _, _, err := c.ReadMessage(); err != nil {
+			return
+		}
+	}
+}
+
+// newTestWsConfig return the config of endpoint without keepalive, modified by opts
+func newTestWsConfig(endpoint string, opts ...WsOption) *WsConfig {
+	return NewWsConfig(endpoint, false, time.Minute, opts...)
+}
+
+// readFirstMessage serve endpoint until the first message is received
+func readFirstMessage(t *testing.T, endpoint string, opts ...WsOption) string {
+	msgC := make(chan string, 1)
+	doneC, stopC, err := WsServe(newTestWsConfig(endpoint, opts...), func(message []byte) {
+		select {
+		case msgC <- string(message):
+		default:
+		}
+	}, func(err error) {})
+	r := require.New(t)
+	r.NoError(err)
+	defer func() {
+		close(stopC)
+		<-doneC
+	}()
+	select {
+	case msg := <-msgC:
+		return msg
+	case <-time.After(time.Second):
+		r.FailNow("no message received")
+	}
+	return ""
+}
+
+func TestWsServeAnswersServerPing(t *testing.T) {
+	pongC := make(chan string, 1)
+	srv, endpoint := newWsTestServer(func(c *websocket.Conn) {
+		c.SetPongHandler(func(msg string) error {
+			pongC <- msg
+			return nil
+		})
+		c.WriteControl(websocket.PingMessage, []byte("ping"), time.Now().Add(time.Second))
+		for {
+			if _, _, err := c.ReadMessage(); err != nil {
+				return
+			}
+		}
+	})
+	defer srv.Close()
+
+	doneC, stopC, err := WsServe(newTestWsConfig(endpoint), func(message []byte) {}, func(err error) {})
+	r := require.New(t)
+	r.NoError(err)
+	select {
+	case msg := <-pongC:
+		r.Equal("ping", msg)
+	case <-time.After(time.Second):
+		r.Fail("no pong received")
+	}
+	close(stopC)
+	<-doneC
+}
+
+func TestWsServeMissedPong(t *testing.T) {
+	srv, endpoint := newWsTestServer(func(c *websocket.Conn) {
+		// swallow client pings without answering
+		c.SetPingHandler(func(string) error { return nil })
+		for {
+			if _, _, err := c.ReadMessage(); err != nil {
+				return
+			}
+		}
+	})
+	defer srv.Close()
+
+	errC := make(chan error, 1)
+	doneC, _, err := WsServe(newTestWsConfig(endpoint, WithWsKeepalive(50*time.Millisecond)), func(message []byte) {}, func(err error) {
+		errC <- err
+	})
+	r := require.New(t)
+	r.NoError(err)
+	select {
+	case err := <-errC:
+		r.Error(err)
+	case <-time.After(time.Second):
+		r.Fail("error handler not called")
+	}
+	<-doneC
+}
+
+func TestWsServeReadTimeout(t *testing.T) {
+	srv, endpoint := newWsTestServer(func(c *websocket.Conn) {
+		for {
+			if _, _, err := c.ReadMessage(); err != nil {
+				return
+			}
+		}
+	})
+	defer srv.Close()
+
+	errC := make(chan error, 1)
+	doneC, _, err := WsServe(newTestWsConfig(endpoint, WithWsReadTimeout(50*time.Millisecond)), func(message []byte) {}, func(err error) {
+		errC <- err
+	})
+	r := require.New(t)
+	r.NoError(err)
+	select {
+	case err := <-errC:
+		r.Contains(err.Error(), "timeout")
+	case <-time.After(time.Second):
+		r.Fail("error handler not called")
+	}
+	<-doneC
+}
+
+func TestWsServeBufferDropOldest(t *testing.T) {
+	srv, endpoint := newWsTestServer(func(c *websocket.Conn) {
+		for i := 0; i < 20; i++ {
+			c.WriteMessage(websocket.TextMessage, []byte("burst"))
+		}
+		c.WriteMessage(websocket.TextMessage, []byte("last"))
+		for {
+			if _, _, err := c.ReadMessage(); err != nil {
+				return
+			}
+		}
+	})
+	defer srv.Close()
+
+	var dropped int64
+	lastC := make(chan struct{})
+	errC := make(chan error, 1)
+	cfg := newTestWsConfig(endpoint, WithWsBuffer(2, WsBufferPolicyDropOldest, &dropped))
+	doneC, stopC, err := WsServe(cfg, func(message []byte) {
+		time.Sleep(20 * time.Millisecond)
+		if string(message) == "last" {
+			close(lastC)
+		}
+	}, func(err error) {
+		errC <- err
+	})
+	r := require.New(t)
+	r.NoError(err)
+	select {
+	case <-lastC:
+	case err := <-errC:
+		r.FailNow("unexpected error", err)
+	case <-time.After(2 * time.Second):
+		r.FailNow("last message not handled")
+	}
+	r.True(atomic.LoadInt64(&dropped) > 0, "dropped")
+	close(stopC)
+	<-doneC
+	r.Len(errC, 0)
+}
+
+func TestWsServeThroughProxy(t *testing.T) {
+	srv, endpoint := newWsTestServer(serveHello)
+	defer srv.Close()
+
+	authC := make(chan string, 1)
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodConnect {
+			http.Error(w, "CONNECT only", http.StatusMethodNotAllowed)
+			return
+		}
+		authC <- r.Header.Get("Proxy-Authorization")
+		upstream, err := net.Dial("tcp", r.Host)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadGateway)
+			return
+		}
+		defer upstream.Close()
+		conn, _, err := w.(http.Hijacker).Hijack()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		conn.Write([]byte("HTTP/1.1 200 Connection established\r\n\r\n"))
+		go io.Copy(upstream, conn)
+		io.Copy(conn, upstream)
+	}))
+	defer proxy.Close()
+
+	proxyURL, err := url.Parse(proxy.URL)
+	r := require.New(t)
+	r.NoError(err)
+	proxyURL.User = url.UserPassword("user", "pass")
+	r.Equal("hello", readFirstMessage(t, endpoint, WithWsProxy(proxyURL)))
+	r.Equal("Basic "+base64.StdEncoding.EncodeToString([]byte("user:pass")), <-authC)
+}
+
+func TestWsServeTLSConfig(t *testing.T) {
+	srv := httptest.NewTLSServer(wsTestHandler(serveHello))
+	defer srv.Close()
+	endpoint := "wss" + strings.TrimPrefix(srv.URL, "https")
+
+	pool := x509.NewCertPool()
+	pool.AddCert(srv.Certificate())
+	require.Equal(t, "hello", readFirstMessage(t, endpoint, WithWsTLSConfig(&tls.Config{RootCAs: pool})))
+}
+
+func TestWsServeDialer(t *testing.T) {
+	srv, endpoint := newWsTestServer(serveHello)
+	defer srv.Close()
+
+	dialed := int32(0)
+	dialer := &websocket.Dialer{
+		NetDial: func(network, addr string) (net.Conn, error) {
+			atomic.AddInt32(&dialed, 1)
+			return net.Dial(network, addr)
+		},
+	}
+	r := require.New(t)
+	r.Equal("hello", readFirstMessage(t, endpoint, WithWsDialer(dialer)))
+	r.Equal(int32(1), atomic.LoadInt32(&dialed))
+}
+
+func TestWsServeDialRetries(t *testing.T) {
+	attempts := int32(0)
+	ws := wsTestHandler(serveHello)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&attempts, 1) <= 2 {
+			http.Error(w, "unavailable", http.StatusServiceUnavailable)
+			return
+		}
+		ws.ServeHTTP(w, r)
+	}))
+	defer srv.Close()
+	endpoint := "ws" + strings.TrimPrefix(srv.URL, "http")
+
+	r := require.New(t)
+	cfg := newTestWsConfig(endpoint, WithWsMaxDialRetries(1), WithWsDialBackoff(time.Millisecond, 2*time.Millisecond))
+	_, _, err := WsServe(cfg, func([]byte) {}, func(error) {})
+	r.Error(err)
+	r.Equal(int32(2), atomic.LoadInt32(&attempts))
+
+	atomic.StoreInt32(&attempts, 0)
+	r.Equal("hello", readFirstMessage(t, endpoint, WithWsMaxDialRetries(3), WithWsDialBackoff(time.Millisecond, 2*time.Millisecond)))
+	r.Equal(int32(3), atomic.LoadInt32(&attempts))
+}
+
+func TestNewWsConfig(t *testing.T) {
+	r := require.New(t)
+	cfg := NewWsConfig("wss://stream.binance.com:9443/ws/btcusdt@trade", true, time.Minute)
+	r.True(cfg.Keepalive)
+	r.Equal(time.Minute, cfg.Timeout)
+	r.Equal(10*time.Second, cfg.WriteTimeout)
+	r.Equal(0, cfg.MaxDialRetries)
+
+	cfg = NewWsConfig("wss://stream.binance.com:9443/ws/btcusdt@trade", false, time.Minute,
+		WithWsKeepalive(time.Second), WithWsReadTimeout(5*time.Second))
+	r.True(cfg.Keepalive)
+	r.Equal(time.Second, cfg.Timeout)
+	r.Equal(5*time.Second, cfg.ReadTimeout)
+}
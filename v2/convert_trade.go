@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"net/http"
+	"time"
 )
 
 type ConvertTradeHistoryService struct {
@@ -19,12 +20,22 @@ func (s *ConvertTradeHistoryService) StartTime(startTime int64) *ConvertTradeHis
 	return s
 }
 
+// StartTimeFrom set startTime from t, converted to epoch milliseconds
+func (s *ConvertTradeHistoryService) StartTimeFrom(t time.Time) *ConvertTradeHistoryService {
+	return s.StartTime(FormatTimestamp(t))
+}
+
 // EndTime set endTime
 func (s *ConvertTradeHistoryService) EndTime(endTime int64) *ConvertTradeHistoryService {
 	s.endTime = endTime
 	return s
 }
 
+// EndTimeFrom set endTime from t, converted to epoch milliseconds
+func (s *ConvertTradeHistoryService) EndTimeFrom(t time.Time) *ConvertTradeHistoryService {
+	return s.EndTime(FormatTimestamp(t))
+}
+
 // Limit set limit
 func (s *ConvertTradeHistoryService) Limit(limit int32) *ConvertTradeHistoryService {
 	s.limit = &limit
@@ -76,3 +87,166 @@ type ConvertTradeHistoryItem struct {
 	InverseRatio string `json:"inverseRatio"`
 	CreateTime   int64  `json:"createTime"`
 }
+
+// ConvertPlaceLimitOrderService place a convert limit order
+type ConvertPlaceLimitOrderService struct {
+	c           *Client
+	baseAsset   string
+	quoteAsset  string
+	limitPrice  string
+	baseAmount  *string
+	quoteAmount *string
+	side        SideType
+	expiredType ConvertExpiredType
+}
+
+// BaseAsset set baseAsset
+func (s *ConvertPlaceLimitOrderService) BaseAsset(baseAsset string) *ConvertPlaceLimitOrderService {
+	s.baseAsset = baseAsset
+	return s
+}
+
+// QuoteAsset set quoteAsset
+func (s *ConvertPlaceLimitOrderService) QuoteAsset(quoteAsset string) *ConvertPlaceLimitOrderService {
+	s.quoteAsset = quoteAsset
+	return s
+}
+
+// LimitPrice set limitPrice, symbol price in quote asset
+func (s *ConvertPlaceLimitOrderService) LimitPrice(limitPrice string) *ConvertPlaceLimitOrderService {
+	s.limitPrice = limitPrice
+	return s
+}
+
+// BaseAmount set baseAmount, only one of baseAmount and quoteAmount should be set
+func (s *ConvertPlaceLimitOrderService) BaseAmount(baseAmount string) *ConvertPlaceLimitOrderService {
+	s.baseAmount = &baseAmount
+	return s
+}
+
+// QuoteAmount set quoteAmount, only one of baseAmount and quoteAmount should be set
+func (s *ConvertPlaceLimitOrderService) QuoteAmount(quoteAmount string) *ConvertPlaceLimitOrderService {
+	s.quoteAmount = &quoteAmount
+	return s
+}
+
+// Side set side
+func (s *ConvertPlaceLimitOrderService) Side(side SideType) *ConvertPlaceLimitOrderService {
+	s.side = side
+	return s
+}
+
+// ExpiredType set expiredType
+func (s *ConvertPlaceLimitOrderService) ExpiredType(expiredType ConvertExpiredType) *ConvertPlaceLimitOrderService {
+	s.expiredType = expiredType
+	return s
+}
+
+// Do send request
+func (s *ConvertPlaceLimitOrderService) Do(ctx context.Context, opts ...RequestOption) (*ConvertLimitOrder, error) {
+	r := &request{
+		method:   http.MethodPost,
+		endpoint: "/sapi/v1/convert/limit/placeOrder",
+		secType:  secTypeSigned,
+	}
+	m := params{
+		"baseAsset":   s.baseAsset,
+		"quoteAsset":  s.quoteAsset,
+		"limitPrice":  s.limitPrice,
+		"side":        s.side,
+		"expiredType": s.expiredType,
+	}
+	if s.baseAmount != nil {
+		m["baseAmount"] = *s.baseAmount
+	}
+	if s.quoteAmount != nil {
+		m["quoteAmount"] = *s.quoteAmount
+	}
+	r.setFormParams(m)
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res := ConvertLimitOrder{}
+	if err = json.Unmarshal(data, &res); err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
+
+// ConvertCancelLimitOrderService cancel a convert limit order
+type ConvertCancelLimitOrderService struct {
+	c       *Client
+	orderID int64
+}
+
+// OrderID set orderId
+func (s *ConvertCancelLimitOrderService) OrderID(orderID int64) *ConvertCancelLimitOrderService {
+	s.orderID = orderID
+	return s
+}
+
+// Do send request
+func (s *ConvertCancelLimitOrderService) Do(ctx context.Context, opts ...RequestOption) (*ConvertLimitOrder, error) {
+	r := &request{
+		method:   http.MethodPost,
+		endpoint: "/sapi/v1/convert/limit/cancelOrder",
+		secType:  secTypeSigned,
+	}
+	r.setFormParam("orderId", s.orderID)
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res := ConvertLimitOrder{}
+	if err = json.Unmarshal(data, &res); err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
+
+// ConvertLimitOrder define the result of placing or cancelling a convert limit order
+type ConvertLimitOrder struct {
+	OrderId int64  `json:"orderId"`
+	Status  string `json:"status"`
+}
+
+// ConvertQueryLimitOpenOrdersService list open convert limit orders
+type ConvertQueryLimitOpenOrdersService struct {
+	c *Client
+}
+
+// Do send request
+func (s *ConvertQueryLimitOpenOrdersService) Do(ctx context.Context, opts ...RequestOption) ([]ConvertLimitOpenOrder, error) {
+	r := &request{
+		method:   http.MethodGet,
+		endpoint: "/sapi/v1/convert/limit/queryOpenOrders",
+		secType:  secTypeSigned,
+	}
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res := struct {
+		List []ConvertLimitOpenOrder `json:"list"`
+	}{}
+	if err = json.Unmarshal(data, &res); err != nil {
+		return nil, err
+	}
+	return res.List, nil
+}
+
+// ConvertLimitOpenOrder define an open convert limit order
+type ConvertLimitOpenOrder struct {
+	QuoteId          string `json:"quoteId"`
+	OrderId          int64  `json:"orderId"`
+	OrderStatus      string `json:"orderStatus"`
+	FromAsset        string `json:"fromAsset"`
+	FromAmount       string `json:"fromAmount"`
+	ToAsset          string `json:"toAsset"`
+	ToAmount         string `json:"toAmount"`
+	Ratio            string `json:"ratio"`
+	InverseRatio     string `json:"inverseRatio"`
+	CreateTime       int64  `json:"createTime"`
+	ExpiredTimestamp int64  `json:"expiredTimestamp"`
+}
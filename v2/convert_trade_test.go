@@ -103,3 +103,103 @@ func (s *convertTradeTestSuite) assertConvertTradeHistoryItemEqual(e, a *Convert
 	r.Equal(e.InverseRatio, a.InverseRatio, "InverseRatio")
 	r.Equal(e.CreateTime, a.CreateTime, "CreateTime")
 }
+
+func (s *convertTradeTestSuite) TestConvertPlaceLimitOrder() {
+	data := []byte(`{
+		"orderId": 1603680255057330400,
+		"status": "PROCESS"
+	}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setFormParams(params{
+			"baseAsset":   "BTC",
+			"quoteAsset":  "USDT",
+			"limitPrice":  "40000",
+			"baseAmount":  "0.01",
+			"side":        SideTypeBuy,
+			"expiredType": ConvertExpiredType7D,
+		})
+		s.assertRequestEqual(e, r)
+	})
+
+	res, err := s.client.NewConvertPlaceLimitOrderService().
+		BaseAsset("BTC").
+		QuoteAsset("USDT").
+		LimitPrice("40000").
+		BaseAmount("0.01").
+		Side(SideTypeBuy).
+		ExpiredType(ConvertExpiredType7D).
+		Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	r.Equal(&ConvertLimitOrder{OrderId: 1603680255057330400, Status: "PROCESS"}, res)
+}
+
+func (s *convertTradeTestSuite) TestConvertCancelLimitOrder() {
+	data := []byte(`{
+		"orderId": 1603680255057330400,
+		"status": "CANCELED"
+	}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	orderID := int64(1603680255057330400)
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setFormParams(params{
+			"orderId": orderID,
+		})
+		s.assertRequestEqual(e, r)
+	})
+
+	res, err := s.client.NewConvertCancelLimitOrderService().OrderID(orderID).Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	r.Equal(&ConvertLimitOrder{OrderId: orderID, Status: "CANCELED"}, res)
+}
+
+func (s *convertTradeTestSuite) TestConvertQueryLimitOpenOrders() {
+	data := []byte(`{
+		"list": [
+			{
+				"quoteId": "18sdf87kh9df",
+				"orderId": 1150901289839,
+				"orderStatus": "PROCESS",
+				"fromAsset": "BNB",
+				"fromAmount": "10",
+				"toAsset": "USDT",
+				"toAmount": "2317.89",
+				"ratio": "231.789",
+				"inverseRatio": "0.00431427",
+				"createTime": 1614089498000,
+				"expiredTimestamp": 1614694298000
+			}
+		]
+	}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	s.assertReq(func(r *request) {
+		s.assertRequestEqual(newSignedRequest(), r)
+	})
+
+	res, err := s.client.NewConvertQueryLimitOpenOrdersService().Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	r.Equal([]ConvertLimitOpenOrder{
+		{
+			QuoteId:          "18sdf87kh9df",
+			OrderId:          1150901289839,
+			OrderStatus:      "PROCESS",
+			FromAsset:        "BNB",
+			FromAmount:       "10",
+			ToAsset:          "USDT",
+			ToAmount:         "2317.89",
+			Ratio:            "231.789",
+			InverseRatio:     "0.00431427",
+			CreateTime:       1614089498000,
+			ExpiredTimestamp: 1614694298000,
+		},
+	}, res)
+}
@@ -162,8 +162,9 @@ const (
 	recvWindowKey = "recvWindow"
 )
 
-func currentTimestamp() int64 {
-	return int64(time.Nanosecond) * time.Now().UnixNano() / int64(time.Millisecond)
+// FormatTimestamp formats a time into Unix timestamp in milliseconds, as requested by Binance.
+func FormatTimestamp(t time.Time) int64 {
+	return t.UnixNano() / int64(time.Millisecond)
 }
 
 func newJSON(data []byte) (j *simplejson.Json, err error) {
@@ -209,6 +210,26 @@ type Client struct {
 	Logger     *log.Logger
 	TimeOffset int64
 	do         doFunc
+	nowFunc    func() time.Time
+}
+
+// SetUserAgent set the User-Agent header sent with every request,
+// WithHeader can still override it per request
+func (c *Client) SetUserAgent(userAgent string) {
+	c.UserAgent = userAgent
+}
+
+// SetClock set the clock read for request timestamps, e.g. a fixed time in
+// tests. A nil now restores the system clock
+func (c *Client) SetClock(now func() time.Time) {
+	c.nowFunc = now
+}
+
+func (c *Client) now() time.Time {
+	if c.nowFunc != nil {
+		return c.nowFunc()
+	}
+	return time.Now()
 }
 
 func (c *Client) debug(format string, v ...interface{}) {
@@ -232,7 +253,7 @@ func (c *Client) parseRequest(r *request, opts ...RequestOption) (err error) {
 		r.setParam(recvWindowKey, r.recvWindow)
 	}
 	if r.secType == secTypeSigned {
-		r.setParam(timestampKey, currentTimestamp()-c.TimeOffset)
+		r.setParam(timestampKey, FormatTimestamp(c.now())-c.TimeOffset)
 	}
 	queryString := r.query.Encode()
 	body := &bytes.Buffer{}
@@ -241,6 +262,9 @@ func (c *Client) parseRequest(r *request, opts ...RequestOption) (err error) {
 	if r.header != nil {
 		header = r.header.Clone()
 	}
+	if c.UserAgent != "" && header.Get("User-Agent") == "" {
+		header.Set("User-Agent", c.UserAgent)
+	}
 	if bodyString != "" {
 		header.Set("Content-Type", "application/x-www-form-urlencoded")
 		body = bytes.NewBufferString(bodyString)
@@ -250,7 +274,11 @@ func (c *Client) parseRequest(r *request, opts ...RequestOption) (err error) {
 	}
 
 	if r.secType == secTypeSigned {
+		// url.Values.Encode sorts the params by key, so the payload is stable
 		raw := fmt.Sprintf("%s%s", queryString, bodyString)
+		if r.signDebug != nil {
+			r.signDebug(raw)
+		}
 		mac := hmac.New(sha256.New, []byte(c.SecretKey))
 		_, err = mac.Write([]byte(raw))
 		if err != nil {
@@ -293,11 +321,14 @@ func (c *Client) callAPI(ctx context.Context, r *request, opts ...RequestOption)
 	}
 	res, err := f(req)
 	if err != nil {
-		return []byte{}, err
+		return []byte{}, common.WrapRequestError(ctx, r.endpoint, err)
 	}
 	data, err = ioutil.ReadAll(res.Body)
 	if err != nil {
-		return []byte{}, err
+		return []byte{}, common.WrapRequestError(ctx, r.endpoint, err)
+	}
+	if r.rawResponse != nil {
+		*r.rawResponse = data
 	}
 	defer func() {
 		cerr := res.Body.Close()
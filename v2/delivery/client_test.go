@@ -5,7 +5,9 @@ import (
 	"context"
 	"io/ioutil"
 	"net/http"
+	"net/http/httptest"
 	"net/url"
+	"testing"
 
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
@@ -142,3 +144,28 @@ func (m *mockedClient) do(req *http.Request) (*http.Response, error) {
 	args := m.Called(req)
 	return args.Get(0).(*http.Response), args.Error(1)
 }
+
+func TestWithRawResponse(t *testing.T) {
+	body := []byte(`{"serverTime": 1499827319559, "newField": "not modeled"}`)
+	status := http.StatusOK
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(status)
+		w.Write(body)
+	}))
+	defer srv.Close()
+
+	c := NewClient("dummyAPIKey", "dummySecretKey")
+	c.BaseURL = srv.URL
+	var raw []byte
+	serverTime, err := c.NewServerTimeService().Do(newContext(), WithRawResponse(&raw))
+	r := require.New(t)
+	r.NoError(err)
+	r.Equal(int64(1499827319559), serverTime)
+	r.Equal(body, raw)
+
+	body = []byte(`{"code":-1121,"msg":"Invalid symbol."}`)
+	status = http.StatusBadRequest
+	_, err = c.NewServerTimeService().Do(newContext(), WithRawResponse(&raw))
+	r.Error(err)
+	r.Equal(body, raw)
+}
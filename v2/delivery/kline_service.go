@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"time"
 )
 
 // KlinesService list klines
@@ -40,12 +41,22 @@ func (s *KlinesService) StartTime(startTime int64) *KlinesService {
 	return s
 }
 
+// StartTimeFrom set startTime from t, converted to epoch milliseconds
+func (s *KlinesService) StartTimeFrom(t time.Time) *KlinesService {
+	return s.StartTime(FormatTimestamp(t))
+}
+
 // EndTime set endTime
 func (s *KlinesService) EndTime(endTime int64) *KlinesService {
 	s.endTime = &endTime
 	return s
 }
 
+// EndTimeFrom set endTime from t, converted to epoch milliseconds
+func (s *KlinesService) EndTimeFrom(t time.Time) *KlinesService {
+	return s.EndTime(FormatTimestamp(t))
+}
+
 // Do send request
 func (s *KlinesService) Do(ctx context.Context, opts ...RequestOption) (res []*Kline, err error) {
 	r := &request{
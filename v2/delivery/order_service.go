@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"net/http"
+	"time"
 )
 
 // CreateOrderService create order
@@ -378,12 +379,22 @@ func (s *ListOrdersService) StartTime(startTime int64) *ListOrdersService {
 	return s
 }
 
+// StartTimeFrom set startTime from t, converted to epoch milliseconds
+func (s *ListOrdersService) StartTimeFrom(t time.Time) *ListOrdersService {
+	return s.StartTime(FormatTimestamp(t))
+}
+
 // EndTime set endtime
 func (s *ListOrdersService) EndTime(endTime int64) *ListOrdersService {
 	s.endTime = &endTime
 	return s
 }
 
+// EndTimeFrom set endTime from t, converted to epoch milliseconds
+func (s *ListOrdersService) EndTimeFrom(t time.Time) *ListOrdersService {
+	return s.EndTime(FormatTimestamp(t))
+}
+
 // Limit set limit
 func (s *ListOrdersService) Limit(limit int) *ListOrdersService {
 	s.limit = &limit
@@ -562,12 +573,22 @@ func (s *ListLiquidationOrdersService) StartTime(startTime int64) *ListLiquidati
 	return s
 }
 
+// StartTimeFrom set startTime from t, converted to epoch milliseconds
+func (s *ListLiquidationOrdersService) StartTimeFrom(t time.Time) *ListLiquidationOrdersService {
+	return s.StartTime(FormatTimestamp(t))
+}
+
 // EndTime set startTime
 func (s *ListLiquidationOrdersService) EndTime(endTime int64) *ListLiquidationOrdersService {
 	s.endTime = &endTime
 	return s
 }
 
+// EndTimeFrom set endTime from t, converted to epoch milliseconds
+func (s *ListLiquidationOrdersService) EndTimeFrom(t time.Time) *ListLiquidationOrdersService {
+	return s.EndTime(FormatTimestamp(t))
+}
+
 // Limit set limit
 func (s *ListLiquidationOrdersService) Limit(limit int) *ListLiquidationOrdersService {
 	s.limit = &limit
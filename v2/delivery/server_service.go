@@ -54,7 +54,7 @@ func (s *SetServerTimeService) Do(ctx context.Context, opts ...RequestOption) (t
 	if err != nil {
 		return 0, err
 	}
-	timeOffset = currentTimestamp() - serverTime
+	timeOffset = FormatTimestamp(s.c.now()) - serverTime
 	s.c.TimeOffset = timeOffset
 	return timeOffset, nil
 }
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"net/http"
+	"time"
 )
 
 // ListDepositsService fetches deposit history.
@@ -38,6 +39,11 @@ func (s *ListDepositsService) StartTime(startTime int64) *ListDepositsService {
 	return s
 }
 
+// StartTimeFrom set startTime from t, converted to epoch milliseconds
+func (s *ListDepositsService) StartTimeFrom(t time.Time) *ListDepositsService {
+	return s.StartTime(FormatTimestamp(t))
+}
+
 // EndTime sets the endTime parameter.
 // If present, StartTime MUST be specified. The difference between EndTime - StartTime MUST be between 0-90 days.
 func (s *ListDepositsService) EndTime(endTime int64) *ListDepositsService {
@@ -45,6 +51,11 @@ func (s *ListDepositsService) EndTime(endTime int64) *ListDepositsService {
 	return s
 }
 
+// EndTimeFrom set endTime from t, converted to epoch milliseconds
+func (s *ListDepositsService) EndTimeFrom(t time.Time) *ListDepositsService {
+	return s.EndTime(FormatTimestamp(t))
+}
+
 // Offset set offset
 func (s *ListDepositsService) Offset(offset int) *ListDepositsService {
 	s.offset = &offset
@@ -164,3 +175,210 @@ type GetDepositAddressResponse struct {
 	Coin    string `json:"coin"`
 	URL     string `json:"url"`
 }
+
+// GetDepositAddressListService retrieves the deposit addresses of a coin on
+// all its networks.
+//
+// See https://binance-docs.github.io/apidocs/spot/en/#fetch-deposit-address-list-with-network-user_data
+type GetDepositAddressListService struct {
+	c    *Client
+	coin string
+}
+
+// Coin sets the coin parameter (MANDATORY).
+func (s *GetDepositAddressListService) Coin(coin string) *GetDepositAddressListService {
+	s.coin = coin
+	return s
+}
+
+// Do sends the request.
+func (s *GetDepositAddressListService) Do(ctx context.Context, opts ...RequestOption) ([]*DepositAddress, error) {
+	r := &request{
+		method:   http.MethodGet,
+		endpoint: "/sapi/v1/capital/deposit/address/list",
+		secType:  secTypeSigned,
+	}
+	r.setParam("coin", s.coin)
+
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]*DepositAddress, 0)
+	if err := json.Unmarshal(data, &res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// DepositAddress represents a deposit address of a coin on one network.
+type DepositAddress struct {
+	Coin      string `json:"coin"`
+	Address   string `json:"address"`
+	Tag       string `json:"tag"`
+	IsDefault int    `json:"isDefault"`
+	Network   string `json:"network"`
+}
+
+// GetSubAccountDepositAddressService retrieves the deposit address of a sub-account,
+// called by the master account.
+//
+// See https://binance-docs.github.io/apidocs/spot/en/#get-sub-account-deposit-address-for-master-account
+type GetSubAccountDepositAddressService struct {
+	c       *Client
+	email   string
+	coin    string
+	network *string
+}
+
+// Email sets the sub-account email parameter (MANDATORY).
+func (s *GetSubAccountDepositAddressService) Email(email string) *GetSubAccountDepositAddressService {
+	s.email = email
+	return s
+}
+
+// Coin sets the coin parameter (MANDATORY).
+func (s *GetSubAccountDepositAddressService) Coin(coin string) *GetSubAccountDepositAddressService {
+	s.coin = coin
+	return s
+}
+
+// Network sets the network parameter.
+func (s *GetSubAccountDepositAddressService) Network(network string) *GetSubAccountDepositAddressService {
+	s.network = &network
+	return s
+}
+
+// Do sends the request.
+func (s *GetSubAccountDepositAddressService) Do(ctx context.Context, opts ...RequestOption) (*GetDepositAddressResponse, error) {
+	r := &request{
+		method:   http.MethodGet,
+		endpoint: "/sapi/v1/capital/deposit/subAddress",
+		secType:  secTypeSigned,
+	}
+	r.setParam("email", s.email)
+	r.setParam("coin", s.coin)
+	if s.network != nil {
+		r.setParam("network", *s.network)
+	}
+
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &GetDepositAddressResponse{}
+	if err := json.Unmarshal(data, res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// GetSubAccountDepositHistoryService fetches deposit history of a sub-account,
+// called by the master account.
+//
+// See https://binance-docs.github.io/apidocs/spot/en/#get-sub-account-deposit-history-for-master-account
+type GetSubAccountDepositHistoryService struct {
+	c         *Client
+	email     string
+	coin      *string
+	status    *int
+	startTime *int64
+	endTime   *int64
+	offset    *int
+	limit     *int
+}
+
+// Email sets the sub-account email parameter (MANDATORY).
+func (s *GetSubAccountDepositHistoryService) Email(email string) *GetSubAccountDepositHistoryService {
+	s.email = email
+	return s
+}
+
+// Coin sets the coin parameter.
+func (s *GetSubAccountDepositHistoryService) Coin(coin string) *GetSubAccountDepositHistoryService {
+	s.coin = &coin
+	return s
+}
+
+// Status sets the status parameter.
+func (s *GetSubAccountDepositHistoryService) Status(status int) *GetSubAccountDepositHistoryService {
+	s.status = &status
+	return s
+}
+
+// StartTime sets the startTime parameter.
+func (s *GetSubAccountDepositHistoryService) StartTime(startTime int64) *GetSubAccountDepositHistoryService {
+	s.startTime = &startTime
+	return s
+}
+
+// StartTimeFrom set startTime from t, converted to epoch milliseconds
+func (s *GetSubAccountDepositHistoryService) StartTimeFrom(t time.Time) *GetSubAccountDepositHistoryService {
+	return s.StartTime(FormatTimestamp(t))
+}
+
+// EndTime sets the endTime parameter.
+func (s *GetSubAccountDepositHistoryService) EndTime(endTime int64) *GetSubAccountDepositHistoryService {
+	s.endTime = &endTime
+	return s
+}
+
+// EndTimeFrom set endTime from t, converted to epoch milliseconds
+func (s *GetSubAccountDepositHistoryService) EndTimeFrom(t time.Time) *GetSubAccountDepositHistoryService {
+	return s.EndTime(FormatTimestamp(t))
+}
+
+// Offset set offset
+func (s *GetSubAccountDepositHistoryService) Offset(offset int) *GetSubAccountDepositHistoryService {
+	s.offset = &offset
+	return s
+}
+
+// Limit set limit
+func (s *GetSubAccountDepositHistoryService) Limit(limit int) *GetSubAccountDepositHistoryService {
+	s.limit = &limit
+	return s
+}
+
+// Do sends the request.
+func (s *GetSubAccountDepositHistoryService) Do(ctx context.Context, opts ...RequestOption) (res []*Deposit, err error) {
+	r := &request{
+		method:   http.MethodGet,
+		endpoint: "/sapi/v1/capital/deposit/subHisrec",
+		secType:  secTypeSigned,
+	}
+	r.setParam("email", s.email)
+	if s.coin != nil {
+		r.setParam("coin", *s.coin)
+	}
+	if s.status != nil {
+		r.setParam("status", *s.status)
+	}
+	if s.startTime != nil {
+		r.setParam("startTime", *s.startTime)
+	}
+	if s.endTime != nil {
+		r.setParam("endTime", *s.endTime)
+	}
+	if s.offset != nil {
+		r.setParam("offset", *s.offset)
+	}
+	if s.limit != nil {
+		r.setParam("limit", *s.limit)
+	}
+
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return
+	}
+	res = make([]*Deposit, 0)
+	err = json.Unmarshal(data, &res)
+	if err != nil {
+		return
+	}
+	return res, nil
+}
@@ -146,3 +146,93 @@ func (s *depositServiceTestSuite) TestGetDepositAddress() {
 	r.Equal("BTC", res.Coin)
 	r.Equal("https://btc.com/1HPn8Rx2y6nNSfagQBKy27GB99Vbzg89wv", res.URL)
 }
+
+func (s *depositServiceTestSuite) TestGetDepositAddressList() {
+	data := []byte(`
+	[
+		{
+			"coin": "USDT",
+			"address": "0x1b9d2b9d4f3b1c5e2a6d8f7e9c0a1b2c3d4e5f60",
+			"tag": "",
+			"isDefault": 1,
+			"network": "ETH"
+		},
+		{
+			"coin": "USDT",
+			"address": "TDunhSa7jkTNuKrusUTU1MUHtqXoBPKETV",
+			"tag": "",
+			"isDefault": 0,
+			"network": "TRX"
+		}
+	]
+	`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	coin := "USDT"
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setParams(params{
+			"coin": coin,
+		})
+		s.assertRequestEqual(e, r)
+	})
+
+	res, err := s.client.NewGetDepositAddressListService().
+		Coin(coin).
+		Do(newContext())
+
+	r := s.r()
+	r.NoError(err)
+	r.Equal([]*DepositAddress{
+		{
+			Coin:      "USDT",
+			Address:   "0x1b9d2b9d4f3b1c5e2a6d8f7e9c0a1b2c3d4e5f60",
+			IsDefault: 1,
+			Network:   "ETH",
+		},
+		{
+			Coin:      "USDT",
+			Address:   "TDunhSa7jkTNuKrusUTU1MUHtqXoBPKETV",
+			IsDefault: 0,
+			Network:   "TRX",
+		},
+	}, res)
+}
+
+func (s *depositServiceTestSuite) TestGetSubAccountDepositAddress() {
+	data := []byte(`
+	{
+		"address": "TDunhSa7jkTNuKrusUTU1MUHtqXoBPKETV",
+		"coin": "USDT",
+		"tag": "",
+		"url": "https://tronscan.org/#/address/TDunhSa7jkTNuKrusUTU1MUHtqXoBPKETV"
+	}
+	`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	email := "sub@example.com"
+	coin := "USDT"
+	network := "TRX"
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setParams(params{
+			"email":   email,
+			"coin":    coin,
+			"network": network,
+		})
+		s.assertRequestEqual(e, r)
+	})
+
+	res, err := s.client.NewGetSubAccountDepositAddressService().
+		Email(email).
+		Coin(coin).
+		Network(network).
+		Do(newContext())
+
+	r := s.r()
+	r.NoError(err)
+	r.Equal("TDunhSa7jkTNuKrusUTU1MUHtqXoBPKETV", res.Address)
+	r.Equal("", res.Tag)
+	r.Equal("USDT", res.Coin)
+	r.Equal("https://tronscan.org/#/address/TDunhSa7jkTNuKrusUTU1MUHtqXoBPKETV", res.URL)
+}
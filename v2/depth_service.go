@@ -2,7 +2,10 @@ package binance
 
 import (
 	"context"
+	"errors"
+	"math/big"
 	"net/http"
+	"strings"
 
 	"github.com/adshao/go-binance/v2/common"
 )
@@ -74,6 +77,94 @@ type DepthResponse struct {
 	Asks         []Ask `json:"asks"`
 }
 
+// ErrEmptyOrderBook is returned by the DepthResponse helpers when a side of
+// the book has no levels
+var ErrEmptyOrderBook = errors.New("order book side is empty")
+
+// Spread return the best ask price minus the best bid price
+func (d *DepthResponse) Spread() (string, error) {
+	bid, ask, err := d.bestPrices()
+	if err != nil {
+		return "", err
+	}
+	decimals := priceDecimals(d.Bids[0].Price, d.Asks[0].Price)
+	return new(big.Rat).Sub(ask, bid).FloatString(decimals), nil
+}
+
+// MidPrice return the average of the best bid and the best ask price
+func (d *DepthResponse) MidPrice() (string, error) {
+	bid, ask, err := d.bestPrices()
+	if err != nil {
+		return "", err
+	}
+	// halving adds at most one decimal
+	decimals := priceDecimals(d.Bids[0].Price, d.Asks[0].Price) + 1
+	mid := new(big.Rat).Add(bid, ask)
+	mid.Quo(mid, big.NewRat(2, 1))
+	return mid.FloatString(decimals), nil
+}
+
+// Imbalance return (bidVol-askVol)/(bidVol+askVol) of the quantities of the
+// top levels of each side, from -1 when only asks to 1 when only bids
+func (d *DepthResponse) Imbalance(levels int) (float64, error) {
+	if levels <= 0 {
+		return 0, errors.New("levels must be positive")
+	}
+	bidVol, err := levelsVolume(d.Bids, levels)
+	if err != nil {
+		return 0, err
+	}
+	askVol, err := levelsVolume(d.Asks, levels)
+	if err != nil {
+		return 0, err
+	}
+	if bidVol+askVol == 0 {
+		return 0, ErrEmptyOrderBook
+	}
+	return (bidVol - askVol) / (bidVol + askVol), nil
+}
+
+func (d *DepthResponse) bestPrices() (bid, ask *big.Rat, err error) {
+	if len(d.Bids) == 0 || len(d.Asks) == 0 {
+		return nil, nil, ErrEmptyOrderBook
+	}
+	if bid, err = common.ParseDecimal(d.Bids[0].Price); err != nil {
+		return nil, nil, err
+	}
+	if ask, err = common.ParseDecimal(d.Asks[0].Price); err != nil {
+		return nil, nil, err
+	}
+	return bid, ask, nil
+}
+
+// levelsVolume sum the quantities of the first n levels
+func levelsVolume(levels []common.PriceLevel, n int) (float64, error) {
+	if n > len(levels) {
+		n = len(levels)
+	}
+	var volume float64
+	for i := 0; i < n; i++ {
+		_, quantity, err := levels[i].Parse()
+		if err != nil {
+			return 0, err
+		}
+		volume += quantity
+	}
+	return volume, nil
+}
+
+// priceDecimals return the largest number of decimals of prices, so results
+// keep the precision the prices are quoted with
+func priceDecimals(prices ...string) int {
+	decimals := 0
+	for _, p := range prices {
+		if i := strings.IndexByte(p, '.'); i >= 0 && len(p)-i-1 > decimals {
+			decimals = len(p) - i - 1
+		}
+	}
+	return decimals
+}
+
 // Ask is a type alias for PriceLevel.
 type Ask = common.PriceLevel
 
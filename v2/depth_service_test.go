@@ -75,3 +75,45 @@ func (s *depthServiceTestSuite) assertDepthResponseEqual(e, a *DepthResponse) {
 		r.Equal(e.Asks[i].Quantity, a.Asks[i].Quantity, "Quantity")
 	}
 }
+
+func (s *depthServiceTestSuite) TestDepthHelpers() {
+	depth := &DepthResponse{
+		Bids: []Bid{
+			{Price: "100.10", Quantity: "3.0"},
+			{Price: "100.00", Quantity: "2.0"},
+			{Price: "99.90", Quantity: "5.0"},
+		},
+		Asks: []Ask{
+			{Price: "100.30", Quantity: "1.0"},
+			{Price: "100.40", Quantity: "4.0"},
+		},
+	}
+	r := s.r()
+	spread, err := depth.Spread()
+	r.NoError(err)
+	r.Equal("0.20", spread)
+	mid, err := depth.MidPrice()
+	r.NoError(err)
+	r.Equal("100.200", mid)
+
+	// (3 - 1) / (3 + 1)
+	imbalance, err := depth.Imbalance(1)
+	r.NoError(err)
+	r.InDelta(0.5, imbalance, 1e-9)
+	// (5 - 5) / (5 + 5)
+	imbalance, err = depth.Imbalance(2)
+	r.NoError(err)
+	r.InDelta(0, imbalance, 1e-9)
+	// levels beyond the book use every level: (10 - 5) / (10 + 5)
+	imbalance, err = depth.Imbalance(10)
+	r.NoError(err)
+	r.InDelta(1.0/3, imbalance, 1e-9)
+
+	_, err = depth.Imbalance(0)
+	r.Error(err)
+	empty := &DepthResponse{Bids: depth.Bids}
+	_, err = empty.Spread()
+	r.Equal(ErrEmptyOrderBook, err)
+	_, err = empty.MidPrice()
+	r.Equal(ErrEmptyOrderBook, err)
+}
@@ -0,0 +1,179 @@
+package binance
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+)
+
+// DualInvestmentListService https://binance-docs.github.io/apidocs/spot/en/#get-dual-investment-product-list-user_data
+type DualInvestmentListService struct {
+	c             *Client
+	optionType    DualInvestmentOptionType
+	exercisedCoin string
+	investCoin    string
+	pageSize      *int
+	pageIndex     *int
+}
+
+// OptionType set optionType, CALL to invest the exercised coin, PUT to invest the quote coin
+func (s *DualInvestmentListService) OptionType(optionType DualInvestmentOptionType) *DualInvestmentListService {
+	s.optionType = optionType
+	return s
+}
+
+// ExercisedCoin set exercisedCoin, the coin received when the product is exercised
+func (s *DualInvestmentListService) ExercisedCoin(exercisedCoin string) *DualInvestmentListService {
+	s.exercisedCoin = exercisedCoin
+	return s
+}
+
+// InvestCoin set investCoin, the coin subscribed with
+func (s *DualInvestmentListService) InvestCoin(investCoin string) *DualInvestmentListService {
+	s.investCoin = investCoin
+	return s
+}
+
+// PageSize Default: 10, Max: 100
+func (s *DualInvestmentListService) PageSize(pageSize int) *DualInvestmentListService {
+	s.pageSize = &pageSize
+	return s
+}
+
+// PageIndex Default: 1
+func (s *DualInvestmentListService) PageIndex(pageIndex int) *DualInvestmentListService {
+	s.pageIndex = &pageIndex
+	return s
+}
+
+// Do send request
+func (s *DualInvestmentListService) Do(ctx context.Context, opts ...RequestOption) (*DualInvestmentProductList, error) {
+	r := &request{
+		method:   http.MethodGet,
+		endpoint: "/sapi/v1/dci/product/list",
+		secType:  secTypeSigned,
+	}
+	r.setParams(params{
+		"optionType":    s.optionType,
+		"exercisedCoin": s.exercisedCoin,
+		"investCoin":    s.investCoin,
+	})
+	if s.pageSize != nil {
+		r.setParam("pageSize", *s.pageSize)
+	}
+	if s.pageIndex != nil {
+		r.setParam("pageIndex", *s.pageIndex)
+	}
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res := new(DualInvestmentProductList)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// DualInvestmentProductList define a page of dual investment products
+type DualInvestmentProductList struct {
+	Total int                      `json:"total"`
+	List  []*DualInvestmentProduct `json:"list"`
+}
+
+// DualInvestmentProduct define a dual investment product
+type DualInvestmentProduct struct {
+	ID                   string                           `json:"id"`
+	InvestCoin           string                           `json:"investCoin"`
+	ExercisedCoin        string                           `json:"exercisedCoin"`
+	StrikePrice          string                           `json:"strikePrice"`
+	Duration             int                              `json:"duration"`
+	SettleDate           int64                            `json:"settleDate"`
+	PurchaseDecimal      int                              `json:"purchaseDecimal"`
+	PurchaseEndTime      int64                            `json:"purchaseEndTime"`
+	CanPurchase          bool                             `json:"canPurchase"`
+	Apr                  string                           `json:"apr"`
+	OrderID              int64                            `json:"orderId"`
+	MinAmount            string                           `json:"minAmount"`
+	MaxAmount            string                           `json:"maxAmount"`
+	CreateTimestamp      int64                            `json:"createTimestamp"`
+	OptionType           DualInvestmentOptionType         `json:"optionType"`
+	IsAutoCompoundEnable bool                             `json:"isAutoCompoundEnable"`
+	AutoCompoundPlanList []DualInvestmentAutoCompoundPlan `json:"autoCompoundPlanList"`
+}
+
+// DualInvestmentSubscribeService https://binance-docs.github.io/apidocs/spot/en/#subscribe-dual-investment-products-user_data
+type DualInvestmentSubscribeService struct {
+	c                *Client
+	id               string
+	orderID          int64
+	depositAmount    string
+	autoCompoundPlan DualInvestmentAutoCompoundPlan
+}
+
+// ID set id, the id of the product from DualInvestmentListService
+func (s *DualInvestmentSubscribeService) ID(id string) *DualInvestmentSubscribeService {
+	s.id = id
+	return s
+}
+
+// OrderID set orderId, the order id of the product from DualInvestmentListService
+func (s *DualInvestmentSubscribeService) OrderID(orderID int64) *DualInvestmentSubscribeService {
+	s.orderID = orderID
+	return s
+}
+
+// DepositAmount set depositAmount
+func (s *DualInvestmentSubscribeService) DepositAmount(depositAmount string) *DualInvestmentSubscribeService {
+	s.depositAmount = depositAmount
+	return s
+}
+
+// AutoCompoundPlan set autoCompoundPlan
+func (s *DualInvestmentSubscribeService) AutoCompoundPlan(autoCompoundPlan DualInvestmentAutoCompoundPlan) *DualInvestmentSubscribeService {
+	s.autoCompoundPlan = autoCompoundPlan
+	return s
+}
+
+// Do send request
+func (s *DualInvestmentSubscribeService) Do(ctx context.Context, opts ...RequestOption) (*DualInvestmentPosition, error) {
+	r := &request{
+		method:   http.MethodPost,
+		endpoint: "/sapi/v1/dci/product/subscribe",
+		secType:  secTypeSigned,
+	}
+	r.setFormParams(params{
+		"id":               s.id,
+		"orderId":          s.orderID,
+		"depositAmount":    s.depositAmount,
+		"autoCompoundPlan": s.autoCompoundPlan,
+	})
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res := new(DualInvestmentPosition)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// DualInvestmentPosition define a subscribed dual investment position
+type DualInvestmentPosition struct {
+	PositionID         int64                          `json:"positionId"`
+	InvestCoin         string                         `json:"investCoin"`
+	ExercisedCoin      string                         `json:"exercisedCoin"`
+	SubscriptionAmount string                         `json:"subscriptionAmount"`
+	Duration           int                            `json:"duration"`
+	AutoCompoundPlan   DualInvestmentAutoCompoundPlan `json:"autoCompoundPlan"`
+	StrikePrice        string                         `json:"strikePrice"`
+	SettleDate         int64                          `json:"settleDate"`
+	PurchaseStatus     string                         `json:"purchaseStatus"`
+	Apr                string                         `json:"apr"`
+	OrderID            int64                          `json:"orderId"`
+	PurchaseTime       int64                          `json:"purchaseTime"`
+	OptionType         DualInvestmentOptionType       `json:"optionType"`
+}
@@ -0,0 +1,139 @@
+package binance
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type dualInvestmentServiceTestSuite struct {
+	baseTestSuite
+}
+
+func TestDualInvestmentService(t *testing.T) {
+	suite.Run(t, new(dualInvestmentServiceTestSuite))
+}
+
+func (s *dualInvestmentServiceTestSuite) TestListProducts() {
+	data := []byte(`{
+		"total": 1,
+		"list": [
+			{
+				"id": "741590",
+				"investCoin": "USDT",
+				"exercisedCoin": "BNB",
+				"strikePrice": "380",
+				"duration": 4,
+				"settleDate": 1709020800000,
+				"purchaseDecimal": 8,
+				"purchaseEndTime": 1708934400000,
+				"canPurchase": true,
+				"apr": "0.6076",
+				"orderId": 8257205859,
+				"minAmount": "0.1",
+				"maxAmount": "25265.7",
+				"createTimestamp": 1708560000000,
+				"optionType": "PUT",
+				"isAutoCompoundEnable": true,
+				"autoCompoundPlanList": ["STANDARD", "ADVANCED"]
+			}
+		]
+	}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setParams(params{
+			"optionType":    DualInvestmentOptionTypePut,
+			"exercisedCoin": "BNB",
+			"investCoin":    "USDT",
+			"pageSize":      10,
+			"pageIndex":     1,
+		})
+		s.assertRequestEqual(e, r)
+	})
+
+	res, err := s.client.NewDualInvestmentListService().OptionType(DualInvestmentOptionTypePut).
+		ExercisedCoin("BNB").InvestCoin("USDT").PageSize(10).PageIndex(1).Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	e := &DualInvestmentProductList{
+		Total: 1,
+		List: []*DualInvestmentProduct{
+			{
+				ID:                   "741590",
+				InvestCoin:           "USDT",
+				ExercisedCoin:        "BNB",
+				StrikePrice:          "380",
+				Duration:             4,
+				SettleDate:           1709020800000,
+				PurchaseDecimal:      8,
+				PurchaseEndTime:      1708934400000,
+				CanPurchase:          true,
+				Apr:                  "0.6076",
+				OrderID:              8257205859,
+				MinAmount:            "0.1",
+				MaxAmount:            "25265.7",
+				CreateTimestamp:      1708560000000,
+				OptionType:           DualInvestmentOptionTypePut,
+				IsAutoCompoundEnable: true,
+				AutoCompoundPlanList: []DualInvestmentAutoCompoundPlan{
+					DualInvestmentAutoCompoundPlanStandard,
+					DualInvestmentAutoCompoundPlanAdvanced,
+				},
+			},
+		},
+	}
+	r.Equal(e, res)
+}
+
+func (s *dualInvestmentServiceTestSuite) TestSubscribe() {
+	data := []byte(`{
+		"positionId": 10208824,
+		"investCoin": "BNB",
+		"exercisedCoin": "USDT",
+		"subscriptionAmount": "0.002",
+		"duration": 4,
+		"autoCompoundPlan": "STANDARD",
+		"strikePrice": "380",
+		"settleDate": 1709020800000,
+		"purchaseStatus": "PURCHASE_SUCCESS",
+		"apr": "0.7397",
+		"orderId": 8259117597,
+		"purchaseTime": 1708677583874,
+		"optionType": "CALL"
+	}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setFormParams(params{
+			"id":               "741590",
+			"orderId":          8259117597,
+			"depositAmount":    "0.002",
+			"autoCompoundPlan": DualInvestmentAutoCompoundPlanStandard,
+		})
+		s.assertRequestEqual(e, r)
+	})
+
+	res, err := s.client.NewDualInvestmentSubscribeService().ID("741590").OrderID(8259117597).
+		DepositAmount("0.002").AutoCompoundPlan(DualInvestmentAutoCompoundPlanStandard).Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	e := &DualInvestmentPosition{
+		PositionID:         10208824,
+		InvestCoin:         "BNB",
+		ExercisedCoin:      "USDT",
+		SubscriptionAmount: "0.002",
+		Duration:           4,
+		AutoCompoundPlan:   DualInvestmentAutoCompoundPlanStandard,
+		StrikePrice:        "380",
+		SettleDate:         1709020800000,
+		PurchaseStatus:     "PURCHASE_SUCCESS",
+		Apr:                "0.7397",
+		OrderID:            8259117597,
+		PurchaseTime:       1708677583874,
+		OptionType:         DualInvestmentOptionTypeCall,
+	}
+	r.Equal(e, res)
+}
@@ -13,6 +13,7 @@ import (
 	"context"
 	"encoding/json"
 	"net/http"
+	"time"
 )
 
 // ListDustLogService fetch small amounts of assets exchanged versus BNB
@@ -30,6 +31,11 @@ func (s *ListDustLogService) StartTime(startTime int64) *ListDustLogService {
 	return s
 }
 
+// StartTimeFrom set startTime from t, converted to epoch milliseconds
+func (s *ListDustLogService) StartTimeFrom(t time.Time) *ListDustLogService {
+	return s.StartTime(FormatTimestamp(t))
+}
+
 // EndTime sets the endTime parameter.
 // If present, StartTime MUST be specified. The difference between EndTime - StartTime MUST be between 0-90 days.
 func (s *ListDustLogService) EndTime(endTime int64) *ListDustLogService {
@@ -37,6 +43,11 @@ func (s *ListDustLogService) EndTime(endTime int64) *ListDustLogService {
 	return s
 }
 
+// EndTimeFrom set endTime from t, converted to epoch milliseconds
+func (s *ListDustLogService) EndTimeFrom(t time.Time) *ListDustLogService {
+	return s.EndTime(FormatTimestamp(t))
+}
+
 // Do sends the request.
 func (s *ListDustLogService) Do(ctx context.Context) (withdraws *DustResult, err error) {
 	r := &request{
@@ -138,3 +149,47 @@ type DustTransferResult struct {
 	TranID              int64  `json:"tranId"`
 	TransferedAmount    string `json:"transferedAmount"`
 }
+
+// GetDustableAssetsService list assets that can be converted to BNB.
+// See https://binance-docs.github.io/apidocs/spot/en/#get-assets-that-can-be-converted-into-bnb-user_data
+type GetDustableAssetsService struct {
+	c *Client
+}
+
+// Do sends the request.
+func (s *GetDustableAssetsService) Do(ctx context.Context, opts ...RequestOption) (res *DustableAssetsResponse, err error) {
+	r := &request{
+		method:   http.MethodPost,
+		endpoint: "/sapi/v1/asset/dust-btc",
+		secType:  secTypeSigned,
+	}
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(DustableAssetsResponse)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// DustableAssetsResponse represents the response from GetDustableAssetsService.
+type DustableAssetsResponse struct {
+	Details            []*DustableAsset `json:"details"`
+	TotalTransferBtc   string           `json:"totalTransferBtc"`
+	TotalTransferBNB   string           `json:"totalTransferBNB"`
+	DribbletPercentage string           `json:"dribbletPercentage"` //Commission fee
+}
+
+// DustableAsset represents one asset that can be converted to BNB.
+type DustableAsset struct {
+	Asset            string `json:"asset"`
+	AssetFullName    string `json:"assetFullName"`
+	AmountFree       string `json:"amountFree"`
+	ToBTC            string `json:"toBTC"`            //BTC amount
+	ToBNB            string `json:"toBNB"`            //BNB amount
+	ToBNBOffExchange string `json:"toBNBOffExchange"` //BNB amount after commission
+	Exchange         string `json:"exchange"`         //Commission fee
+}
@@ -261,3 +261,66 @@ func (s *dustTransferTestSuite) assertTransferResponse(e, a *DustTransferRespons
 		r.Equal(etr.TransferedAmount, a.TransferResult[i].TransferedAmount, "TransferedAmount")
 	}
 }
+
+func (s *dustTransferTestSuite) TestGetDustableAssets() {
+	data := []byte(`{
+		"details": [
+			{
+				"asset": "ADA",
+				"assetFullName": "ADA",
+				"amountFree": "6.21",
+				"toBTC": "0.00016848",
+				"toBNB": "0.01777302",
+				"toBNBOffExchange": "0.01741756",
+				"exchange": "0.00035546"
+			},
+			{
+				"asset": "TRX",
+				"assetFullName": "TRON",
+				"amountFree": "0.82",
+				"toBTC": "0.00000032",
+				"toBNB": "0.00003402",
+				"toBNBOffExchange": "0.00003334",
+				"exchange": "0.00000068"
+			}
+		],
+		"totalTransferBtc": "0.00016880",
+		"totalTransferBNB": "0.01780704",
+		"dribbletPercentage": "0.02"
+	}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+	s.assertReq(func(r *request) {
+		e := newSignedRequest()
+		s.assertRequestEqual(e, r)
+	})
+	res, err := s.client.NewGetDustableAssetsService().Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	e := &DustableAssetsResponse{
+		Details: []*DustableAsset{
+			{
+				Asset:            "ADA",
+				AssetFullName:    "ADA",
+				AmountFree:       "6.21",
+				ToBTC:            "0.00016848",
+				ToBNB:            "0.01777302",
+				ToBNBOffExchange: "0.01741756",
+				Exchange:         "0.00035546",
+			},
+			{
+				Asset:            "TRX",
+				AssetFullName:    "TRON",
+				AmountFree:       "0.82",
+				ToBTC:            "0.00000032",
+				ToBNB:            "0.00003402",
+				ToBNBOffExchange: "0.00003334",
+				Exchange:         "0.00000068",
+			},
+		},
+		TotalTransferBtc:   "0.00016880",
+		TotalTransferBNB:   "0.01780704",
+		DribbletPercentage: "0.02",
+	}
+	r.Equal(e, res)
+}
@@ -3,6 +3,7 @@ package binance
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"net/http"
 	"strings"
 )
@@ -124,6 +125,16 @@ type MinNotionalFilter struct {
 	ApplyToMarket    bool   `json:"applyToMarket"`
 }
 
+// NotionalFilter define notional filter of symbol, which replaces the min
+// notional filter with both a min and a max notional
+type NotionalFilter struct {
+	MinNotional      string `json:"minNotional"`
+	ApplyMinToMarket bool   `json:"applyMinToMarket"`
+	MaxNotional      string `json:"maxNotional"`
+	ApplyMaxToMarket bool   `json:"applyMaxToMarket"`
+	AveragePriceMins int    `json:"avgPriceMins"`
+}
+
 // IcebergPartsFilter define iceberg part filter of symbol
 type IcebergPartsFilter struct {
 	Limit int `json:"limit"`
@@ -141,6 +152,17 @@ type MaxNumAlgoOrdersFilter struct {
 	MaxNumAlgoOrders int `json:"maxNumAlgoOrders"`
 }
 
+// MaxNumOrdersFilter define max num orders filter of symbol
+type MaxNumOrdersFilter struct {
+	MaxNumOrders int `json:"maxNumOrders"`
+}
+
+// ExchangeMaxNumOrdersFilter define max num orders filter of the exchange,
+// the limit of open orders of an account over all symbols
+type ExchangeMaxNumOrdersFilter struct {
+	MaxNumOrders int `json:"maxNumOrders"`
+}
+
 // LotSizeFilter return lot size filter of symbol
 func (s *Symbol) LotSizeFilter() *LotSizeFilter {
 	for _, filter := range s.Filters {
@@ -221,6 +243,32 @@ func (s *Symbol) MinNotionalFilter() *MinNotionalFilter {
 	return nil
 }
 
+// NotionalFilter return notional filter of symbol
+func (s *Symbol) NotionalFilter() *NotionalFilter {
+	for _, filter := range s.Filters {
+		if filter["filterType"].(string) == string(SymbolFilterTypeNotional) {
+			f := &NotionalFilter{}
+			if i, ok := filter["minNotional"]; ok {
+				f.MinNotional = i.(string)
+			}
+			if i, ok := filter["applyMinToMarket"]; ok {
+				f.ApplyMinToMarket = i.(bool)
+			}
+			if i, ok := filter["maxNotional"]; ok {
+				f.MaxNotional = i.(string)
+			}
+			if i, ok := filter["applyMaxToMarket"]; ok {
+				f.ApplyMaxToMarket = i.(bool)
+			}
+			if i, ok := filter["avgPriceMins"]; ok {
+				f.AveragePriceMins = int(i.(float64))
+			}
+			return f
+		}
+	}
+	return nil
+}
+
 // IcebergPartsFilter return iceberg part filter of symbol
 func (s *Symbol) IcebergPartsFilter() *IcebergPartsFilter {
 	for _, filter := range s.Filters {
@@ -268,3 +316,54 @@ func (s *Symbol) MaxNumAlgoOrdersFilter() *MaxNumAlgoOrdersFilter {
 	}
 	return nil
 }
+
+// MaxNumOrdersFilter return max num orders filter of symbol
+func (s *Symbol) MaxNumOrdersFilter() *MaxNumOrdersFilter {
+	for _, filter := range s.Filters {
+		if filter["filterType"].(string) == string(SymbolFilterTypeMaxNumOrders) {
+			f := &MaxNumOrdersFilter{}
+			if i, ok := filter["maxNumOrders"]; ok {
+				f.MaxNumOrders = int(i.(float64))
+			}
+			return f
+		}
+	}
+	return nil
+}
+
+// ExchangeMaxNumOrdersFilter return max num orders filter of the exchange
+func (e *ExchangeInfo) ExchangeMaxNumOrdersFilter() *ExchangeMaxNumOrdersFilter {
+	for _, item := range e.ExchangeFilters {
+		filter, ok := item.(map[string]interface{})
+		if !ok || filter["filterType"] != string(ExchangeFilterTypeExchangeMaxNumOrders) {
+			continue
+		}
+		f := &ExchangeMaxNumOrdersFilter{}
+		if i, ok := filter["maxNumOrders"]; ok {
+			f.MaxNumOrders = int(i.(float64))
+		}
+		return f
+	}
+	return nil
+}
+
+// CanPlaceOrder return whether another order can be placed on symbol without
+// exceeding the MAX_NUM_ORDERS filter of symbol, given the open orders on
+// symbol, or the EXCHANGE_MAX_NUM_ORDERS filter, given the open orders of the
+// account over all symbols. Exceeding either limit is rejected with error
+// code -1015.
+func (e *ExchangeInfo) CanPlaceOrder(symbol string, symbolOpenOrders int, totalOpenOrders int) (bool, error) {
+	for i := range e.Symbols {
+		if e.Symbols[i].Symbol != symbol {
+			continue
+		}
+		if f := e.Symbols[i].MaxNumOrdersFilter(); f != nil && symbolOpenOrders >= f.MaxNumOrders {
+			return false, nil
+		}
+		if f := e.ExchangeMaxNumOrdersFilter(); f != nil && totalOpenOrders >= f.MaxNumOrders {
+			return false, nil
+		}
+		return true, nil
+	}
+	return false, fmt.Errorf("symbol %s not found in exchange info", symbol)
+}
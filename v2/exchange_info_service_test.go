@@ -220,3 +220,60 @@ func (s *exchangeInfoServiceTestSuite) assertMaxNumAlgoOrdersFilterEqual(e, a *M
 	r := s.r()
 	r.Equal(e.MaxNumAlgoOrders, a.MaxNumAlgoOrders, "MaxNumAlgoOrders")
 }
+
+func (s *exchangeInfoServiceTestSuite) TestCanPlaceOrder() {
+	data := []byte(`{
+		"timezone": "UTC",
+		"serverTime": 1565246363776,
+		"exchangeFilters": [
+			{
+				"filterType": "EXCHANGE_MAX_NUM_ORDERS",
+				"maxNumOrders": 1000
+			}
+		],
+		"symbols": [
+			{
+				"symbol": "ETHBTC",
+				"status": "TRADING",
+				"filters": [
+					{
+						"filterType": "MAX_NUM_ORDERS",
+						"maxNumOrders": 200
+					}
+				]
+			},
+			{
+				"symbol": "LTCBTC",
+				"status": "TRADING",
+				"filters": []
+			}
+		]
+	}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	res, err := s.client.NewExchangeInfoService().Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	r.Equal(&MaxNumOrdersFilter{MaxNumOrders: 200}, res.Symbols[0].MaxNumOrdersFilter())
+	r.Nil(res.Symbols[1].MaxNumOrdersFilter())
+	r.Equal(&ExchangeMaxNumOrdersFilter{MaxNumOrders: 1000}, res.ExchangeMaxNumOrdersFilter())
+
+	// symbol level cap
+	ok, err := res.CanPlaceOrder("ETHBTC", 199, 500)
+	r.NoError(err)
+	r.True(ok)
+	ok, err = res.CanPlaceOrder("ETHBTC", 200, 500)
+	r.NoError(err)
+	r.False(ok)
+	// exchange level cap, LTCBTC has no symbol cap
+	ok, err = res.CanPlaceOrder("LTCBTC", 999, 999)
+	r.NoError(err)
+	r.True(ok)
+	ok, err = res.CanPlaceOrder("LTCBTC", 10, 1000)
+	r.NoError(err)
+	r.False(ok)
+
+	_, err = res.CanPlaceOrder("BNBBTC", 0, 0)
+	r.Error(err)
+}
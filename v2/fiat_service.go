@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"net/http"
+	"time"
 )
 
 // FiatDepositWithdrawHistoryService retrieve the fiat deposit/withdraw history
@@ -34,6 +35,11 @@ func (s *FiatDepositWithdrawHistoryService) EndTime(endTime int64) *FiatDepositW
 	return s
 }
 
+// EndTimeFrom set endTime from t, converted to epoch milliseconds
+func (s *FiatDepositWithdrawHistoryService) EndTimeFrom(t time.Time) *FiatDepositWithdrawHistoryService {
+	return s.EndTime(FormatTimestamp(t))
+}
+
 // Page set page
 func (s *FiatDepositWithdrawHistoryService) Page(page int32) *FiatDepositWithdrawHistoryService {
 	s.page = &page
@@ -127,6 +133,11 @@ func (s *FiatPaymentsHistoryService) EndTime(endTime int64) *FiatPaymentsHistory
 	return s
 }
 
+// EndTimeFrom set endTime from t, converted to epoch milliseconds
+func (s *FiatPaymentsHistoryService) EndTimeFrom(t time.Time) *FiatPaymentsHistoryService {
+	return s.EndTime(FormatTimestamp(t))
+}
+
 // Page set page
 func (s *FiatPaymentsHistoryService) Page(page int32) *FiatPaymentsHistoryService {
 	s.page = &page
@@ -0,0 +1,94 @@
+package binance
+
+import (
+	"context"
+	"time"
+)
+
+// fillsStreamKeepalive is the interval at which FillsStream keeps the listen key alive
+var fillsStreamKeepalive = 30 * time.Minute
+
+// ExecutionFill define a trade of an order, as reported by an executionReport
+// event of the user data stream
+type ExecutionFill struct {
+	Symbol          string
+	OrderID         int64
+	OrderListID     int64 // -1 unless the order is a leg of an OCO
+	ClientOrderID   string
+	Side            SideType
+	Price           string
+	Quantity        string
+	Commission      string
+	CommissionAsset string
+	TradeID         int64
+	IsMaker         bool
+	Time            int64
+}
+
+// FillsStream serve the user data stream of listenKey until ctx is done and
+// emit a fill for every executionReport of a trade. The listen key is kept
+// alive while serving. opts apply to the stream connection. Both channels are
+// closed once the stream stopped.
+func (c *Client) FillsStream(ctx context.Context, listenKey string, opts ...WsOption) (<-chan *ExecutionFill, <-chan error) {
+	fillC := make(chan *ExecutionFill)
+	errC := make(chan error, 1)
+	sendErr := func(err error) {
+		select {
+		case errC <- err:
+		case <-ctx.Done():
+		}
+	}
+	handler := func(event *WsUserDataEvent) {
+		if event.Event != UserDataEventTypeExecutionReport || event.OrderUpdate.ExecutionType != "TRADE" {
+			return
+		}
+		u := event.OrderUpdate
+		fill := &ExecutionFill{
+			Symbol:          u.Symbol,
+			OrderID:         u.Id,
+			OrderListID:     u.OrderListId,
+			ClientOrderID:   u.ClientOrderId,
+			Side:            SideType(u.Side),
+			Price:           u.LatestPrice,
+			Quantity:        u.LatestVolume,
+			Commission:      u.FeeCost,
+			CommissionAsset: u.FeeAsset,
+			TradeID:         u.TradeId,
+			IsMaker:         u.IsMaker,
+			Time:            u.TransactionTime,
+		}
+		select {
+		case fillC <- fill:
+		case <-ctx.Done():
+		}
+	}
+
+	doneC, stopC, err := WsUserDataServe(listenKey, handler, sendErr, WithUserDataWsOptions(opts...))
+	if err != nil {
+		errC <- err
+		close(errC)
+		close(fillC)
+		return fillC, errC
+	}
+	go func() {
+		defer close(errC)
+		defer close(fillC)
+		ticker := time.NewTicker(fillsStreamKeepalive)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				close(stopC)
+				<-doneC
+				return
+			case <-doneC:
+				return
+			case <-ticker.C:
+				if err := c.NewKeepaliveUserStreamService().ListenKey(listenKey).Do(ctx); err != nil {
+					sendErr(err)
+				}
+			}
+		}
+	}()
+	return fillC, errC
+}
@@ -0,0 +1,124 @@
+package binance
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type fillsStreamTestSuite struct {
+	baseTestSuite
+	origWsServe func(*WsConfig, WsHandler, ErrHandler) (chan struct{}, chan struct{}, error)
+}
+
+func TestFillsStream(t *testing.T) {
+	suite.Run(t, new(fillsStreamTestSuite))
+}
+
+func (s *fillsStreamTestSuite) SetupTest() {
+	s.baseTestSuite.SetupTest()
+	s.origWsServe = wsServe
+}
+
+func (s *fillsStreamTestSuite) TearDownTest() {
+	wsServe = s.origWsServe
+}
+
+func (s *fillsStreamTestSuite) TestTradeReports() {
+	messages := [][]byte{
+		[]byte(`{"e":"executionReport","E":1499405658658,"s":"ETHBTC","c":"myOrder1","S":"BUY","o":"LIMIT","f":"GTC","q":"2.00000000","p":"0.10264410","x":"NEW","X":"NEW","i":4293153,"l":"0.00000000","z":"0.00000000","L":"0.00000000","n":"0","N":null,"T":1499405658657,"t":-1}`),
+		[]byte(`{"e":"executionReport","E":1499405658659,"s":"ETHBTC","c":"myOrder1","S":"BUY","o":"LIMIT","f":"GTC","q":"2.00000000","p":"0.10264410","x":"TRADE","X":"PARTIALLY_FILLED","i":4293153,"g":-1,"l":"0.50000000","z":"0.50000000","L":"0.10264400","n":"0.00050000","N":"ETH","T":1499405658658,"t":1001,"m":true}`),
+		[]byte(`{"e":"outboundAccountPosition","E":1499405658660,"u":1499405658660,"B":[{"a":"ETH","f":"10000.000000","l":"0.000000"}]}`),
+		[]byte(`{"e":"executionReport","E":1499405658661,"s":"ETHBTC","c":"myOrder1","S":"BUY","o":"LIMIT","f":"GTC","q":"2.00000000","p":"0.10264410","x":"TRADE","X":"FILLED","i":4293153,"l":"1.50000000","z":"2.00000000","L":"0.10264410","n":"0.00150000","N":"ETH","T":1499405658660,"t":1002,"m":false}`),
+	}
+	wsServe = func(cfg *WsConfig, handler WsHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+		s.r().Equal(getWsEndpoint()+"/listenKey1", cfg.Endpoint)
+		doneC = make(chan struct{})
+		stopC = make(chan struct{})
+		go func() {
+			defer close(doneC)
+			for _, message := range messages {
+				handler(message)
+			}
+			<-stopC
+		}()
+		return doneC, stopC, nil
+	}
+
+	ctx, cancel := context.WithCancel(newContext())
+	defer cancel()
+	fillC, errC := s.client.FillsStream(ctx, "listenKey1")
+	var fills []*ExecutionFill
+	for len(fills) < 2 {
+		select {
+		case fill := <-fillC:
+			fills = append(fills, fill)
+		case err := <-errC:
+			s.r().FailNow("unexpected error", "%v", err)
+		case <-time.After(time.Second):
+			s.r().FailNow("fills not received")
+		}
+	}
+	r := s.r()
+	r.Equal(&ExecutionFill{
+		Symbol:          "ETHBTC",
+		OrderID:         4293153,
+		OrderListID:     -1,
+		ClientOrderID:   "myOrder1",
+		Side:            SideTypeBuy,
+		Price:           "0.10264400",
+		Quantity:        "0.50000000",
+		Commission:      "0.00050000",
+		CommissionAsset: "ETH",
+		TradeID:         1001,
+		IsMaker:         true,
+		Time:            1499405658658,
+	}, fills[0])
+	r.Equal(int64(1002), fills[1].TradeID)
+	r.Equal("1.50000000", fills[1].Quantity)
+	r.False(fills[1].IsMaker)
+
+	cancel()
+	select {
+	case _, ok := <-fillC:
+		r.False(ok)
+	case <-time.After(time.Second):
+		r.FailNow("fills channel not closed")
+	}
+	_, ok := <-errC
+	r.False(ok)
+}
+
+func (s *fillsStreamTestSuite) TestOCOTradeReports() {
+	messages := [][]byte{
+		[]byte(`{"e":"executionReport","E":1499405658660,"s":"ETHBTC","c":"ocoLeg1","S":"SELL","o":"LIMIT_MAKER","f":"GTC","q":"1.00000000","p":"0.11000000","g":29,"x":"TRADE","X":"FILLED","i":4293154,"l":"1.00000000","z":"1.00000000","L":"0.11000000","n":"0.00011000","N":"BTC","T":1499405658659,"t":1003,"m":true}`),
+		[]byte(`{"e":"executionReport","E":1499405658661,"s":"ETHBTC","c":"ocoLeg2","S":"SELL","o":"STOP_LOSS_LIMIT","f":"GTC","q":"1.00000000","p":"0.09000000","g":29,"x":"EXPIRED","X":"EXPIRED","i":4293155,"l":"0.00000000","z":"0.00000000","L":"0.00000000","n":"0","N":null,"T":1499405658659,"t":-1}`),
+	}
+	wsServe = func(cfg *WsConfig, handler WsHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+		doneC = make(chan struct{})
+		stopC = make(chan struct{})
+		go func() {
+			defer close(doneC)
+			for _, message := range messages {
+				handler(message)
+			}
+			<-stopC
+		}()
+		return doneC, stopC, nil
+	}
+
+	ctx, cancel := context.WithCancel(newContext())
+	defer cancel()
+	fillC, _ := s.client.FillsStream(ctx, "listenKey1")
+	r := s.r()
+	select {
+	case fill := <-fillC:
+		r.Equal(int64(29), fill.OrderListID)
+		r.Equal(int64(4293154), fill.OrderID)
+		r.Equal("ocoLeg1", fill.ClientOrderID)
+	case <-time.After(time.Second):
+		r.FailNow("fill not received")
+	}
+}
@@ -176,8 +176,9 @@ const (
 	recvWindowKey = "recvWindow"
 )
 
-func currentTimestamp() int64 {
-	return int64(time.Nanosecond) * time.Now().UnixNano() / int64(time.Millisecond)
+// FormatTimestamp formats a time into Unix timestamp in milliseconds, as requested by Binance.
+func FormatTimestamp(t time.Time) int64 {
+	return t.UnixNano() / int64(time.Millisecond)
 }
 
 func newJSON(data []byte) (j *simplejson.Json, err error) {
@@ -223,6 +224,26 @@ type Client struct {
 	Logger     *log.Logger
 	TimeOffset int64
 	do         doFunc
+	nowFunc    func() time.Time
+}
+
+// SetUserAgent set the User-Agent header sent with every request,
+// WithHeader can still override it per request
+func (c *Client) SetUserAgent(userAgent string) {
+	c.UserAgent = userAgent
+}
+
+// SetClock set the clock read for request timestamps, e.g. a fixed time in
+// tests. A nil now restores the system clock
+func (c *Client) SetClock(now func() time.Time) {
+	c.nowFunc = now
+}
+
+func (c *Client) now() time.Time {
+	if c.nowFunc != nil {
+		return c.nowFunc()
+	}
+	return time.Now()
 }
 
 func (c *Client) debug(format string, v ...interface{}) {
@@ -246,7 +267,7 @@ func (c *Client) parseRequest(r *request, opts ...RequestOption) (err error) {
 		r.setParam(recvWindowKey, r.recvWindow)
 	}
 	if r.secType == secTypeSigned {
-		r.setParam(timestampKey, currentTimestamp()-c.TimeOffset)
+		r.setParam(timestampKey, FormatTimestamp(c.now())-c.TimeOffset)
 	}
 	queryString := r.query.Encode()
 	body := &bytes.Buffer{}
@@ -255,6 +276,9 @@ func (c *Client) parseRequest(r *request, opts ...RequestOption) (err error) {
 	if r.header != nil {
 		header = r.header.Clone()
 	}
+	if c.UserAgent != "" && header.Get("User-Agent") == "" {
+		header.Set("User-Agent", c.UserAgent)
+	}
 	if bodyString != "" {
 		header.Set("Content-Type", "application/x-www-form-urlencoded")
 		body = bytes.NewBufferString(bodyString)
@@ -264,7 +288,11 @@ func (c *Client) parseRequest(r *request, opts ...RequestOption) (err error) {
 	}
 
 	if r.secType == secTypeSigned {
+		// url.Values.Encode sorts the params by key, so the payload is stable
 		raw := fmt.Sprintf("%s%s", queryString, bodyString)
+		if r.signDebug != nil {
+			r.signDebug(raw)
+		}
 		mac := hmac.New(sha256.New, []byte(c.SecretKey))
 		_, err = mac.Write([]byte(raw))
 		if err != nil {
@@ -307,11 +335,14 @@ func (c *Client) callAPI(ctx context.Context, r *request, opts ...RequestOption)
 	}
 	res, err := f(req)
 	if err != nil {
-		return []byte{}, &http.Header{}, err
+		return []byte{}, &http.Header{}, common.WrapRequestError(ctx, r.endpoint, err)
 	}
 	data, err = ioutil.ReadAll(res.Body)
 	if err != nil {
-		return []byte{}, &http.Header{}, err
+		return []byte{}, &http.Header{}, common.WrapRequestError(ctx, r.endpoint, err)
+	}
+	if r.rawResponse != nil {
+		*r.rawResponse = data
 	}
 	defer func() {
 		cerr := res.Body.Close()
@@ -396,6 +427,16 @@ func (c *Client) NewCreateBatchOrdersService() *CreateBatchOrdersService {
 	return &CreateBatchOrdersService{c: c}
 }
 
+// NewModifyOrderService init modify order service
+func (c *Client) NewModifyOrderService() *ModifyOrderService {
+	return &ModifyOrderService{c: c}
+}
+
+// NewModifyBatchOrdersService init modify batch orders service
+func (c *Client) NewModifyBatchOrdersService() *ModifyBatchOrdersService {
+	return &ModifyBatchOrdersService{c: c}
+}
+
 // NewGetOrderService init get order service
 func (c *Client) NewGetOrderService() *GetOrderService {
 	return &GetOrderService{c: c}
@@ -411,6 +452,11 @@ func (c *Client) NewCancelAllOpenOrdersService() *CancelAllOpenOrdersService {
 	return &CancelAllOpenOrdersService{c: c}
 }
 
+// NewCountdownCancelAllService init countdown cancel all open orders service
+func (c *Client) NewCountdownCancelAllService() *CountdownCancelAllService {
+	return &CountdownCancelAllService{c: c}
+}
+
 // NewCancelMultipleOrdersService init cancel multiple orders service
 func (c *Client) NewCancelMultipleOrdersService() *CancelMultiplesOrdersService {
 	return &CancelMultiplesOrdersService{c: c}
@@ -521,6 +567,11 @@ func (c *Client) NewUpdatePositionMarginService() *UpdatePositionMarginService {
 	return &UpdatePositionMarginService{c: c}
 }
 
+// NewModifyIsolatedPositionMarginService init modify isolated position margin service
+func (c *Client) NewModifyIsolatedPositionMarginService() *ModifyIsolatedPositionMarginService {
+	return &ModifyIsolatedPositionMarginService{c: c}
+}
+
 // NewChangePositionModeService init change position mode service
 func (c *Client) NewChangePositionModeService() *ChangePositionModeService {
 	return &ChangePositionModeService{c: c}
@@ -535,3 +586,33 @@ func (c *Client) NewGetPositionModeService() *GetPositionModeService {
 func (c *Client) NewGetRebateNewUserService() *GetRebateNewUserService {
 	return &GetRebateNewUserService{c: c}
 }
+
+// NewGetOpenInterestService init open interest service
+func (c *Client) NewGetOpenInterestService() *GetOpenInterestService {
+	return &GetOpenInterestService{c: c}
+}
+
+// NewGetOpenInterestStatisticsService init open interest statistics service
+func (c *Client) NewGetOpenInterestStatisticsService() *GetOpenInterestStatisticsService {
+	return &GetOpenInterestStatisticsService{c: c}
+}
+
+// NewGetTopLongShortAccountRatioService init top trader long/short account ratio service
+func (c *Client) NewGetTopLongShortAccountRatioService() *GetTopLongShortAccountRatioService {
+	return &GetTopLongShortAccountRatioService{c: c}
+}
+
+// NewGetTopLongShortPositionRatioService init top trader long/short position ratio service
+func (c *Client) NewGetTopLongShortPositionRatioService() *GetTopLongShortPositionRatioService {
+	return &GetTopLongShortPositionRatioService{c: c}
+}
+
+// NewGetGlobalLongShortAccountRatioService init global long/short account ratio service
+func (c *Client) NewGetGlobalLongShortAccountRatioService() *GetGlobalLongShortAccountRatioService {
+	return &GetGlobalLongShortAccountRatioService{c: c}
+}
+
+// NewGetTakerLongShortRatioService init taker buy/sell volume service
+func (c *Client) NewGetTakerLongShortRatioService() *GetTakerLongShortRatioService {
+	return &GetTakerLongShortRatioService{c: c}
+}
@@ -5,7 +5,9 @@ import (
 	"context"
 	"io/ioutil"
 	"net/http"
+	"net/http/httptest"
 	"net/url"
+	"testing"
 
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
@@ -122,6 +124,8 @@ func newMockedClient(apiKey, secretKey string) *mockedClient {
 func (m *mockedClient) do(req *http.Request) (*http.Response, error) {
 	if m.assertReq != nil {
 		r := newRequest()
+		r.method = req.Method
+		r.endpoint = req.URL.Path
 		r.query = req.URL.Query()
 		if req.Body != nil {
 			bs := make([]byte, req.ContentLength)
@@ -158,3 +162,28 @@ func (s *baseTestSuite) assertTradeV3Equal(e, a *TradeV3) {
 	r.Equal(e.IsMaker, a.IsMaker, "IsMaker")
 	r.Equal(e.IsBestMatch, a.IsBestMatch, "IsBestMatch")
 }
+
+func TestWithRawResponse(t *testing.T) {
+	body := []byte(`{"serverTime": 1499827319559, "newField": "not modeled"}`)
+	status := http.StatusOK
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(status)
+		w.Write(body)
+	}))
+	defer srv.Close()
+
+	c := NewClient("dummyAPIKey", "dummySecretKey")
+	c.BaseURL = srv.URL
+	var raw []byte
+	serverTime, err := c.NewServerTimeService().Do(newContext(), WithRawResponse(&raw))
+	r := require.New(t)
+	r.NoError(err)
+	r.Equal(int64(1499827319559), serverTime)
+	r.Equal(body, raw)
+
+	body = []byte(`{"code":-1121,"msg":"Invalid symbol."}`)
+	status = http.StatusBadRequest
+	_, err = c.NewServerTimeService().Do(newContext(), WithRawResponse(&raw))
+	r.Error(err)
+	r.Equal(body, raw)
+}
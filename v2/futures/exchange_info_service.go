@@ -3,8 +3,11 @@ package futures
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"net/http"
 	"strconv"
+
+	"github.com/adshao/go-binance/v2/common"
 )
 
 // ExchangeInfoService exchange info service
@@ -245,3 +248,43 @@ func (s *Symbol) MinNotionalFilter() *MinNotionalFilter {
 	}
 	return nil
 }
+
+// RoundPrice round price down to the tick size of the PRICE_FILTER of the
+// symbol, and check it is within the min and max price
+func (s *Symbol) RoundPrice(price string) (string, error) {
+	f := s.PriceFilter()
+	if f == nil {
+		return "", fmt.Errorf("no price filter for %s", s.Symbol)
+	}
+	return roundToFilter(price, f.TickSize, f.MinPrice, f.MaxPrice, "price", s.Symbol)
+}
+
+// RoundQuantity round quantity down to the step size of the LOT_SIZE filter of
+// the symbol, and check it is within the min and max quantity
+func (s *Symbol) RoundQuantity(quantity string) (string, error) {
+	f := s.LotSizeFilter()
+	if f == nil {
+		return "", fmt.Errorf("no lot size filter for %s", s.Symbol)
+	}
+	return roundToFilter(quantity, f.StepSize, f.MinQuantity, f.MaxQuantity, "quantity", s.Symbol)
+}
+
+// RoundMarketQuantity round quantity like RoundQuantity, using the
+// MARKET_LOT_SIZE filter which applies to market orders
+func (s *Symbol) RoundMarketQuantity(quantity string) (string, error) {
+	f := s.MarketLotSizeFilter()
+	if f == nil {
+		return "", fmt.Errorf("no market lot size filter for %s", s.Symbol)
+	}
+	return roundToFilter(quantity, f.StepSize, f.MinQuantity, f.MaxQuantity, "quantity", s.Symbol)
+}
+
+// roundToFilter round value like common.RoundToFilter and format it with the
+// decimals of step
+func roundToFilter(value, step, min, max, name, symbol string) (string, error) {
+	v, err := common.RoundToFilter(value, step, min, max, name, symbol)
+	if err != nil {
+		return "", err
+	}
+	return v.FloatString(common.StepDecimals(step)), nil
+}
@@ -195,6 +195,35 @@ func (s *exchangeInfoServiceTestSuite) TestExchangeInfo() {
 	s.assertPercentPriceFilterEqual(ePercentPriceFilter, res.Symbols[0].PercentPriceFilter())
 }
 
+func (s *exchangeInfoServiceTestSuite) TestSymbolRounding() {
+	symbol := &Symbol{
+		Symbol: "BTCUSDT",
+		Filters: []map[string]interface{}{
+			{"filterType": "PRICE_FILTER", "minPrice": "556.80", "maxPrice": "4529764", "tickSize": "0.10"},
+			{"filterType": "LOT_SIZE", "minQty": "0.001", "maxQty": "1000", "stepSize": "0.001"},
+			{"filterType": "MARKET_LOT_SIZE", "minQty": "0.001", "maxQty": "120", "stepSize": "0.001"},
+		},
+	}
+	r := s.r()
+	price, err := symbol.RoundPrice("26123.4567")
+	r.NoError(err)
+	r.Equal("26123.4", price)
+	quantity, err := symbol.RoundQuantity("0.12345")
+	r.NoError(err)
+	r.Equal("0.123", quantity)
+	_, err = symbol.RoundQuantity("0.0009")
+	r.EqualError(err, "quantity 0.0009 of BTCUSDT below min quantity 0.001")
+	quantity, err = symbol.RoundQuantity("500")
+	r.NoError(err)
+	r.Equal("500.000", quantity)
+	_, err = symbol.RoundMarketQuantity("500")
+	r.EqualError(err, "quantity 500 of BTCUSDT above max quantity 120")
+	_, err = symbol.RoundPrice("100")
+	r.EqualError(err, "price 100 of BTCUSDT below min price 556.80")
+	_, err = (&Symbol{Symbol: "BTCUSDT"}).RoundPrice("100")
+	r.EqualError(err, "no price filter for BTCUSDT")
+}
+
 func (s *exchangeInfoServiceTestSuite) assertExchangeInfoEqual(e, a *ExchangeInfo) {
 	r := s.r()
 
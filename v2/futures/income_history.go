@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"net/http"
+	"time"
 )
 
 // GetIncomeHistoryService get position margin history service
@@ -34,12 +35,22 @@ func (s *GetIncomeHistoryService) StartTime(startTime int64) *GetIncomeHistorySe
 	return s
 }
 
+// StartTimeFrom set startTime from t, converted to epoch milliseconds
+func (s *GetIncomeHistoryService) StartTimeFrom(t time.Time) *GetIncomeHistoryService {
+	return s.StartTime(FormatTimestamp(t))
+}
+
 // EndTime set endTime
 func (s *GetIncomeHistoryService) EndTime(endTime int64) *GetIncomeHistoryService {
 	s.endTime = &endTime
 	return s
 }
 
+// EndTimeFrom set endTime from t, converted to epoch milliseconds
+func (s *GetIncomeHistoryService) EndTimeFrom(t time.Time) *GetIncomeHistoryService {
+	return s.EndTime(FormatTimestamp(t))
+}
+
 // Limit set limit
 func (s *GetIncomeHistoryService) Limit(limit int64) *GetIncomeHistoryService {
 	s.limit = &limit
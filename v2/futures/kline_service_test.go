@@ -2,6 +2,7 @@ package futures
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/suite"
 )
@@ -98,6 +99,28 @@ func (s *klineServiceTestSuite) TestKlines() {
 	s.assertKlineEqual(kline2, klines[1])
 }
 
+func (s *klineServiceTestSuite) TestKlinesTimeFrom() {
+	s.mockDo([]byte(`[]`), nil)
+	defer s.assertDo()
+
+	startTime := time.Date(2017, 7, 3, 0, 0, 0, 0, time.UTC)
+	// sub-millisecond precision is truncated
+	endTime := startTime.Add(time.Millisecond + 999*time.Microsecond)
+	s.assertReq(func(r *request) {
+		e := newRequest().setParams(params{
+			"symbol":    "LTCBTC",
+			"interval":  "15m",
+			"startTime": int64(1499040000000),
+			"endTime":   int64(1499040000001),
+		})
+		s.assertRequestEqual(e, r)
+	})
+	_, err := s.client.NewKlinesService().Symbol("LTCBTC").Interval("15m").
+		StartTimeFrom(startTime).EndTimeFrom(endTime.In(time.FixedZone("UTC+8", 8*3600))).
+		Do(newContext())
+	s.r().NoError(err)
+}
+
 func (s *klineServiceTestSuite) assertKlineEqual(e, a *Kline) {
 	r := s.r()
 	r.Equal(e.OpenTime, a.OpenTime, "OpenTime")
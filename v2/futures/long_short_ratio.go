@@ -0,0 +1,300 @@
+package futures
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"time"
+)
+
+// GetTopLongShortAccountRatioService list top trader long/short account ratio of a symbol
+type GetTopLongShortAccountRatioService struct {
+	c         *Client
+	symbol    string
+	period    string
+	limit     *int
+	startTime *int64
+	endTime   *int64
+}
+
+// Symbol set symbol
+func (s *GetTopLongShortAccountRatioService) Symbol(symbol string) *GetTopLongShortAccountRatioService {
+	s.symbol = symbol
+	return s
+}
+
+// Period set period interval, e.g. 5m, 1h, 1d
+func (s *GetTopLongShortAccountRatioService) Period(period string) *GetTopLongShortAccountRatioService {
+	s.period = period
+	return s
+}
+
+// Limit set limit
+func (s *GetTopLongShortAccountRatioService) Limit(limit int) *GetTopLongShortAccountRatioService {
+	s.limit = &limit
+	return s
+}
+
+// StartTime set startTime
+func (s *GetTopLongShortAccountRatioService) StartTime(startTime int64) *GetTopLongShortAccountRatioService {
+	s.startTime = &startTime
+	return s
+}
+
+// StartTimeFrom set startTime from t, converted to epoch milliseconds
+func (s *GetTopLongShortAccountRatioService) StartTimeFrom(t time.Time) *GetTopLongShortAccountRatioService {
+	return s.StartTime(FormatTimestamp(t))
+}
+
+// EndTime set endTime
+func (s *GetTopLongShortAccountRatioService) EndTime(endTime int64) *GetTopLongShortAccountRatioService {
+	s.endTime = &endTime
+	return s
+}
+
+// EndTimeFrom set endTime from t, converted to epoch milliseconds
+func (s *GetTopLongShortAccountRatioService) EndTimeFrom(t time.Time) *GetTopLongShortAccountRatioService {
+	return s.EndTime(FormatTimestamp(t))
+}
+
+// Do send request
+func (s *GetTopLongShortAccountRatioService) Do(ctx context.Context, opts ...RequestOption) (res []*LongShortRatio, err error) {
+	return listLongShortRatio(ctx, s.c, "/futures/data/topLongShortAccountRatio", s.symbol, s.period, s.limit, s.startTime, s.endTime, opts...)
+}
+
+// GetTopLongShortPositionRatioService list top trader long/short position ratio of a symbol
+type GetTopLongShortPositionRatioService struct {
+	c         *Client
+	symbol    string
+	period    string
+	limit     *int
+	startTime *int64
+	endTime   *int64
+}
+
+// Symbol set symbol
+func (s *GetTopLongShortPositionRatioService) Symbol(symbol string) *GetTopLongShortPositionRatioService {
+	s.symbol = symbol
+	return s
+}
+
+// Period set period interval, e.g. 5m, 1h, 1d
+func (s *GetTopLongShortPositionRatioService) Period(period string) *GetTopLongShortPositionRatioService {
+	s.period = period
+	return s
+}
+
+// Limit set limit
+func (s *GetTopLongShortPositionRatioService) Limit(limit int) *GetTopLongShortPositionRatioService {
+	s.limit = &limit
+	return s
+}
+
+// StartTime set startTime
+func (s *GetTopLongShortPositionRatioService) StartTime(startTime int64) *GetTopLongShortPositionRatioService {
+	s.startTime = &startTime
+	return s
+}
+
+// StartTimeFrom set startTime from t, converted to epoch milliseconds
+func (s *GetTopLongShortPositionRatioService) StartTimeFrom(t time.Time) *GetTopLongShortPositionRatioService {
+	return s.StartTime(FormatTimestamp(t))
+}
+
+// EndTime set endTime
+func (s *GetTopLongShortPositionRatioService) EndTime(endTime int64) *GetTopLongShortPositionRatioService {
+	s.endTime = &endTime
+	return s
+}
+
+// EndTimeFrom set endTime from t, converted to epoch milliseconds
+func (s *GetTopLongShortPositionRatioService) EndTimeFrom(t time.Time) *GetTopLongShortPositionRatioService {
+	return s.EndTime(FormatTimestamp(t))
+}
+
+// Do send request
+func (s *GetTopLongShortPositionRatioService) Do(ctx context.Context, opts ...RequestOption) (res []*LongShortRatio, err error) {
+	return listLongShortRatio(ctx, s.c, "/futures/data/topLongShortPositionRatio", s.symbol, s.period, s.limit, s.startTime, s.endTime, opts...)
+}
+
+// GetGlobalLongShortAccountRatioService list global long/short account ratio of a symbol
+type GetGlobalLongShortAccountRatioService struct {
+	c         *Client
+	symbol    string
+	period    string
+	limit     *int
+	startTime *int64
+	endTime   *int64
+}
+
+// Symbol set symbol
+func (s *GetGlobalLongShortAccountRatioService) Symbol(symbol string) *GetGlobalLongShortAccountRatioService {
+	s.symbol = symbol
+	return s
+}
+
+// Period set period interval, e.g. 5m, 1h, 1d
+func (s *GetGlobalLongShortAccountRatioService) Period(period string) *GetGlobalLongShortAccountRatioService {
+	s.period = period
+	return s
+}
+
+// Limit set limit
+func (s *GetGlobalLongShortAccountRatioService) Limit(limit int) *GetGlobalLongShortAccountRatioService {
+	s.limit = &limit
+	return s
+}
+
+// StartTime set startTime
+func (s *GetGlobalLongShortAccountRatioService) StartTime(startTime int64) *GetGlobalLongShortAccountRatioService {
+	s.startTime = &startTime
+	return s
+}
+
+// StartTimeFrom set startTime from t, converted to epoch milliseconds
+func (s *GetGlobalLongShortAccountRatioService) StartTimeFrom(t time.Time) *GetGlobalLongShortAccountRatioService {
+	return s.StartTime(FormatTimestamp(t))
+}
+
+// EndTime set endTime
+func (s *GetGlobalLongShortAccountRatioService) EndTime(endTime int64) *GetGlobalLongShortAccountRatioService {
+	s.endTime = &endTime
+	return s
+}
+
+// EndTimeFrom set endTime from t, converted to epoch milliseconds
+func (s *GetGlobalLongShortAccountRatioService) EndTimeFrom(t time.Time) *GetGlobalLongShortAccountRatioService {
+	return s.EndTime(FormatTimestamp(t))
+}
+
+// Do send request
+func (s *GetGlobalLongShortAccountRatioService) Do(ctx context.Context, opts ...RequestOption) (res []*LongShortRatio, err error) {
+	return listLongShortRatio(ctx, s.c, "/futures/data/globalLongShortAccountRatio", s.symbol, s.period, s.limit, s.startTime, s.endTime, opts...)
+}
+
+func listLongShortRatio(ctx context.Context, c *Client, endpoint string, symbol string, period string,
+	limit *int, startTime *int64, endTime *int64, opts ...RequestOption) (res []*LongShortRatio, err error) {
+	r := &request{
+		method:   http.MethodGet,
+		endpoint: endpoint,
+		secType:  secTypeNone,
+	}
+	r.setParam("symbol", symbol)
+	r.setParam("period", period)
+	if limit != nil {
+		r.setParam("limit", *limit)
+	}
+	if startTime != nil {
+		r.setParam("startTime", *startTime)
+	}
+	if endTime != nil {
+		r.setParam("endTime", *endTime)
+	}
+	data, _, err := c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return []*LongShortRatio{}, err
+	}
+	res = make([]*LongShortRatio, 0)
+	err = json.Unmarshal(data, &res)
+	if err != nil {
+		return []*LongShortRatio{}, err
+	}
+	return res, nil
+}
+
+// LongShortRatio define long/short ratio info
+type LongShortRatio struct {
+	Symbol         string `json:"symbol"`
+	LongShortRatio string `json:"longShortRatio"`
+	LongAccount    string `json:"longAccount"`
+	ShortAccount   string `json:"shortAccount"`
+	Timestamp      int64  `json:"timestamp"`
+}
+
+// GetTakerLongShortRatioService list taker buy/sell volume of a symbol
+type GetTakerLongShortRatioService struct {
+	c         *Client
+	symbol    string
+	period    string
+	limit     *int
+	startTime *int64
+	endTime   *int64
+}
+
+// Symbol set symbol
+func (s *GetTakerLongShortRatioService) Symbol(symbol string) *GetTakerLongShortRatioService {
+	s.symbol = symbol
+	return s
+}
+
+// Period set period interval, e.g. 5m, 1h, 1d
+func (s *GetTakerLongShortRatioService) Period(period string) *GetTakerLongShortRatioService {
+	s.period = period
+	return s
+}
+
+// Limit set limit
+func (s *GetTakerLongShortRatioService) Limit(limit int) *GetTakerLongShortRatioService {
+	s.limit = &limit
+	return s
+}
+
+// StartTime set startTime
+func (s *GetTakerLongShortRatioService) StartTime(startTime int64) *GetTakerLongShortRatioService {
+	s.startTime = &startTime
+	return s
+}
+
+// StartTimeFrom set startTime from t, converted to epoch milliseconds
+func (s *GetTakerLongShortRatioService) StartTimeFrom(t time.Time) *GetTakerLongShortRatioService {
+	return s.StartTime(FormatTimestamp(t))
+}
+
+// EndTime set endTime
+func (s *GetTakerLongShortRatioService) EndTime(endTime int64) *GetTakerLongShortRatioService {
+	s.endTime = &endTime
+	return s
+}
+
+// EndTimeFrom set endTime from t, converted to epoch milliseconds
+func (s *GetTakerLongShortRatioService) EndTimeFrom(t time.Time) *GetTakerLongShortRatioService {
+	return s.EndTime(FormatTimestamp(t))
+}
+
+// Do send request
+func (s *GetTakerLongShortRatioService) Do(ctx context.Context, opts ...RequestOption) (res []*TakerLongShortRatio, err error) {
+	r := &request{
+		method:   http.MethodGet,
+		endpoint: "/futures/data/takerlongshortRatio",
+		secType:  secTypeNone,
+	}
+	r.setParam("symbol", s.symbol)
+	r.setParam("period", s.period)
+	if s.limit != nil {
+		r.setParam("limit", *s.limit)
+	}
+	if s.startTime != nil {
+		r.setParam("startTime", *s.startTime)
+	}
+	if s.endTime != nil {
+		r.setParam("endTime", *s.endTime)
+	}
+	data, _, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return []*TakerLongShortRatio{}, err
+	}
+	res = make([]*TakerLongShortRatio, 0)
+	err = json.Unmarshal(data, &res)
+	if err != nil {
+		return []*TakerLongShortRatio{}, err
+	}
+	return res, nil
+}
+
+// TakerLongShortRatio define taker buy/sell volume info
+type TakerLongShortRatio struct {
+	BuySellRatio string `json:"buySellRatio"`
+	BuyVol       string `json:"buyVol"`
+	SellVol      string `json:"sellVol"`
+	Timestamp    int64  `json:"timestamp"`
+}
@@ -0,0 +1,140 @@
+package futures
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type longShortRatioServiceTestSuite struct {
+	baseTestSuite
+}
+
+func TestLongShortRatioService(t *testing.T) {
+	suite.Run(t, new(longShortRatioServiceTestSuite))
+}
+
+func (s *longShortRatioServiceTestSuite) TestGetTopLongShortAccountRatio() {
+	data := []byte(`[
+		{
+			"symbol": "BTCUSDT",
+			"longShortRatio": "1.8105",
+			"longAccount": "0.6442",
+			"shortAccount": "0.3558",
+			"timestamp": 1583139600000
+		},
+		{
+			"symbol": "BTCUSDT",
+			"longShortRatio": "0.5576",
+			"longAccount": "0.3580",
+			"shortAccount": "0.6420",
+			"timestamp": 1583139900000
+		}
+	]`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	var path string
+	do := s.client.Client.do
+	s.client.Client.do = func(req *http.Request) (*http.Response, error) {
+		path = req.URL.Path
+		return do(req)
+	}
+
+	symbol := "BTCUSDT"
+	period := "5m"
+	limit := 2
+	startTime := int64(1583139600000)
+	endTime := int64(1583139900000)
+	s.assertReq(func(r *request) {
+		e := newRequest().setParams(params{
+			"symbol":    symbol,
+			"period":    period,
+			"limit":     limit,
+			"startTime": startTime,
+			"endTime":   endTime,
+		})
+		s.assertRequestEqual(e, r)
+	})
+
+	res, err := s.client.NewGetTopLongShortAccountRatioService().Symbol(symbol).Period(period).
+		Limit(limit).StartTime(startTime).EndTime(endTime).Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	r.Equal("/futures/data/topLongShortAccountRatio", path)
+	e := []*LongShortRatio{
+		{
+			Symbol:         symbol,
+			LongShortRatio: "1.8105",
+			LongAccount:    "0.6442",
+			ShortAccount:   "0.3558",
+			Timestamp:      1583139600000,
+		},
+		{
+			Symbol:         symbol,
+			LongShortRatio: "0.5576",
+			LongAccount:    "0.3580",
+			ShortAccount:   "0.6420",
+			Timestamp:      1583139900000,
+		},
+	}
+	r.Len(res, len(e))
+	for i := range e {
+		r.Equal(e[i], res[i])
+	}
+}
+
+func (s *longShortRatioServiceTestSuite) TestGetTakerLongShortRatio() {
+	data := []byte(`[
+		{
+			"buySellRatio": "1.5586",
+			"buyVol": "387.3300",
+			"sellVol": "248.5030",
+			"timestamp": 1585614900000
+		},
+		{
+			"buySellRatio": "1.3104",
+			"buyVol": "343.9290",
+			"sellVol": "248.5030",
+			"timestamp": 1583139900000
+		}
+	]`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	symbol := "BTCUSDT"
+	period := "5m"
+	limit := 2
+	s.assertReq(func(r *request) {
+		e := newRequest().setParams(params{
+			"symbol": symbol,
+			"period": period,
+			"limit":  limit,
+		})
+		s.assertRequestEqual(e, r)
+	})
+
+	res, err := s.client.NewGetTakerLongShortRatioService().Symbol(symbol).Period(period).
+		Limit(limit).Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	e := []*TakerLongShortRatio{
+		{
+			BuySellRatio: "1.5586",
+			BuyVol:       "387.3300",
+			SellVol:      "248.5030",
+			Timestamp:    1585614900000,
+		},
+		{
+			BuySellRatio: "1.3104",
+			BuyVol:       "343.9290",
+			SellVol:      "248.5030",
+			Timestamp:    1583139900000,
+		},
+	}
+	r.Len(res, len(e))
+	for i := range e {
+		r.Equal(e[i], res[i])
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"net/http"
+	"time"
 
 	"github.com/adshao/go-binance/v2/common"
 )
@@ -73,12 +74,22 @@ func (s *FundingRateService) StartTime(startTime int64) *FundingRateService {
 	return s
 }
 
+// StartTimeFrom set startTime from t, converted to epoch milliseconds
+func (s *FundingRateService) StartTimeFrom(t time.Time) *FundingRateService {
+	return s.StartTime(FormatTimestamp(t))
+}
+
 // EndTime set startTime
 func (s *FundingRateService) EndTime(endTime int64) *FundingRateService {
 	s.endTime = &endTime
 	return s
 }
 
+// EndTimeFrom set endTime from t, converted to epoch milliseconds
+func (s *FundingRateService) EndTimeFrom(t time.Time) *FundingRateService {
+	return s.EndTime(FormatTimestamp(t))
+}
+
 // Limit set limit
 func (s *FundingRateService) Limit(limit int) *FundingRateService {
 	s.limit = &limit
@@ -92,7 +103,9 @@ func (s *FundingRateService) Do(ctx context.Context, opts ...RequestOption) (res
 		endpoint: "/fapi/v1/fundingRate",
 		secType:  secTypeNone,
 	}
-	r.setParam("symbol", s.symbol)
+	if s.symbol != "" {
+		r.setParam("symbol", s.symbol)
+	}
 	if s.startTime != nil {
 		r.setParam("startTime", *s.startTime)
 	}
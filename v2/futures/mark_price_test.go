@@ -118,6 +118,47 @@ func (s *fundingRateServiceTestSuite) TestGetFundingRate() {
 	}
 }
 
+func (s *fundingRateServiceTestSuite) TestGetFundingRateAllSymbols() {
+	data := []byte(`[
+		{
+			"symbol": "BTCUSDT",
+			"fundingRate": "0.00010000",
+			"fundingTime": 1570636800000
+		},
+		{
+			"symbol": "ETHUSDT",
+			"fundingRate": "-0.00025000",
+			"fundingTime": 1570636800000
+		}
+	]`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	s.assertReq(func(r *request) {
+		e := newRequest()
+		s.assertRequestEqual(e, r)
+	})
+
+	res, err := s.client.NewFundingRateService().Do(newContext())
+	s.r().NoError(err)
+	e := []*FundingRate{
+		{
+			Symbol:      "BTCUSDT",
+			FundingRate: "0.00010000",
+			FundingTime: int64(1570636800000),
+		},
+		{
+			Symbol:      "ETHUSDT",
+			FundingRate: "-0.00025000",
+			FundingTime: int64(1570636800000),
+		},
+	}
+	s.r().Len(res, len(e))
+	for i := 0; i < len(res); i++ {
+		s.assertFundingRateEqual(e[i], res[i])
+	}
+}
+
 func (s *fundingRateServiceTestSuite) assertFundingRateEqual(e, a *FundingRate) {
 	r := s.r()
 	r.Equal(e.Symbol, a.Symbol, "Symbol")
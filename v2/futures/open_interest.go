@@ -0,0 +1,136 @@
+package futures
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"time"
+)
+
+// GetOpenInterestService get present open interest of a specific symbol.
+type GetOpenInterestService struct {
+	c      *Client
+	symbol string
+}
+
+// Symbol set symbol
+func (s *GetOpenInterestService) Symbol(symbol string) *GetOpenInterestService {
+	s.symbol = symbol
+	return s
+}
+
+// Do send request
+func (s *GetOpenInterestService) Do(ctx context.Context, opts ...RequestOption) (res *OpenInterest, err error) {
+	r := &request{
+		method:   http.MethodGet,
+		endpoint: "/fapi/v1/openInterest",
+		secType:  secTypeNone,
+	}
+	r.setParam("symbol", s.symbol)
+	data, _, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(OpenInterest)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// OpenInterest define open interest info
+type OpenInterest struct {
+	OpenInterest string `json:"openInterest"`
+	Symbol       string `json:"symbol"`
+	Time         int64  `json:"time"`
+}
+
+// GetOpenInterestStatisticsService list open interest history of a symbol.
+// The endpoint is served under /futures/data instead of /fapi.
+type GetOpenInterestStatisticsService struct {
+	c         *Client
+	symbol    string
+	period    string
+	limit     *int
+	startTime *int64
+	endTime   *int64
+}
+
+// Symbol set symbol
+func (s *GetOpenInterestStatisticsService) Symbol(symbol string) *GetOpenInterestStatisticsService {
+	s.symbol = symbol
+	return s
+}
+
+// Period set period interval, e.g. 5m, 1h, 1d
+func (s *GetOpenInterestStatisticsService) Period(period string) *GetOpenInterestStatisticsService {
+	s.period = period
+	return s
+}
+
+// Limit set limit
+func (s *GetOpenInterestStatisticsService) Limit(limit int) *GetOpenInterestStatisticsService {
+	s.limit = &limit
+	return s
+}
+
+// StartTime set startTime
+func (s *GetOpenInterestStatisticsService) StartTime(startTime int64) *GetOpenInterestStatisticsService {
+	s.startTime = &startTime
+	return s
+}
+
+// StartTimeFrom set startTime from t, converted to epoch milliseconds
+func (s *GetOpenInterestStatisticsService) StartTimeFrom(t time.Time) *GetOpenInterestStatisticsService {
+	return s.StartTime(FormatTimestamp(t))
+}
+
+// EndTime set endTime
+func (s *GetOpenInterestStatisticsService) EndTime(endTime int64) *GetOpenInterestStatisticsService {
+	s.endTime = &endTime
+	return s
+}
+
+// EndTimeFrom set endTime from t, converted to epoch milliseconds
+func (s *GetOpenInterestStatisticsService) EndTimeFrom(t time.Time) *GetOpenInterestStatisticsService {
+	return s.EndTime(FormatTimestamp(t))
+}
+
+// Do send request
+func (s *GetOpenInterestStatisticsService) Do(ctx context.Context, opts ...RequestOption) (res []*OpenInterestStatistic, err error) {
+	r := &request{
+		method:   http.MethodGet,
+		endpoint: "/futures/data/openInterestHist",
+		secType:  secTypeNone,
+	}
+	r.setParam("symbol", s.symbol)
+	r.setParam("period", s.period)
+	if s.limit != nil {
+		r.setParam("limit", *s.limit)
+	}
+	if s.startTime != nil {
+		r.setParam("startTime", *s.startTime)
+	}
+	if s.endTime != nil {
+		r.setParam("endTime", *s.endTime)
+	}
+	data, _, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return []*OpenInterestStatistic{}, err
+	}
+	res = make([]*OpenInterestStatistic, 0)
+	err = json.Unmarshal(data, &res)
+	if err != nil {
+		return []*OpenInterestStatistic{}, err
+	}
+	return res, nil
+}
+
+// OpenInterestStatistic define open interest statistic
+type OpenInterestStatistic struct {
+	Symbol               string `json:"symbol"`
+	SumOpenInterest      string `json:"sumOpenInterest"`
+	SumOpenInterestValue string `json:"sumOpenInterestValue"`
+	Timestamp            int64  `json:"timestamp"`
+}
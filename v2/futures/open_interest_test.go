@@ -0,0 +1,107 @@
+package futures
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type openInterestServiceTestSuite struct {
+	baseTestSuite
+}
+
+func TestOpenInterestService(t *testing.T) {
+	suite.Run(t, new(openInterestServiceTestSuite))
+}
+
+func (s *openInterestServiceTestSuite) TestGetOpenInterest() {
+	data := []byte(`{
+		"openInterest": "10659.509",
+		"symbol": "BTCUSDT",
+		"time": 1589437530011
+	}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	symbol := "BTCUSDT"
+	s.assertReq(func(r *request) {
+		e := newRequest().setParams(params{
+			"symbol": symbol,
+		})
+		s.assertRequestEqual(e, r)
+	})
+
+	res, err := s.client.NewGetOpenInterestService().Symbol(symbol).Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	r.Equal("10659.509", res.OpenInterest, "OpenInterest")
+	r.Equal(symbol, res.Symbol, "Symbol")
+	r.Equal(int64(1589437530011), res.Time, "Time")
+}
+
+func (s *openInterestServiceTestSuite) TestGetOpenInterestStatistics() {
+	data := []byte(`[
+		{
+			"symbol": "BTCUSDT",
+			"sumOpenInterest": "20403.63700000",
+			"sumOpenInterestValue": "150570784.07809979",
+			"timestamp": 1583127900000
+		},
+		{
+			"symbol": "BTCUSDT",
+			"sumOpenInterest": "20401.36700000",
+			"sumOpenInterestValue": "149940752.14464448",
+			"timestamp": 1583128200000
+		}
+	]`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	var path string
+	do := s.client.Client.do
+	s.client.Client.do = func(req *http.Request) (*http.Response, error) {
+		path = req.URL.Path
+		return do(req)
+	}
+
+	symbol := "BTCUSDT"
+	period := "5m"
+	limit := 2
+	startTime := int64(1583127900000)
+	endTime := int64(1583128200000)
+	s.assertReq(func(r *request) {
+		e := newRequest().setParams(params{
+			"symbol":    symbol,
+			"period":    period,
+			"limit":     limit,
+			"startTime": startTime,
+			"endTime":   endTime,
+		})
+		s.assertRequestEqual(e, r)
+	})
+
+	res, err := s.client.NewGetOpenInterestStatisticsService().Symbol(symbol).Period(period).
+		Limit(limit).StartTime(startTime).EndTime(endTime).Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	r.Equal("/futures/data/openInterestHist", path)
+	e := []*OpenInterestStatistic{
+		{
+			Symbol:               symbol,
+			SumOpenInterest:      "20403.63700000",
+			SumOpenInterestValue: "150570784.07809979",
+			Timestamp:            1583127900000,
+		},
+		{
+			Symbol:               symbol,
+			SumOpenInterest:      "20401.36700000",
+			SumOpenInterestValue: "149940752.14464448",
+			Timestamp:            1583128200000,
+		},
+	}
+	r.Len(res, len(e))
+	for i := range e {
+		r.Equal(e[i], res[i])
+	}
+}
@@ -3,9 +3,13 @@ package futures
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"strings"
+	"time"
+
+	"github.com/adshao/go-binance/v2/common"
 )
 
 // CreateOrderService create order
@@ -366,12 +370,22 @@ func (s *ListOrdersService) StartTime(startTime int64) *ListOrdersService {
 	return s
 }
 
+// StartTimeFrom set startTime from t, converted to epoch milliseconds
+func (s *ListOrdersService) StartTimeFrom(t time.Time) *ListOrdersService {
+	return s.StartTime(FormatTimestamp(t))
+}
+
 // EndTime set endtime
 func (s *ListOrdersService) EndTime(endTime int64) *ListOrdersService {
 	s.endTime = &endTime
 	return s
 }
 
+// EndTimeFrom set endTime from t, converted to epoch milliseconds
+func (s *ListOrdersService) EndTimeFrom(t time.Time) *ListOrdersService {
+	return s.EndTime(FormatTimestamp(t))
+}
+
 // Limit set limit
 func (s *ListOrdersService) Limit(limit int) *ListOrdersService {
 	s.limit = &limit
@@ -487,6 +501,185 @@ type CancelOrderResponse struct {
 	PriceProtect     bool             `json:"priceProtect"`
 }
 
+// ErrOrderIdentifierRequired is returned when neither orderId nor origClientOrderId is set
+var ErrOrderIdentifierRequired = errors.New("either orderId or origClientOrderId must be set")
+
+// ErrModifyOrderNoChange is returned when a modify sets neither price nor quantity
+var ErrModifyOrderNoChange = errors.New("either price or quantity must be set to modify an order")
+
+// ModifyOrderService modify the price or quantity of an open limit order in place
+type ModifyOrderService struct {
+	c                 *Client
+	symbol            string
+	side              SideType
+	orderID           *int64
+	origClientOrderID *string
+	quantity          *string
+	price             *string
+}
+
+// Symbol set symbol
+func (s *ModifyOrderService) Symbol(symbol string) *ModifyOrderService {
+	s.symbol = symbol
+	return s
+}
+
+// Side set side
+func (s *ModifyOrderService) Side(side SideType) *ModifyOrderService {
+	s.side = side
+	return s
+}
+
+// OrderID set orderID
+func (s *ModifyOrderService) OrderID(orderID int64) *ModifyOrderService {
+	s.orderID = &orderID
+	return s
+}
+
+// OrigClientOrderID set origClientOrderID
+func (s *ModifyOrderService) OrigClientOrderID(origClientOrderID string) *ModifyOrderService {
+	s.origClientOrderID = &origClientOrderID
+	return s
+}
+
+// Quantity set quantity
+func (s *ModifyOrderService) Quantity(quantity string) *ModifyOrderService {
+	s.quantity = &quantity
+	return s
+}
+
+// Price set price
+func (s *ModifyOrderService) Price(price string) *ModifyOrderService {
+	s.price = &price
+	return s
+}
+
+// params return the params of the modify, validating them
+func (s *ModifyOrderService) params() (params, error) {
+	if s.orderID == nil && s.origClientOrderID == nil {
+		return nil, ErrOrderIdentifierRequired
+	}
+	if s.quantity == nil && s.price == nil {
+		return nil, ErrModifyOrderNoChange
+	}
+	m := params{
+		"symbol": s.symbol,
+		"side":   s.side,
+	}
+	if s.orderID != nil {
+		m["orderId"] = *s.orderID
+	}
+	if s.origClientOrderID != nil {
+		m["origClientOrderId"] = *s.origClientOrderID
+	}
+	if s.quantity != nil {
+		m["quantity"] = *s.quantity
+	}
+	if s.price != nil {
+		m["price"] = *s.price
+	}
+	return m, nil
+}
+
+// Do send request
+func (s *ModifyOrderService) Do(ctx context.Context, opts ...RequestOption) (res *Order, err error) {
+	m, err := s.params()
+	if err != nil {
+		return nil, err
+	}
+	r := &request{
+		method:   http.MethodPut,
+		endpoint: "/fapi/v1/order",
+		secType:  secTypeSigned,
+	}
+	r.setFormParams(m)
+	data, _, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(Order)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// maxBatchModifyOrders is the max number of orders modified by one batch
+const maxBatchModifyOrders = 5
+
+// ModifyBatchOrdersService modify up to 5 orders in place with one request
+type ModifyBatchOrdersService struct {
+	c      *Client
+	orders []*ModifyOrderService
+}
+
+// OrderList set the modifications, built like a single ModifyOrderService
+func (s *ModifyBatchOrdersService) OrderList(orders []*ModifyOrderService) *ModifyBatchOrdersService {
+	s.orders = orders
+	return s
+}
+
+// ModifyBatchOrdersResponse define response of modifying orders in batch, both
+// slices are in the order of the modifications
+type ModifyBatchOrdersResponse struct {
+	// Orders has the modified order, or nil where the modification failed
+	Orders []*Order
+	// Errors has the *common.APIError of a failed modification, or nil
+	Errors []error
+}
+
+// Do send request
+func (s *ModifyBatchOrdersService) Do(ctx context.Context, opts ...RequestOption) (res *ModifyBatchOrdersResponse, err error) {
+	if len(s.orders) == 0 || len(s.orders) > maxBatchModifyOrders {
+		return nil, fmt.Errorf("batch modify takes 1 to %d orders, got %d", maxBatchModifyOrders, len(s.orders))
+	}
+	orders := make([]params, 0, len(s.orders))
+	for _, order := range s.orders {
+		m, err := order.params()
+		if err != nil {
+			return nil, err
+		}
+		orders = append(orders, m)
+	}
+	b, err := json.Marshal(orders)
+	if err != nil {
+		return nil, err
+	}
+	r := &request{
+		method:   http.MethodPut,
+		endpoint: "/fapi/v1/batchOrders",
+		secType:  secTypeSigned,
+	}
+	r.setFormParam("batchOrders", string(b))
+	data, _, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	rawMessages := make([]json.RawMessage, 0)
+	err = json.Unmarshal(data, &rawMessages)
+	if err != nil {
+		return nil, err
+	}
+	res = &ModifyBatchOrdersResponse{
+		Orders: make([]*Order, len(rawMessages)),
+		Errors: make([]error, len(rawMessages)),
+	}
+	for i, raw := range rawMessages {
+		apiErr := new(common.APIError)
+		if err := json.Unmarshal(raw, apiErr); err == nil && apiErr.Code != 0 {
+			res.Errors[i] = apiErr
+			continue
+		}
+		o := new(Order)
+		if err := json.Unmarshal(raw, o); err != nil {
+			return nil, err
+		}
+		res.Orders[i] = o
+	}
+	return res, nil
+}
+
 // CancelAllOpenOrdersService cancel all open orders
 type CancelAllOpenOrdersService struct {
 	c      *Client
@@ -514,6 +707,56 @@ func (s *CancelAllOpenOrdersService) Do(ctx context.Context, opts ...RequestOpti
 	return nil
 }
 
+// CountdownCancelAllService cancel all open orders of the symbol when the
+// countdown ends. Each call resets the countdown, so it works as a heartbeat:
+// orders are cancelled once the heartbeat stops. A countdown of 0 disables it.
+type CountdownCancelAllService struct {
+	c             *Client
+	symbol        string
+	countdownTime int64
+}
+
+// Symbol set symbol
+func (s *CountdownCancelAllService) Symbol(symbol string) *CountdownCancelAllService {
+	s.symbol = symbol
+	return s
+}
+
+// CountdownTime set countdown time in milliseconds
+func (s *CountdownCancelAllService) CountdownTime(countdownTime int64) *CountdownCancelAllService {
+	s.countdownTime = countdownTime
+	return s
+}
+
+// Do send request
+func (s *CountdownCancelAllService) Do(ctx context.Context, opts ...RequestOption) (res *CountdownCancelAll, err error) {
+	r := &request{
+		method:   http.MethodPost,
+		endpoint: "/fapi/v1/countdownCancelAll",
+		secType:  secTypeSigned,
+	}
+	r.setFormParams(params{
+		"symbol":        s.symbol,
+		"countdownTime": s.countdownTime,
+	})
+	data, _, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(CountdownCancelAll)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// CountdownCancelAll define countdown cancel all info
+type CountdownCancelAll struct {
+	Symbol        string `json:"symbol"`
+	CountdownTime string `json:"countdownTime"`
+}
+
 // CancelMultiplesOrdersService cancel a list of orders
 type CancelMultiplesOrdersService struct {
 	c                     *Client
@@ -589,12 +832,22 @@ func (s *ListLiquidationOrdersService) StartTime(startTime int64) *ListLiquidati
 	return s
 }
 
+// StartTimeFrom set startTime from t, converted to epoch milliseconds
+func (s *ListLiquidationOrdersService) StartTimeFrom(t time.Time) *ListLiquidationOrdersService {
+	return s.StartTime(FormatTimestamp(t))
+}
+
 // EndTime set startTime
 func (s *ListLiquidationOrdersService) EndTime(endTime int64) *ListLiquidationOrdersService {
 	s.endTime = &endTime
 	return s
 }
 
+// EndTimeFrom set endTime from t, converted to epoch milliseconds
+func (s *ListLiquidationOrdersService) EndTimeFrom(t time.Time) *ListLiquidationOrdersService {
+	return s.EndTime(FormatTimestamp(t))
+}
+
 // Limit set limit
 func (s *ListLiquidationOrdersService) Limit(limit int) *ListLiquidationOrdersService {
 	s.limit = &limit
@@ -674,12 +927,22 @@ func (s *ListUserLiquidationOrdersService) StartTime(startTime int64) *ListUserL
 	return s
 }
 
+// StartTimeFrom set startTime from t, converted to epoch milliseconds
+func (s *ListUserLiquidationOrdersService) StartTimeFrom(t time.Time) *ListUserLiquidationOrdersService {
+	return s.StartTime(FormatTimestamp(t))
+}
+
 // EndTime set endTime
 func (s *ListUserLiquidationOrdersService) EndTime(endTime int64) *ListUserLiquidationOrdersService {
 	s.endTime = &endTime
 	return s
 }
 
+// EndTimeFrom set endTime from t, converted to epoch milliseconds
+func (s *ListUserLiquidationOrdersService) EndTimeFrom(t time.Time) *ListUserLiquidationOrdersService {
+	return s.EndTime(FormatTimestamp(t))
+}
+
 // Limit set limit
 func (s *ListUserLiquidationOrdersService) Limit(limit int) *ListUserLiquidationOrdersService {
 	s.limit = &limit
@@ -1,8 +1,10 @@
 package futures
 
 import (
+	"net/http"
 	"testing"
 
+	"github.com/adshao/go-binance/v2/common"
 	"github.com/stretchr/testify/suite"
 )
 
@@ -113,6 +115,92 @@ func (s *orderServiceTestSuite) TestCreateOrder() {
 	s.assertCreateOrderResponseEqual(e, res)
 }
 
+func (s *orderServiceTestSuite) TestCreateOrderPositionSide() {
+	long := []byte(`{"orderId": 1, "symbol": "BTCUSDT", "side": "BUY", "type": "MARKET", "positionSide": "LONG"}`)
+	both := []byte(`{"orderId": 2, "symbol": "BTCUSDT", "side": "BUY", "type": "MARKET", "positionSide": "BOTH"}`)
+	s.client.Client.do = s.client.do
+	s.client.On("do", anyHTTPRequest()).Return(newHTTPResponse(long, 200), nil).Once()
+	s.client.On("do", anyHTTPRequest()).Return(newHTTPResponse(both, 200), nil).Once()
+	defer s.assertDo()
+	symbol := "BTCUSDT"
+	side := SideTypeBuy
+	orderType := OrderTypeMarket
+	quantity := "1"
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setFormParams(params{
+			"symbol":           symbol,
+			"side":             side,
+			"type":             orderType,
+			"quantity":         quantity,
+			"positionSide":     PositionSideTypeLong,
+			"newOrderRespType": "",
+		})
+		s.assertRequestEqual(e, r)
+	})
+	res, err := s.client.NewCreateOrderService().Symbol(symbol).Side(side).
+		Type(orderType).Quantity(quantity).PositionSide(PositionSideTypeLong).
+		Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	r.Equal(PositionSideTypeLong, res.PositionSide)
+
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setFormParams(params{
+			"symbol":           symbol,
+			"side":             side,
+			"type":             orderType,
+			"quantity":         quantity,
+			"newOrderRespType": "",
+		})
+		s.assertRequestEqual(e, r)
+	})
+	res, err = s.client.NewCreateOrderService().Symbol(symbol).Side(side).
+		Type(orderType).Quantity(quantity).Do(newContext())
+	r.NoError(err)
+	r.Equal(PositionSideTypeBoth, res.PositionSide)
+}
+
+func (s *orderServiceTestSuite) TestCreateMarkPriceStopOrder() {
+	data := []byte(`{
+		"orderId": 22542180,
+		"symbol": "BTCUSDT",
+		"status": "NEW",
+		"side": "SELL",
+		"type": "STOP_MARKET",
+		"origQty": "0.010",
+		"stopPrice": "25000",
+		"reduceOnly": true,
+		"workingType": "MARK_PRICE",
+		"priceProtect": true,
+		"positionSide": "BOTH"
+	}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setFormParams(params{
+			"symbol":           "BTCUSDT",
+			"side":             SideTypeSell,
+			"type":             OrderTypeStopMarket,
+			"quantity":         "0.010",
+			"stopPrice":        "25000",
+			"reduceOnly":       true,
+			"workingType":      WorkingTypeMarkPrice,
+			"priceProtect":     true,
+			"newOrderRespType": "",
+		})
+		s.assertRequestEqual(e, r)
+	})
+	res, err := s.client.NewCreateOrderService().Symbol("BTCUSDT").Side(SideTypeSell).
+		Type(OrderTypeStopMarket).Quantity("0.010").StopPrice("25000").ReduceOnly(true).
+		WorkingType(WorkingTypeMarkPrice).PriceProtect(true).
+		Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	r.Equal(WorkingTypeMarkPrice, res.WorkingType)
+	r.True(res.PriceProtect)
+	r.Equal("25000", res.StopPrice)
+}
+
 func (s *baseOrderTestSuite) assertCreateOrderResponseEqual(e, a *CreateOrderResponse) {
 	r := s.r()
 	r.Equal(e.ClientOrderID, a.ClientOrderID, "ClientOrderID")
@@ -368,6 +456,128 @@ func (s *orderServiceTestSuite) TestListOrders() {
 	s.assertOrderEqual(e, orders[0])
 }
 
+func (s *orderServiceTestSuite) TestModifyOrder() {
+	data := []byte(`{
+		"orderId": 20072994037,
+		"symbol": "BTCUSDT",
+		"pair": "BTCUSDT",
+		"status": "NEW",
+		"clientOrderId": "LJ9R4QZDihCaS8UAOOLpgW",
+		"price": "30005",
+		"avgPrice": "0.0",
+		"origQty": "1",
+		"executedQty": "0",
+		"cumQty": "0",
+		"cumQuote": "0",
+		"timeInForce": "GTC",
+		"type": "LIMIT",
+		"reduceOnly": false,
+		"closePosition": false,
+		"side": "BUY",
+		"positionSide": "LONG",
+		"stopPrice": "0",
+		"workingType": "CONTRACT_PRICE",
+		"priceProtect": false,
+		"origType": "LIMIT",
+		"updateTime": 1629182711600
+	}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+	s.assertReq(func(r *request) {
+		s.r().Equal(http.MethodPut, r.method)
+		s.r().Equal("/fapi/v1/order", r.endpoint)
+		e := newSignedRequest().setFormParams(params{
+			"symbol":   "BTCUSDT",
+			"side":     SideTypeBuy,
+			"orderId":  20072994037,
+			"quantity": "1",
+			"price":    "30005",
+		})
+		s.assertRequestEqual(e, r)
+	})
+	res, err := s.client.NewModifyOrderService().Symbol("BTCUSDT").Side(SideTypeBuy).
+		OrderID(20072994037).Quantity("1").Price("30005").Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	r.Equal(int64(20072994037), res.OrderID)
+	r.Equal("30005", res.Price)
+	r.Equal("1", res.OrigQuantity)
+	r.Equal(OrderStatusTypeNew, res.Status)
+	r.Equal(int64(1629182711600), res.UpdateTime)
+}
+
+func (s *orderServiceTestSuite) TestModifyOrderValidation() {
+	r := s.r()
+	_, err := s.client.NewModifyOrderService().Symbol("BTCUSDT").Side(SideTypeBuy).
+		Price("30005").Do(newContext())
+	r.Equal(ErrOrderIdentifierRequired, err)
+	_, err = s.client.NewModifyOrderService().Symbol("BTCUSDT").Side(SideTypeBuy).
+		OrigClientOrderID("myOrder1").Do(newContext())
+	r.Equal(ErrModifyOrderNoChange, err)
+	s.client.AssertNotCalled(s.T(), "do", anyHTTPRequest())
+}
+
+func (s *orderServiceTestSuite) TestModifyBatchOrders() {
+	data := []byte(`[
+		{
+			"orderId": 42042723,
+			"symbol": "BTCUSDT",
+			"status": "NEW",
+			"clientOrderId": "Ne7DEEvLvv8b9Nbpzmq8Wz",
+			"price": "30005",
+			"origQty": "1",
+			"side": "BUY",
+			"type": "LIMIT",
+			"updateTime": 1629182711600
+		},
+		{
+			"code": -2013,
+			"msg": "Order does not exist."
+		}
+	]`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+	s.assertReq(func(r *request) {
+		s.r().Equal(http.MethodPut, r.method)
+		s.r().Equal("/fapi/v1/batchOrders", r.endpoint)
+		e := newSignedRequest().setFormParams(params{
+			"batchOrders": `[{"orderId":42042723,"price":"30005","quantity":"1","side":"BUY","symbol":"BTCUSDT"},` +
+				`{"origClientOrderId":"gone","price":"29990","side":"SELL","symbol":"BTCUSDT"}]`,
+		})
+		s.assertRequestEqual(e, r)
+	})
+	res, err := s.client.NewModifyBatchOrdersService().OrderList([]*ModifyOrderService{
+		s.client.NewModifyOrderService().Symbol("BTCUSDT").Side(SideTypeBuy).
+			OrderID(42042723).Quantity("1").Price("30005"),
+		s.client.NewModifyOrderService().Symbol("BTCUSDT").Side(SideTypeSell).
+			OrigClientOrderID("gone").Price("29990"),
+	}).Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	r.Len(res.Orders, 2)
+	r.Len(res.Errors, 2)
+	r.NoError(res.Errors[0])
+	r.Equal(int64(42042723), res.Orders[0].OrderID)
+	r.Equal("30005", res.Orders[0].Price)
+	r.Nil(res.Orders[1])
+	r.Equal(&common.APIError{Code: -2013, Message: "Order does not exist."}, res.Errors[1])
+}
+
+func (s *orderServiceTestSuite) TestModifyBatchOrdersValidation() {
+	r := s.r()
+	orders := make([]*ModifyOrderService, 6)
+	for i := range orders {
+		orders[i] = s.client.NewModifyOrderService().Symbol("BTCUSDT").Side(SideTypeBuy).OrderID(int64(i)).Price("30000")
+	}
+	_, err := s.client.NewModifyBatchOrdersService().OrderList(orders).Do(newContext())
+	r.EqualError(err, "batch modify takes 1 to 5 orders, got 6")
+	_, err = s.client.NewModifyBatchOrdersService().OrderList([]*ModifyOrderService{
+		s.client.NewModifyOrderService().Symbol("BTCUSDT").Side(SideTypeBuy).Price("30000"),
+	}).Do(newContext())
+	r.Equal(ErrOrderIdentifierRequired, err)
+	s.client.AssertNotCalled(s.T(), "do", anyHTTPRequest())
+}
+
 func (s *orderServiceTestSuite) TestCancelOrder() {
 	data := []byte(`{
 		"clientOrderId": "myOrder1",
@@ -481,6 +691,55 @@ func (s *orderServiceTestSuite) TestCancelAllOpenOrders() {
 	s.r().NoError(err)
 }
 
+func (s *orderServiceTestSuite) TestCountdownCancelAll() {
+	data := []byte(`{
+		"symbol": "BTCUSDT",
+		"countdownTime": "100000"
+	}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	symbol := "BTCUSDT"
+	countdownTime := int64(100000)
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setFormParams(params{
+			"symbol":        symbol,
+			"countdownTime": countdownTime,
+		})
+		s.assertRequestEqual(e, r)
+	})
+
+	res, err := s.client.NewCountdownCancelAllService().Symbol(symbol).
+		CountdownTime(countdownTime).Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	r.Equal(&CountdownCancelAll{Symbol: symbol, CountdownTime: "100000"}, res)
+}
+
+func (s *orderServiceTestSuite) TestCountdownCancelAllDisable() {
+	data := []byte(`{
+		"symbol": "BTCUSDT",
+		"countdownTime": "0"
+	}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	symbol := "BTCUSDT"
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setFormParams(params{
+			"symbol":        symbol,
+			"countdownTime": 0,
+		})
+		s.assertRequestEqual(e, r)
+	})
+
+	res, err := s.client.NewCountdownCancelAllService().Symbol(symbol).
+		CountdownTime(0).Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	r.Equal(&CountdownCancelAll{Symbol: symbol, CountdownTime: "0"}, res)
+}
+
 func (s *orderServiceTestSuite) TestListLiquidationOrders() {
 	data := []byte(`[
 		{
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"net/http"
+	"time"
 )
 
 // GetPositionMarginHistoryService get position margin history service
@@ -34,12 +35,22 @@ func (s *GetPositionMarginHistoryService) StartTime(startTime int64) *GetPositio
 	return s
 }
 
+// StartTimeFrom set startTime from t, converted to epoch milliseconds
+func (s *GetPositionMarginHistoryService) StartTimeFrom(t time.Time) *GetPositionMarginHistoryService {
+	return s.StartTime(FormatTimestamp(t))
+}
+
 // EndTime set endTime
 func (s *GetPositionMarginHistoryService) EndTime(endTime int64) *GetPositionMarginHistoryService {
 	s.endTime = &endTime
 	return s
 }
 
+// EndTimeFrom set endTime from t, converted to epoch milliseconds
+func (s *GetPositionMarginHistoryService) EndTimeFrom(t time.Time) *GetPositionMarginHistoryService {
+	return s.EndTime(FormatTimestamp(t))
+}
+
 // Limit set limit
 func (s *GetPositionMarginHistoryService) Limit(limit int64) *GetPositionMarginHistoryService {
 	s.limit = &limit
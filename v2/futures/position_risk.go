@@ -3,6 +3,8 @@ package futures
 import (
 	"context"
 	"encoding/json"
+	"fmt"
+	"math/big"
 	"net/http"
 )
 
@@ -56,4 +58,77 @@ type PositionRisk struct {
 	PositionSide     string `json:"positionSide"`
 	Notional         string `json:"notional"`
 	IsolatedWallet   string `json:"isolatedWallet"`
+	UpdateTime       int64  `json:"updateTime"`
+}
+
+// FuturesNetExposure return the net notional of the open positions per
+// underlying asset. LONG and SHORT positions of hedge mode offset each other,
+// in one-way mode the sign of the position amount tells the side.
+func (c *Client) FuturesNetExposure(ctx context.Context) (map[string]string, error) {
+	positions, err := c.NewGetPositionRiskService().Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	info, err := c.NewExchangeInfoService().Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	baseAssets := make(map[string]string, len(info.Symbols))
+	for _, symbol := range info.Symbols {
+		baseAssets[symbol.Symbol] = symbol.BaseAsset
+	}
+	exposure := make(map[string]*big.Rat)
+	for _, position := range positions {
+		notional, err := positionNotional(position)
+		if err != nil {
+			return nil, err
+		}
+		if notional.Sign() == 0 {
+			continue
+		}
+		asset, ok := baseAssets[position.Symbol]
+		if !ok {
+			return nil, fmt.Errorf("unknown underlying asset of %s", position.Symbol)
+		}
+		if exposure[asset] == nil {
+			exposure[asset] = new(big.Rat)
+		}
+		exposure[asset].Add(exposure[asset], notional)
+	}
+	res := make(map[string]string, len(exposure))
+	for asset, notional := range exposure {
+		res[asset] = notional.FloatString(8)
+	}
+	return res, nil
+}
+
+// positionNotional return the notional of position, negative for a short position
+func positionNotional(position *PositionRisk) (*big.Rat, error) {
+	amount, ok := new(big.Rat).SetString(position.PositionAmt)
+	if !ok {
+		return nil, fmt.Errorf("invalid position amount %q of %s", position.PositionAmt, position.Symbol)
+	}
+	notional := new(big.Rat)
+	if position.Notional != "" {
+		if _, ok := notional.SetString(position.Notional); !ok {
+			return nil, fmt.Errorf("invalid notional %q of %s", position.Notional, position.Symbol)
+		}
+	} else {
+		markPrice, ok := new(big.Rat).SetString(position.MarkPrice)
+		if !ok {
+			return nil, fmt.Errorf("invalid mark price %q of %s", position.MarkPrice, position.Symbol)
+		}
+		notional.Mul(amount, markPrice)
+	}
+	notional.Abs(notional)
+	switch PositionSideType(position.PositionSide) {
+	case PositionSideTypeShort:
+		notional.Neg(notional)
+	case PositionSideTypeLong:
+	default:
+		if amount.Sign() < 0 {
+			notional.Neg(notional)
+		}
+	}
+	return notional, nil
 }
@@ -65,6 +65,122 @@ func (s *positionRiskServiceTestSuite) TestGetPositionRisk() {
 	s.assertPositionRiskEqual(e, res[0])
 }
 
+func (s *positionRiskServiceTestSuite) TestGetPositionRiskLongShort() {
+	data := []byte(`[
+		{
+			"entryPrice": "26012.10000000",
+			"marginType": "cross",
+			"isAutoAddMargin": "false",
+			"isolatedMargin": "0.00000000",
+			"leverage": "20",
+			"liquidationPrice": "0",
+			"markPrice": "26100.50000000",
+			"maxNotionalValue": "10000000",
+			"positionAmt": "0.010",
+			"symbol": "BTCUSDT",
+			"unRealizedProfit": "0.88400000",
+			"positionSide": "LONG",
+			"notional": "261.00500000",
+			"isolatedWallet": "0",
+			"updateTime": 1695000000000
+		},
+		{
+			"entryPrice": "1650.25000000",
+			"marginType": "isolated",
+			"isAutoAddMargin": "false",
+			"isolatedMargin": "16.43009840",
+			"leverage": "10",
+			"liquidationPrice": "1798.12345678",
+			"markPrice": "1640.00000000",
+			"maxNotionalValue": "2000000",
+			"positionAmt": "-0.100",
+			"symbol": "ETHUSDT",
+			"unRealizedProfit": "1.02500000",
+			"positionSide": "SHORT",
+			"notional": "-164.00000000",
+			"isolatedWallet": "15.40509840",
+			"updateTime": 1695000000001
+		}
+	]`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+	s.assertReq(func(r *request) {
+		e := newSignedRequest()
+		s.assertRequestEqual(e, r)
+	})
+	res, err := s.client.NewGetPositionRiskService().Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	r.Len(res, 2)
+	s.assertPositionRiskEqual(&PositionRisk{
+		EntryPrice:       "26012.10000000",
+		MarginType:       "cross",
+		IsAutoAddMargin:  "false",
+		IsolatedMargin:   "0.00000000",
+		Leverage:         "20",
+		LiquidationPrice: "0",
+		MarkPrice:        "26100.50000000",
+		MaxNotionalValue: "10000000",
+		PositionAmt:      "0.010",
+		Symbol:           "BTCUSDT",
+		UnRealizedProfit: "0.88400000",
+		PositionSide:     "LONG",
+		Notional:         "261.00500000",
+		IsolatedWallet:   "0",
+		UpdateTime:       1695000000000,
+	}, res[0])
+	s.assertPositionRiskEqual(&PositionRisk{
+		EntryPrice:       "1650.25000000",
+		MarginType:       "isolated",
+		IsAutoAddMargin:  "false",
+		IsolatedMargin:   "16.43009840",
+		Leverage:         "10",
+		LiquidationPrice: "1798.12345678",
+		MarkPrice:        "1640.00000000",
+		MaxNotionalValue: "2000000",
+		PositionAmt:      "-0.100",
+		Symbol:           "ETHUSDT",
+		UnRealizedProfit: "1.02500000",
+		PositionSide:     "SHORT",
+		Notional:         "-164.00000000",
+		IsolatedWallet:   "15.40509840",
+		UpdateTime:       1695000000001,
+	}, res[1])
+}
+
+func (s *positionRiskServiceTestSuite) TestFuturesNetExposure() {
+	positions := []byte(`[
+		{"symbol": "BTCUSDT", "positionSide": "LONG", "positionAmt": "0.020", "markPrice": "26000.00000000", "notional": "520.00000000"},
+		{"symbol": "BTCUSDT", "positionSide": "SHORT", "positionAmt": "-0.020", "markPrice": "26000.00000000", "notional": "-520.00000000"},
+		{"symbol": "BTCBUSD", "positionSide": "SHORT", "positionAmt": "-0.005", "markPrice": "26010.00000000", "notional": "-130.05000000"},
+		{"symbol": "ETHUSDT", "positionSide": "LONG", "positionAmt": "0.500", "markPrice": "1600.00000000", "notional": "800.00000000"},
+		{"symbol": "ETHUSDT", "positionSide": "SHORT", "positionAmt": "-0.200", "markPrice": "1600.00000000", "notional": "-320.00000000"},
+		{"symbol": "BNBUSDT", "positionSide": "BOTH", "positionAmt": "-1.00", "markPrice": "210.00000000"},
+		{"symbol": "XRPUSDT", "positionSide": "BOTH", "positionAmt": "0", "markPrice": "0.50000000", "notional": "0"}
+	]`)
+	info := []byte(`{
+		"symbols": [
+			{"symbol": "BTCUSDT", "baseAsset": "BTC", "quoteAsset": "USDT"},
+			{"symbol": "BTCBUSD", "baseAsset": "BTC", "quoteAsset": "BUSD"},
+			{"symbol": "ETHUSDT", "baseAsset": "ETH", "quoteAsset": "USDT"},
+			{"symbol": "BNBUSDT", "baseAsset": "BNB", "quoteAsset": "USDT"},
+			{"symbol": "XRPUSDT", "baseAsset": "XRP", "quoteAsset": "USDT"}
+		]
+	}`)
+	s.client.Client.do = s.client.do
+	s.client.On("do", anyHTTPRequest()).Return(newHTTPResponse(positions, 200), nil).Once()
+	s.client.On("do", anyHTTPRequest()).Return(newHTTPResponse(info, 200), nil).Once()
+
+	res, err := s.client.FuturesNetExposure(newContext())
+	r := s.r()
+	r.NoError(err)
+	r.Equal(map[string]string{
+		"BTC": "-130.05000000",
+		"ETH": "480.00000000",
+		"BNB": "-210.00000000",
+	}, res)
+}
+
 func (s *positionRiskServiceTestSuite) assertPositionRiskEqual(e, a *PositionRisk) {
 	r := s.r()
 	r.Equal(e.EntryPrice, a.EntryPrice, "EntryPrice")
@@ -78,5 +194,8 @@ func (s *positionRiskServiceTestSuite) assertPositionRiskEqual(e, a *PositionRis
 	r.Equal(e.PositionAmt, a.PositionAmt, "PositionAmt")
 	r.Equal(e.Symbol, a.Symbol, "Symbol")
 	r.Equal(e.UnRealizedProfit, a.UnRealizedProfit, "UnRealizedProfit")
+	r.Equal(e.Notional, a.Notional, "Notional")
+	r.Equal(e.IsolatedWallet, a.IsolatedWallet, "IsolatedWallet")
+	r.Equal(e.UpdateTime, a.UpdateTime, "UpdateTime")
 	r.Equal(e.PositionSide, a.PositionSide, "PositionSide")
 }
@@ -55,6 +55,18 @@ type SymbolLeverage struct {
 	Symbol           string `json:"symbol"`
 }
 
+// Error codes of the *common.APIError returned by ChangeMarginTypeService
+const (
+	// ErrCodeNoNeedToChangeMarginType the symbol already has the margin type
+	ErrCodeNoNeedToChangeMarginType int64 = -4046
+	// ErrCodeMarginTypeOpenOrders the margin type can not be changed while
+	// there are open orders
+	ErrCodeMarginTypeOpenOrders int64 = -4047
+	// ErrCodeMarginTypeOpenPosition the margin type can not be changed while
+	// there is an open position
+	ErrCodeMarginTypeOpenPosition int64 = -4048
+)
+
 // ChangeMarginTypeService change user's margin type of specific symbol market
 type ChangeMarginTypeService struct {
 	c          *Client
@@ -74,7 +86,9 @@ func (s *ChangeMarginTypeService) MarginType(marginType MarginType) *ChangeMargi
 	return s
 }
 
-// Do send request
+// Do send request, changing to the margin type already set returns an
+// *common.APIError with code ErrCodeNoNeedToChangeMarginType, and open orders or
+// positions return ErrCodeMarginTypeOpenOrders or ErrCodeMarginTypeOpenPosition
 func (s *ChangeMarginTypeService) Do(ctx context.Context, opts ...RequestOption) (err error) {
 	r := &request{
 		method:   http.MethodPost,
@@ -127,6 +141,51 @@ func (s *UpdatePositionMarginService) Type(actionType int) *UpdatePositionMargin
 
 // Do send request
 func (s *UpdatePositionMarginService) Do(ctx context.Context, opts ...RequestOption) (err error) {
+	_, err = (&ModifyIsolatedPositionMarginService{
+		c:            s.c,
+		symbol:       s.symbol,
+		positionSide: s.positionSide,
+		amount:       s.amount,
+		actionType:   s.actionType,
+	}).Do(ctx, opts...)
+	return err
+}
+
+// ModifyIsolatedPositionMarginService add or reduce margin of an isolated position
+type ModifyIsolatedPositionMarginService struct {
+	c            *Client
+	symbol       string
+	positionSide *PositionSideType
+	amount       string
+	actionType   int
+}
+
+// Symbol set symbol
+func (s *ModifyIsolatedPositionMarginService) Symbol(symbol string) *ModifyIsolatedPositionMarginService {
+	s.symbol = symbol
+	return s
+}
+
+// PositionSide set positionSide, required in hedge mode
+func (s *ModifyIsolatedPositionMarginService) PositionSide(positionSide PositionSideType) *ModifyIsolatedPositionMarginService {
+	s.positionSide = &positionSide
+	return s
+}
+
+// Amount set position margin amount
+func (s *ModifyIsolatedPositionMarginService) Amount(amount string) *ModifyIsolatedPositionMarginService {
+	s.amount = amount
+	return s
+}
+
+// Type set action type: 1: Add position margin, 2: Reduce position margin
+func (s *ModifyIsolatedPositionMarginService) Type(actionType int) *ModifyIsolatedPositionMarginService {
+	s.actionType = actionType
+	return s
+}
+
+// Do send request
+func (s *ModifyIsolatedPositionMarginService) Do(ctx context.Context, opts ...RequestOption) (res *PositionMarginModification, err error) {
 	r := &request{
 		method:   http.MethodPost,
 		endpoint: "/fapi/v1/positionMargin",
@@ -142,11 +201,25 @@ func (s *UpdatePositionMarginService) Do(ctx context.Context, opts ...RequestOpt
 	}
 	r.setFormParams(m)
 
-	_, _, err = s.c.callAPI(ctx, r, opts...)
+	data, _, err := s.c.callAPI(ctx, r, opts...)
 	if err != nil {
-		return err
+		return nil, err
 	}
-	return nil
+	res = new(PositionMarginModification)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// PositionMarginModification define result of an isolated position margin
+// modification. Amount is sent as a JSON number, it keeps its decimal text
+type PositionMarginModification struct {
+	Amount json.Number `json:"amount"`
+	Code   int64       `json:"code"`
+	Msg    string      `json:"msg"`
+	Type   int         `json:"type"`
 }
 
 // ChangePositionModeService change user's position mode
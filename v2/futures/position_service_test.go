@@ -1,8 +1,10 @@
 package futures
 
 import (
+	"net/http"
 	"testing"
 
+	"github.com/adshao/go-binance/v2/common"
 	"github.com/stretchr/testify/suite"
 )
 
@@ -63,6 +65,40 @@ func (s *positionServiceTestSuite) TestChangeMarginType() {
 	s.r().NoError(err)
 }
 
+func (s *positionServiceTestSuite) TestChangeMarginTypeAlreadySet() {
+	data := []byte(`{
+		"code": -4046,
+		"msg": "No need to change margin type."
+	}`)
+	s.mockDo(data, nil, http.StatusBadRequest)
+	defer s.assertDo()
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setFormParams(params{
+			"symbol":     "BTCUSDT",
+			"marginType": MarginTypeCrossed,
+		})
+		s.assertRequestEqual(e, r)
+	})
+	err := s.client.NewChangeMarginTypeService().Symbol("BTCUSDT").MarginType(MarginTypeCrossed).Do(newContext())
+	r := s.r()
+	r.True(common.IsAPIError(err))
+	r.Equal(ErrCodeNoNeedToChangeMarginType, err.(*common.APIError).Code)
+}
+
+func (s *positionServiceTestSuite) TestChangeMarginTypeWithOpenPosition() {
+	data := []byte(`{
+		"code": -4048,
+		"msg": "Margin type cannot be changed if there exists position."
+	}`)
+	s.mockDo(data, nil, http.StatusBadRequest)
+	defer s.assertDo()
+	err := s.client.NewChangeMarginTypeService().Symbol("BTCUSDT").MarginType(MarginTypeIsolated).Do(newContext())
+	r := s.r()
+	r.True(common.IsAPIError(err))
+	r.Equal(ErrCodeMarginTypeOpenPosition, err.(*common.APIError).Code)
+	r.Contains(err.Error(), "exists position")
+}
+
 func (s *positionServiceTestSuite) TestUpdatePositionMargin() {
 	data := []byte(`{
 		"amount": 100.0,
@@ -91,6 +127,36 @@ func (s *positionServiceTestSuite) TestUpdatePositionMargin() {
 	s.r().NoError(err)
 }
 
+func (s *positionServiceTestSuite) TestModifyIsolatedPositionMargin() {
+	data := []byte(`{
+		"amount": 100.0,
+		"code": 200,
+		"msg": "Successfully modify position margin.",
+		"type": 1
+	}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setFormParams(params{
+			"symbol":       "BTCUSDT",
+			"positionSide": PositionSideTypeLong,
+			"amount":       "100",
+			"type":         1,
+		})
+		s.assertRequestEqual(e, r)
+	})
+	res, err := s.client.NewModifyIsolatedPositionMarginService().Symbol("BTCUSDT").
+		PositionSide(PositionSideTypeLong).Amount("100").Type(1).Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	r.Equal(&PositionMarginModification{
+		Amount: "100.0",
+		Code:   200,
+		Msg:    "Successfully modify position margin.",
+		Type:   1,
+	}, res)
+}
+
 func (s *positionServiceTestSuite) TestChangePositionMode() {
 	data := []byte(`{
 		"code": 200,
@@ -108,6 +174,26 @@ func (s *positionServiceTestSuite) TestChangePositionMode() {
 	s.r().NoError(err)
 }
 
+func (s *positionServiceTestSuite) TestChangePositionModeWithOpenPositions() {
+	data := []byte(`{
+		"code": -4068,
+		"msg": "The position side cannot be changed if there exists position."
+	}`)
+	s.mockDo(data, nil, http.StatusBadRequest)
+	defer s.assertDo()
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setFormParams(params{
+			"dualSidePosition": "false",
+		})
+		s.assertRequestEqual(e, r)
+	})
+	err := s.client.NewChangePositionModeService().DualSide(false).Do(newContext())
+	r := s.r()
+	r.Error(err)
+	r.True(common.IsAPIError(err))
+	r.Equal(int64(-4068), err.(*common.APIError).Code)
+}
+
 func (s *positionServiceTestSuite) TestGetPositionMode() {
 	data := []byte(`{
 		"dualSidePosition": true
@@ -4,6 +4,9 @@ import (
 	"context"
 	"encoding/json"
 	"net/http"
+	"time"
+
+	"github.com/adshao/go-binance/v2/common"
 )
 
 // HistoricalTradesService trades
@@ -113,12 +116,22 @@ func (s *AggTradesService) StartTime(startTime int64) *AggTradesService {
 	return s
 }
 
+// StartTimeFrom set startTime from t, converted to epoch milliseconds
+func (s *AggTradesService) StartTimeFrom(t time.Time) *AggTradesService {
+	return s.StartTime(FormatTimestamp(t))
+}
+
 // EndTime set endTime
 func (s *AggTradesService) EndTime(endTime int64) *AggTradesService {
 	s.endTime = &endTime
 	return s
 }
 
+// EndTimeFrom set endTime from t, converted to epoch milliseconds
+func (s *AggTradesService) EndTimeFrom(t time.Time) *AggTradesService {
+	return s.EndTime(FormatTimestamp(t))
+}
+
 // Limit set limit
 func (s *AggTradesService) Limit(limit int) *AggTradesService {
 	s.limit = &limit
@@ -230,12 +243,22 @@ func (s *ListAccountTradeService) StartTime(startTime int64) *ListAccountTradeSe
 	return s
 }
 
+// StartTimeFrom set startTime from t, converted to epoch milliseconds
+func (s *ListAccountTradeService) StartTimeFrom(t time.Time) *ListAccountTradeService {
+	return s.StartTime(FormatTimestamp(t))
+}
+
 // EndTime set endTime
 func (s *ListAccountTradeService) EndTime(endTime int64) *ListAccountTradeService {
 	s.endTime = &endTime
 	return s
 }
 
+// EndTimeFrom set endTime from t, converted to epoch milliseconds
+func (s *ListAccountTradeService) EndTimeFrom(t time.Time) *ListAccountTradeService {
+	return s.EndTime(FormatTimestamp(t))
+}
+
 // FromID set fromID
 func (s *ListAccountTradeService) FromID(fromID int64) *ListAccountTradeService {
 	s.fromID = &fromID
@@ -263,7 +286,7 @@ func (s *ListAccountTradeService) Do(ctx context.Context, opts ...RequestOption)
 		r.setParam("endTime", *s.endTime)
 	}
 	if s.fromID != nil {
-		r.setParam("fromID", *s.fromID)
+		r.setParam("fromId", *s.fromID)
 	}
 	if s.limit != nil {
 		r.setParam("limit", *s.limit)
@@ -280,12 +303,48 @@ func (s *ListAccountTradeService) Do(ctx context.Context, opts ...RequestOption)
 	return res, nil
 }
 
+// Iterate export the futures fills of the symbol from /fapi/v1/userTrades,
+// calling fn for every fill in ascending trade id order, e.g. to rebuild the
+// realized pnl of a position. The endpoint serves at most 1000 fills per
+// call, so the limit defaults to and is capped at 1000 and a limit <= 0 is
+// rejected before any call. Pages after the first continue at fromId = last
+// trade id + 1, which the endpoint refuses together with startTime and
+// endTime, so the window only selects the first page and a fill after endTime
+// ends the export. An error returned by fn stops the export and is returned.
+func (s *ListAccountTradeService) Iterate(ctx context.Context, fn func(*AccountTrade) error, opts ...RequestOption) error {
+	limit, err := common.PageLimit(s.limit, 1000)
+	if err != nil {
+		return err
+	}
+	page := *s
+	page.limit = &limit
+	return common.PageForward(limit, func(fromID *int64) (int, int64, bool, error) {
+		if fromID != nil {
+			page.fromID, page.startTime, page.endTime = fromID, nil, nil
+		}
+		trades, err := page.Do(ctx, opts...)
+		if err != nil || len(trades) == 0 {
+			return 0, 0, false, err
+		}
+		for _, t := range trades {
+			if s.endTime != nil && t.Time > *s.endTime {
+				return 0, 0, true, nil
+			}
+			if err = fn(t); err != nil {
+				return 0, 0, false, err
+			}
+		}
+		return len(trades), trades[len(trades)-1].ID, false, nil
+	})
+}
+
 // AccountTrade define account trade
 type AccountTrade struct {
 	Buyer           bool             `json:"buyer"`
 	Commission      string           `json:"commission"`
 	CommissionAsset string           `json:"commissionAsset"`
 	ID              int64            `json:"id"`
+	MarginAsset     string           `json:"marginAsset"`
 	Maker           bool             `json:"maker"`
 	OrderID         int64            `json:"orderId"`
 	Price           string           `json:"price"`
@@ -172,6 +172,7 @@ func (s *tradeServiceTestSuite) TestAccountTradeList() {
 			"commission": "-0.07819010",
 			"commissionAsset": "USDT",
 			"id": 698759,
+			"marginAsset": "USDT",
 			"maker": false,
 			"orderId": 25851813,
 			"price": "7819.01",
@@ -197,7 +198,7 @@ func (s *tradeServiceTestSuite) TestAccountTradeList() {
 			"symbol":    symbol,
 			"startTime": startTime,
 			"endTime":   endTime,
-			"fromID":    fromID,
+			"fromId":    fromID,
 			"limit":     limit,
 		})
 		s.assertRequestEqual(e, r)
@@ -213,6 +214,7 @@ func (s *tradeServiceTestSuite) TestAccountTradeList() {
 		Commission:      "-0.07819010",
 		CommissionAsset: "USDT",
 		ID:              698759,
+		MarginAsset:     "USDT",
 		Maker:           false,
 		OrderID:         25851813,
 		Price:           "7819.01",
@@ -227,12 +229,97 @@ func (s *tradeServiceTestSuite) TestAccountTradeList() {
 	s.assertAccountTradeEqual(e, trades[0])
 }
 
+func (s *tradeServiceTestSuite) TestIterateAccountTrades() {
+	page1 := []byte(`[
+		{"symbol": "BTCUSDT", "id": 100, "orderId": 1, "price": "7819.01", "qty": "0.002", "realizedPnl": "-0.91539999", "time": 1569514978020},
+		{"symbol": "BTCUSDT", "id": 101, "orderId": 1, "price": "7819.02", "qty": "0.001", "realizedPnl": "0.12000000", "time": 1569514978021}
+	]`)
+	page2 := []byte(`[
+		{"symbol": "BTCUSDT", "id": 102, "orderId": 2, "price": "7820.00", "qty": "0.003", "realizedPnl": "1.50000000", "time": 1569514978022}
+	]`)
+	s.client.Client.do = s.client.do
+	s.client.On("do", anyHTTPRequest()).Return(newHTTPResponse(page1, 200), nil).Once()
+	s.client.On("do", anyHTTPRequest()).Return(newHTTPResponse(page2, 200), nil).Once()
+	defer s.assertDo()
+
+	symbol := "BTCUSDT"
+	startTime := int64(1569514978000)
+	calls := 0
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setParams(params{
+			"symbol":    symbol,
+			"limit":     2,
+			"startTime": startTime,
+		})
+		if calls > 0 {
+			e = newSignedRequest().setParams(params{
+				"symbol": symbol,
+				"limit":  2,
+				"fromId": 102,
+			})
+		}
+		calls++
+		s.assertRequestEqual(e, r)
+	})
+
+	var pnl []string
+	err := s.client.NewListAccountTradeService().Symbol(symbol).StartTime(startTime).Limit(2).
+		Iterate(newContext(), func(t *AccountTrade) error {
+			pnl = append(pnl, t.RealizedPnl)
+			return nil
+		})
+	r := s.r()
+	r.NoError(err)
+	r.Equal(2, calls)
+	r.Equal([]string{"-0.91539999", "0.12000000", "1.50000000"}, pnl)
+}
+
+func (s *tradeServiceTestSuite) TestIterateAccountTradesInvalidLimit() {
+	calls := 0
+	err := s.client.NewListAccountTradeService().Symbol("BTCUSDT").Limit(-1).
+		Iterate(newContext(), func(t *AccountTrade) error {
+			calls++
+			return nil
+		})
+	r := s.r()
+	r.Error(err)
+	r.Equal(0, calls)
+}
+
+func (s *tradeServiceTestSuite) TestIterateAccountTradesLimitCapped() {
+	page1 := []byte(`[
+		{"symbol": "BTCUSDT", "id": 100, "orderId": 1, "price": "7819.01", "qty": "0.002", "realizedPnl": "-0.91539999", "time": 1569514978020}
+	]`)
+	s.client.Client.do = s.client.do
+	s.client.On("do", anyHTTPRequest()).Return(newHTTPResponse(page1, 200), nil).Once()
+	defer s.assertDo()
+
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setParams(params{
+			"symbol": "BTCUSDT",
+			"limit":  1000,
+		})
+		s.assertRequestEqual(e, r)
+	})
+
+	var ids []int64
+	err := s.client.NewListAccountTradeService().Symbol("BTCUSDT").Limit(2000).
+		Iterate(newContext(), func(t *AccountTrade) error {
+			ids = append(ids, t.ID)
+			return nil
+		})
+	r := s.r()
+	r.NoError(err)
+	r.Equal([]int64{100}, ids)
+}
+
 func (s *tradeServiceTestSuite) assertAccountTradeEqual(e, a *AccountTrade) {
 	r := s.r()
 	r.Equal(e.ID, a.ID, "ID")
 	r.Equal(e.Buyer, a.Buyer, "Buyer")
 	r.Equal(e.Commission, a.Commission, "Commission")
 	r.Equal(e.CommissionAsset, a.CommissionAsset, "CommissionAsset")
+	r.Equal(e.MarginAsset, a.MarginAsset, "MarginAsset")
 	r.Equal(e.Maker, a.Maker, "Maker")
 	r.Equal(e.OrderID, a.OrderID, "OrderID")
 	r.Equal(e.Price, a.Price, "Price")
@@ -35,7 +35,9 @@ type KeepaliveUserStreamService struct {
 	listenKey string
 }
 
-// ListenKey set listen key
+// ListenKey set listen key.
+// It is kept for compatibility only: futures derives the listen key from the
+// API key, so it is not sent with the request.
 func (s *KeepaliveUserStreamService) ListenKey(listenKey string) *KeepaliveUserStreamService {
 	s.listenKey = listenKey
 	return s
@@ -48,7 +50,6 @@ func (s *KeepaliveUserStreamService) Do(ctx context.Context, opts ...RequestOpti
 		endpoint: "/fapi/v1/listenKey",
 		secType:  secTypeSigned,
 	}
-	r.setFormParam("listenKey", s.listenKey)
 	_, _, err = s.c.callAPI(ctx, r, opts...)
 	return err
 }
@@ -59,7 +60,9 @@ type CloseUserStreamService struct {
 	listenKey string
 }
 
-// ListenKey set listen key
+// ListenKey set listen key.
+// It is kept for compatibility only: futures derives the listen key from the
+// API key, so it is not sent with the request.
 func (s *CloseUserStreamService) ListenKey(listenKey string) *CloseUserStreamService {
 	s.listenKey = listenKey
 	return s
@@ -72,7 +75,6 @@ func (s *CloseUserStreamService) Do(ctx context.Context, opts ...RequestOption)
 		endpoint: "/fapi/v1/listenKey",
 		secType:  secTypeSigned,
 	}
-	r.setFormParam("listenKey", s.listenKey)
 	_, _, err = s.c.callAPI(ctx, r, opts...)
 	return err
 }
@@ -1,6 +1,7 @@
 package futures
 
 import (
+	"net/http"
 	"testing"
 
 	"github.com/stretchr/testify/suite"
@@ -20,6 +21,7 @@ func (s *userStreamServiceTestSuite) TestStartUserStream() {
     }`)
 	s.mockDo(data, nil)
 	defer s.assertDo()
+	req := s.captureHTTPRequest()
 
 	s.assertReq(func(r *request) {
 		s.assertRequestEqual(newSignedRequest(), r)
@@ -28,32 +30,54 @@ func (s *userStreamServiceTestSuite) TestStartUserStream() {
 	listenKey, err := s.client.NewStartUserStreamService().Do(newContext())
 	s.r().NoError(err)
 	s.r().Equal("pqia91ma19a5s61cv6a81va65sdf19v8a65a1a5s61cv6a81va65sdf19v8a65a1", listenKey)
+	s.assertEndpoint(req, http.MethodPost, "/fapi/v1/listenKey")
 }
 
 func (s *userStreamServiceTestSuite) TestKeepaliveUserStream() {
 	data := []byte(`{}`)
 	s.mockDo(data, nil)
 	defer s.assertDo()
+	req := s.captureHTTPRequest()
 
 	listenKey := "dummykey"
 	s.assertReq(func(r *request) {
-		s.assertRequestEqual(newSignedRequest().setFormParam("listenKey", listenKey), r)
+		s.assertRequestEqual(newSignedRequest(), r)
 	})
 
 	err := s.client.NewKeepaliveUserStreamService().ListenKey(listenKey).Do(newContext())
 	s.r().NoError(err)
+	s.assertEndpoint(req, http.MethodPut, "/fapi/v1/listenKey")
 }
 
 func (s *userStreamServiceTestSuite) TestCloseUserStream() {
 	data := []byte(`{}`)
 	s.mockDo(data, nil)
 	defer s.assertDo()
+	req := s.captureHTTPRequest()
 
 	listenKey := "dummykey"
 	s.assertReq(func(r *request) {
-		s.assertRequestEqual(newSignedRequest().setFormParam("listenKey", listenKey), r)
+		s.assertRequestEqual(newSignedRequest(), r)
 	})
 
 	err := s.client.NewCloseUserStreamService().ListenKey(listenKey).Do(newContext())
 	s.r().NoError(err)
+	s.assertEndpoint(req, http.MethodDelete, "/fapi/v1/listenKey")
+}
+
+// captureHTTPRequest records the last http request sent by the client
+func (s *userStreamServiceTestSuite) captureHTTPRequest() *http.Request {
+	req := new(http.Request)
+	do := s.client.Client.do
+	s.client.Client.do = func(r *http.Request) (*http.Response, error) {
+		*req = *r
+		return do(r)
+	}
+	return req
+}
+
+func (s *userStreamServiceTestSuite) assertEndpoint(req *http.Request, method, path string) {
+	r := s.r()
+	r.Equal(method, req.Method, "Method")
+	r.Equal(path, req.URL.Path, "Path")
 }
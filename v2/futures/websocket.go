@@ -1,92 +1,105 @@
 package futures
 
 import (
+	"crypto/tls"
+	"net/url"
 	"time"
 
+	"github.com/adshao/go-binance/v2/common"
 	"github.com/gorilla/websocket"
 )
 
 // WsHandler handle raw websocket message
-type WsHandler func(message []byte)
+type WsHandler = common.WsHandler
 
 // ErrHandler handles errors
-type ErrHandler func(err error)
+type ErrHandler = common.ErrHandler
 
 // WsConfig webservice configuration
-type WsConfig struct {
-	Endpoint string
+type WsConfig = common.WsConfig
+
+// WsEvent is a typed event along with the message it was parsed from
+type WsEvent = common.WsEvent
+
+// WsEventHandler handle typed events along with their raw message
+type WsEventHandler = common.WsEventHandler
+
+// WsBufferPolicy define what to do when the message buffer is full
+type WsBufferPolicy = common.WsBufferPolicy
+
+// Buffer policies
+const (
+	WsBufferPolicyBlock      = common.WsBufferPolicyBlock
+	WsBufferPolicyDropOldest = common.WsBufferPolicyDropOldest
+	WsBufferPolicyDropNewest = common.WsBufferPolicyDropNewest
+)
+
+// WsOption define option of a websocket connection, given to the serve call
+// of the stream
+type WsOption = common.WsOption
+
+// WithWsKeepalive send a ping every interval and close the connection when
+// no pong is received within interval
+func WithWsKeepalive(interval time.Duration) WsOption {
+	return common.WithWsKeepalive(interval)
+}
+
+// WithWsReadTimeout set read deadline of the connection
+func WithWsReadTimeout(timeout time.Duration) WsOption {
+	return common.WithWsReadTimeout(timeout)
+}
+
+// WithWsWriteTimeout set write deadline of ping and pong messages
+func WithWsWriteTimeout(timeout time.Duration) WsOption {
+	return common.WithWsWriteTimeout(timeout)
 }
 
-func newWsConfig(endpoint string) *WsConfig {
-	return &WsConfig{
-		Endpoint: endpoint,
-	}
+// WithWsBuffer queue up to size messages for the handler so a slow handler
+// does not block reading, policy decides what happens when the queue is full.
+// dropped may be nil, otherwise it counts the dropped messages and should be
+// read with atomic.LoadInt64
+func WithWsBuffer(size int, policy WsBufferPolicy, dropped *int64) WsOption {
+	return common.WithWsBuffer(size, policy, dropped)
 }
 
-var wsServe = func(cfg *WsConfig, handler WsHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
-	c, _, err := websocket.DefaultDialer.Dial(cfg.Endpoint, nil)
-	if err != nil {
-		return nil, nil, err
-	}
-	c.SetReadLimit(655350)
-	doneC = make(chan struct{})
-	stopC = make(chan struct{})
-	go func() {
-		// This function will exit either on error from
-		// websocket.Conn.ReadMessage or when the stopC channel is
-		// closed by the client.
-		defer close(doneC)
-		if WebsocketKeepalive {
-			keepAlive(c, WebsocketTimeout)
-		}
-		// Wait for the stopC channel to be closed.  We do that in a
-		// separate goroutine because ReadMessage is a blocking
-		// operation.
-		silent := false
-		go func() {
-			select {
-			case <-stopC:
-				silent = true
-			case <-doneC:
-			}
-			c.Close()
-		}()
-		for {
-			_, message, err := c.ReadMessage()
-			if err != nil {
-				if !silent {
-					errHandler(err)
-				}
-				return
-			}
-			handler(message)
-		}
-	}()
-	return
+// WithWsDialer set the dialer used to connect
+func WithWsDialer(dialer *websocket.Dialer) WsOption {
+	return common.WithWsDialer(dialer)
 }
 
-func keepAlive(c *websocket.Conn, timeout time.Duration) {
-	ticker := time.NewTicker(timeout)
-
-	lastResponse := time.Now()
-	c.SetPongHandler(func(msg string) error {
-		lastResponse = time.Now()
-		return nil
-	})
-
-	go func() {
-		defer ticker.Stop()
-		for {
-			deadline := time.Now().Add(10 * time.Second)
-			err := c.WriteControl(websocket.PingMessage, []byte{}, deadline)
-			if err != nil {
-				return
-			}
-			<-ticker.C
-			if time.Since(lastResponse) > timeout {
-				c.Close()
-				return
-			}
-		}
-	}()
+// WithWsProxy connect through the http proxy, credentials of an
+// authenticated proxy are taken from the user info of proxyURL
+func WithWsProxy(proxyURL *url.URL) WsOption {
+	return common.WithWsProxy(proxyURL)
 }
+
+// WithWsTLSConfig set the tls config used to connect, e.g. to pin a CA
+func WithWsTLSConfig(tlsConfig *tls.Config) WsOption {
+	return common.WithWsTLSConfig(tlsConfig)
+}
+
+// WithWsMaxDialRetries retry a failed dial up to n times before returning the
+// error, waiting as set by WithWsDialBackoff between attempts
+func WithWsMaxDialRetries(n int) WsOption {
+	return common.WithWsMaxDialRetries(n)
+}
+
+// WithWsDialBackoff wait base after the first failed dial, doubled after
+// every following failure up to max
+func WithWsDialBackoff(base, max time.Duration) WsOption {
+	return common.WithWsDialBackoff(base, max)
+}
+
+// WithWsEventHandler call handler with every typed event and its raw message,
+// after the typed handler of the stream
+func WithWsEventHandler(handler WsEventHandler) WsOption {
+	return common.WithWsEventHandler(handler)
+}
+
+// newWsConfig return the config of a connection to endpoint, the package
+// keepalive settings modified by the options of the serve call
+func newWsConfig(endpoint string, opts ...WsOption) *WsConfig {
+	return common.NewWsConfig(endpoint, WebsocketKeepalive, WebsocketTimeout, opts...)
+}
+
+var wsServe = common.WsServe
@@ -59,9 +59,9 @@ type WsAggTradeEvent struct {
 type WsAggTradeHandler func(event *WsAggTradeEvent)
 
 // WsAggTradeServe serve websocket that push trade information that is aggregated for a single taker order.
-func WsAggTradeServe(symbol string, handler WsAggTradeHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+func WsAggTradeServe(symbol string, handler WsAggTradeHandler, errHandler ErrHandler, opts ...WsOption) (doneC, stopC chan struct{}, err error) {
 	endpoint := fmt.Sprintf("%s/%s@aggTrade", getWsEndpoint(), strings.ToLower(symbol))
-	cfg := newWsConfig(endpoint)
+	cfg := newWsConfig(endpoint, opts...)
 	wsHandler := func(message []byte) {
 		event := new(WsAggTradeEvent)
 		err := json.Unmarshal(message, &event)
@@ -70,18 +70,19 @@ func WsAggTradeServe(symbol string, handler WsAggTradeHandler, errHandler ErrHan
 			return
 		}
 		handler(event)
+		cfg.HandleEvent(message, event)
 	}
 	return wsServe(cfg, wsHandler, errHandler)
 }
 
 // WsCombinedAggTradeServe is similar to WsAggTradeServe, but it handles multiple symbols
-func WsCombinedAggTradeServe(symbols []string, handler WsAggTradeHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+func WsCombinedAggTradeServe(symbols []string, handler WsAggTradeHandler, errHandler ErrHandler, opts ...WsOption) (doneC, stopC chan struct{}, err error) {
 	endpoint := getCombinedEndpoint()
 	for _, s := range symbols {
 		endpoint += fmt.Sprintf("%s@aggTrade", strings.ToLower(s)) + "/"
 	}
 	endpoint = endpoint[:len(endpoint)-1]
-	cfg := newWsConfig(endpoint)
+	cfg := newWsConfig(endpoint, opts...)
 	wsHandler := func(message []byte) {
 		j, err := newJSON(message)
 		if err != nil {
@@ -105,6 +106,7 @@ func WsCombinedAggTradeServe(symbols []string, handler WsAggTradeHandler, errHan
 		event.Symbol = strings.ToUpper(symbol)
 
 		handler(event)
+		cfg.HandleEvent(message, event)
 	}
 	return wsServe(cfg, wsHandler, errHandler)
 }
@@ -124,8 +126,8 @@ type WsMarkPriceEvent struct {
 // WsMarkPriceHandler handle websocket that pushes price and funding rate for a single symbol.
 type WsMarkPriceHandler func(event *WsMarkPriceEvent)
 
-func wsMarkPriceServe(endpoint string, handler WsMarkPriceHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
-	cfg := newWsConfig(endpoint)
+func wsMarkPriceServe(endpoint string, handler WsMarkPriceHandler, errHandler ErrHandler, opts ...WsOption) (doneC, stopC chan struct{}, err error) {
+	cfg := newWsConfig(endpoint, opts...)
 	wsHandler := func(message []byte) {
 		event := new(WsMarkPriceEvent)
 		err := json.Unmarshal(message, &event)
@@ -134,18 +136,19 @@ func wsMarkPriceServe(endpoint string, handler WsMarkPriceHandler, errHandler Er
 			return
 		}
 		handler(event)
+		cfg.HandleEvent(message, event)
 	}
 	return wsServe(cfg, wsHandler, errHandler)
 }
 
 // WsMarkPriceServe serve websocket that pushes price and funding rate for a single symbol.
-func WsMarkPriceServe(symbol string, handler WsMarkPriceHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+func WsMarkPriceServe(symbol string, handler WsMarkPriceHandler, errHandler ErrHandler, opts ...WsOption) (doneC, stopC chan struct{}, err error) {
 	endpoint := fmt.Sprintf("%s/%s@markPrice", getWsEndpoint(), strings.ToLower(symbol))
-	return wsMarkPriceServe(endpoint, handler, errHandler)
+	return wsMarkPriceServe(endpoint, handler, errHandler, opts...)
 }
 
 // WsMarkPriceServeWithRate serve websocket that pushes price and funding rate for a single symbol and rate.
-func WsMarkPriceServeWithRate(symbol string, rate time.Duration, handler WsMarkPriceHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+func WsMarkPriceServeWithRate(symbol string, rate time.Duration, handler WsMarkPriceHandler, errHandler ErrHandler, opts ...WsOption) (doneC, stopC chan struct{}, err error) {
 	var rateStr string
 	switch rate {
 	case 3 * time.Second:
@@ -156,7 +159,7 @@ func WsMarkPriceServeWithRate(symbol string, rate time.Duration, handler WsMarkP
 		return nil, nil, errors.New("Invalid rate")
 	}
 	endpoint := fmt.Sprintf("%s/%s@markPrice%s", getWsEndpoint(), strings.ToLower(symbol), rateStr)
-	return wsMarkPriceServe(endpoint, handler, errHandler)
+	return wsMarkPriceServe(endpoint, handler, errHandler, opts...)
 }
 
 // WsAllMarkPriceEvent defines an array of websocket markPriceUpdate events.
@@ -165,8 +168,8 @@ type WsAllMarkPriceEvent []*WsMarkPriceEvent
 // WsAllMarkPriceHandler handle websocket that pushes price and funding rate for all symbol.
 type WsAllMarkPriceHandler func(event WsAllMarkPriceEvent)
 
-func wsAllMarkPriceServe(endpoint string, handler WsAllMarkPriceHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
-	cfg := newWsConfig(endpoint)
+func wsAllMarkPriceServe(endpoint string, handler WsAllMarkPriceHandler, errHandler ErrHandler, opts ...WsOption) (doneC, stopC chan struct{}, err error) {
+	cfg := newWsConfig(endpoint, opts...)
 	wsHandler := func(message []byte) {
 		var event WsAllMarkPriceEvent
 		err := json.Unmarshal(message, &event)
@@ -175,18 +178,19 @@ func wsAllMarkPriceServe(endpoint string, handler WsAllMarkPriceHandler, errHand
 			return
 		}
 		handler(event)
+		cfg.HandleEvent(message, event)
 	}
 	return wsServe(cfg, wsHandler, errHandler)
 }
 
 // WsAllMarkPriceServe serve websocket that pushes price and funding rate for all symbol.
-func WsAllMarkPriceServe(handler WsAllMarkPriceHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+func WsAllMarkPriceServe(handler WsAllMarkPriceHandler, errHandler ErrHandler, opts ...WsOption) (doneC, stopC chan struct{}, err error) {
 	endpoint := fmt.Sprintf("%s/!markPrice@arr", getWsEndpoint())
-	return wsAllMarkPriceServe(endpoint, handler, errHandler)
+	return wsAllMarkPriceServe(endpoint, handler, errHandler, opts...)
 }
 
 // WsAllMarkPriceServeWithRate serve websocket that pushes price and funding rate for all symbol and rate.
-func WsAllMarkPriceServeWithRate(rate time.Duration, handler WsAllMarkPriceHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+func WsAllMarkPriceServeWithRate(rate time.Duration, handler WsAllMarkPriceHandler, errHandler ErrHandler, opts ...WsOption) (doneC, stopC chan struct{}, err error) {
 	var rateStr string
 	switch rate {
 	case 3 * time.Second:
@@ -197,7 +201,7 @@ func WsAllMarkPriceServeWithRate(rate time.Duration, handler WsAllMarkPriceHandl
 		return nil, nil, errors.New("Invalid rate")
 	}
 	endpoint := fmt.Sprintf("%s/!markPrice@arr%s", getWsEndpoint(), rateStr)
-	return wsAllMarkPriceServe(endpoint, handler, errHandler)
+	return wsAllMarkPriceServe(endpoint, handler, errHandler, opts...)
 }
 
 // WsKlineEvent define websocket kline event
@@ -232,9 +236,9 @@ type WsKline struct {
 type WsKlineHandler func(event *WsKlineEvent)
 
 // WsKlineServe serve websocket kline handler with a symbol and interval like 15m, 30s
-func WsKlineServe(symbol string, interval string, handler WsKlineHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+func WsKlineServe(symbol string, interval string, handler WsKlineHandler, errHandler ErrHandler, opts ...WsOption) (doneC, stopC chan struct{}, err error) {
 	endpoint := fmt.Sprintf("%s/%s@kline_%s", getWsEndpoint(), strings.ToLower(symbol), interval)
-	cfg := newWsConfig(endpoint)
+	cfg := newWsConfig(endpoint, opts...)
 	wsHandler := func(message []byte) {
 		event := new(WsKlineEvent)
 		err := json.Unmarshal(message, event)
@@ -243,18 +247,19 @@ func WsKlineServe(symbol string, interval string, handler WsKlineHandler, errHan
 			return
 		}
 		handler(event)
+		cfg.HandleEvent(message, event)
 	}
 	return wsServe(cfg, wsHandler, errHandler)
 }
 
 // WsCombinedKlineServe is similar to WsKlineServe, but it handles multiple symbols with it interval
-func WsCombinedKlineServe(symbolIntervalPair map[string]string, handler WsKlineHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+func WsCombinedKlineServe(symbolIntervalPair map[string]string, handler WsKlineHandler, errHandler ErrHandler, opts ...WsOption) (doneC, stopC chan struct{}, err error) {
 	endpoint := getCombinedEndpoint()
 	for symbol, interval := range symbolIntervalPair {
 		endpoint += fmt.Sprintf("%s@kline_%s", strings.ToLower(symbol), interval) + "/"
 	}
 	endpoint = endpoint[:len(endpoint)-1]
-	cfg := newWsConfig(endpoint)
+	cfg := newWsConfig(endpoint, opts...)
 	wsHandler := func(message []byte) {
 		j, err := newJSON(message)
 		if err != nil {
@@ -278,10 +283,65 @@ func WsCombinedKlineServe(symbolIntervalPair map[string]string, handler WsKlineH
 		event.Symbol = strings.ToUpper(symbol)
 
 		handler(event)
+		cfg.HandleEvent(message, event)
 	}
 	return wsServe(cfg, wsHandler, errHandler)
 }
 
+// WsPriceKlineEvent define websocket index price or mark price kline event
+type WsPriceKlineEvent struct {
+	Event string       `json:"e"`
+	Time  int64        `json:"E"`
+	Pair  string       `json:"ps"`
+	Kline WsPriceKline `json:"k"`
+}
+
+// WsPriceKline define websocket index price or mark price kline, it has no
+// volume as it is not traded
+type WsPriceKline struct {
+	StartTime       int64  `json:"t"`
+	EndTime         int64  `json:"T"`
+	Interval        string `json:"i"`
+	FirstUpdateTime int64  `json:"f"`
+	LastUpdateTime  int64  `json:"L"`
+	Open            string `json:"o"`
+	Close           string `json:"c"`
+	High            string `json:"h"`
+	Low             string `json:"l"`
+	UpdateNum       int64  `json:"n"`
+	IsFinal         bool   `json:"x"`
+}
+
+// WsPriceKlineHandler handle websocket index price or mark price kline event
+type WsPriceKlineHandler func(event *WsPriceKlineEvent)
+
+func wsPriceKlineServe(endpoint string, handler WsPriceKlineHandler, errHandler ErrHandler, opts ...WsOption) (doneC, stopC chan struct{}, err error) {
+	cfg := newWsConfig(endpoint, opts...)
+	wsHandler := func(message []byte) {
+		event := new(WsPriceKlineEvent)
+		err := json.Unmarshal(message, event)
+		if err != nil {
+			errHandler(err)
+			return
+		}
+		handler(event)
+		cfg.HandleEvent(message, event)
+	}
+	return wsServe(cfg, wsHandler, errHandler)
+}
+
+// WsIndexPriceKlineServe serve websocket kline of the index price of a pair with an interval like 15m
+func WsIndexPriceKlineServe(pair string, interval string, handler WsPriceKlineHandler, errHandler ErrHandler, opts ...WsOption) (doneC, stopC chan struct{}, err error) {
+	endpoint := fmt.Sprintf("%s/%s@indexPriceKline_%s", getWsEndpoint(), strings.ToLower(pair), interval)
+	return wsPriceKlineServe(endpoint, handler, errHandler, opts...)
+}
+
+// WsMarkPriceKlineServe serve websocket kline of the mark price of a symbol with an interval like 15m
+func WsMarkPriceKlineServe(symbol string, interval string, handler WsPriceKlineHandler, errHandler ErrHandler, opts ...WsOption) (doneC, stopC chan struct{}, err error) {
+	endpoint := fmt.Sprintf("%s/%s@markPriceKline_%s", getWsEndpoint(), strings.ToLower(symbol), interval)
+	return wsPriceKlineServe(endpoint, handler, errHandler, opts...)
+}
+
 // WsMiniMarketTickerEvent define websocket mini market ticker event.
 type WsMiniMarketTickerEvent struct {
 	Event       string `json:"e"`
@@ -299,9 +359,9 @@ type WsMiniMarketTickerEvent struct {
 type WsMiniMarketTickerHandler func(event *WsMiniMarketTickerEvent)
 
 // WsMiniMarketTickerServe serve websocket that pushes 24hr rolling window mini-ticker statistics for a single symbol.
-func WsMiniMarketTickerServe(symbol string, handler WsMiniMarketTickerHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+func WsMiniMarketTickerServe(symbol string, handler WsMiniMarketTickerHandler, errHandler ErrHandler, opts ...WsOption) (doneC, stopC chan struct{}, err error) {
 	endpoint := fmt.Sprintf("%s/%s@miniTicker", getWsEndpoint(), strings.ToLower(symbol))
-	cfg := newWsConfig(endpoint)
+	cfg := newWsConfig(endpoint, opts...)
 	wsHandler := func(message []byte) {
 		event := new(WsMiniMarketTickerEvent)
 		err := json.Unmarshal(message, &event)
@@ -310,6 +370,7 @@ func WsMiniMarketTickerServe(symbol string, handler WsMiniMarketTickerHandler, e
 			return
 		}
 		handler(event)
+		cfg.HandleEvent(message, event)
 	}
 	return wsServe(cfg, wsHandler, errHandler)
 }
@@ -321,9 +382,9 @@ type WsAllMiniMarketTickerEvent []*WsMiniMarketTickerEvent
 type WsAllMiniMarketTickerHandler func(event WsAllMiniMarketTickerEvent)
 
 // WsAllMiniMarketTickerServe serve websocket that pushes price and funding rate for all markets.
-func WsAllMiniMarketTickerServe(handler WsAllMiniMarketTickerHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+func WsAllMiniMarketTickerServe(handler WsAllMiniMarketTickerHandler, errHandler ErrHandler, opts ...WsOption) (doneC, stopC chan struct{}, err error) {
 	endpoint := fmt.Sprintf("%s/!miniTicker@arr", getWsEndpoint())
-	cfg := newWsConfig(endpoint)
+	cfg := newWsConfig(endpoint, opts...)
 	wsHandler := func(message []byte) {
 		var event WsAllMiniMarketTickerEvent
 		err := json.Unmarshal(message, &event)
@@ -332,6 +393,7 @@ func WsAllMiniMarketTickerServe(handler WsAllMiniMarketTickerHandler, errHandler
 			return
 		}
 		handler(event)
+		cfg.HandleEvent(message, event)
 	}
 	return wsServe(cfg, wsHandler, errHandler)
 }
@@ -362,9 +424,9 @@ type WsMarketTickerEvent struct {
 type WsMarketTickerHandler func(event *WsMarketTickerEvent)
 
 // WsMarketTickerServe serve websocket that pushes 24hr rolling window mini-ticker statistics for a single symbol.
-func WsMarketTickerServe(symbol string, handler WsMarketTickerHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+func WsMarketTickerServe(symbol string, handler WsMarketTickerHandler, errHandler ErrHandler, opts ...WsOption) (doneC, stopC chan struct{}, err error) {
 	endpoint := fmt.Sprintf("%s/%s@ticker", getWsEndpoint(), strings.ToLower(symbol))
-	cfg := newWsConfig(endpoint)
+	cfg := newWsConfig(endpoint, opts...)
 	wsHandler := func(message []byte) {
 		event := new(WsMarketTickerEvent)
 		err := json.Unmarshal(message, &event)
@@ -373,6 +435,7 @@ func WsMarketTickerServe(symbol string, handler WsMarketTickerHandler, errHandle
 			return
 		}
 		handler(event)
+		cfg.HandleEvent(message, event)
 	}
 	return wsServe(cfg, wsHandler, errHandler)
 }
@@ -384,9 +447,9 @@ type WsAllMarketTickerEvent []*WsMarketTickerEvent
 type WsAllMarketTickerHandler func(event WsAllMarketTickerEvent)
 
 // WsAllMarketTickerServe serve websocket that pushes price and funding rate for all markets.
-func WsAllMarketTickerServe(handler WsAllMarketTickerHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+func WsAllMarketTickerServe(handler WsAllMarketTickerHandler, errHandler ErrHandler, opts ...WsOption) (doneC, stopC chan struct{}, err error) {
 	endpoint := fmt.Sprintf("%s/!ticker@arr", getWsEndpoint())
-	cfg := newWsConfig(endpoint)
+	cfg := newWsConfig(endpoint, opts...)
 	wsHandler := func(message []byte) {
 		var event WsAllMarketTickerEvent
 		err := json.Unmarshal(message, &event)
@@ -395,6 +458,7 @@ func WsAllMarketTickerServe(handler WsAllMarketTickerHandler, errHandler ErrHand
 			return
 		}
 		handler(event)
+		cfg.HandleEvent(message, event)
 	}
 	return wsServe(cfg, wsHandler, errHandler)
 }
@@ -416,9 +480,9 @@ type WsBookTickerEvent struct {
 type WsBookTickerHandler func(event *WsBookTickerEvent)
 
 // WsBookTickerServe serve websocket that pushes updates to the best bid or ask price or quantity in real-time for a specified symbol.
-func WsBookTickerServe(symbol string, handler WsBookTickerHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+func WsBookTickerServe(symbol string, handler WsBookTickerHandler, errHandler ErrHandler, opts ...WsOption) (doneC, stopC chan struct{}, err error) {
 	endpoint := fmt.Sprintf("%s/%s@bookTicker", getWsEndpoint(), strings.ToLower(symbol))
-	cfg := newWsConfig(endpoint)
+	cfg := newWsConfig(endpoint, opts...)
 	wsHandler := func(message []byte) {
 		event := new(WsBookTickerEvent)
 		err := json.Unmarshal(message, &event)
@@ -427,14 +491,15 @@ func WsBookTickerServe(symbol string, handler WsBookTickerHandler, errHandler Er
 			return
 		}
 		handler(event)
+		cfg.HandleEvent(message, event)
 	}
 	return wsServe(cfg, wsHandler, errHandler)
 }
 
 // WsAllBookTickerServe serve websocket that pushes updates to the best bid or ask price or quantity in real-time for all symbols.
-func WsAllBookTickerServe(handler WsBookTickerHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+func WsAllBookTickerServe(handler WsBookTickerHandler, errHandler ErrHandler, opts ...WsOption) (doneC, stopC chan struct{}, err error) {
 	endpoint := fmt.Sprintf("%s/!bookTicker", getWsEndpoint())
-	cfg := newWsConfig(endpoint)
+	cfg := newWsConfig(endpoint, opts...)
 	wsHandler := func(message []byte) {
 		event := new(WsBookTickerEvent)
 		err := json.Unmarshal(message, &event)
@@ -443,6 +508,7 @@ func WsAllBookTickerServe(handler WsBookTickerHandler, errHandler ErrHandler) (d
 			return
 		}
 		handler(event)
+		cfg.HandleEvent(message, event)
 	}
 	return wsServe(cfg, wsHandler, errHandler)
 }
@@ -473,9 +539,9 @@ type WsLiquidationOrder struct {
 type WsLiquidationOrderHandler func(event *WsLiquidationOrderEvent)
 
 // WsLiquidationOrderServe serve websocket that pushes force liquidation order information for specific symbol.
-func WsLiquidationOrderServe(symbol string, handler WsLiquidationOrderHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+func WsLiquidationOrderServe(symbol string, handler WsLiquidationOrderHandler, errHandler ErrHandler, opts ...WsOption) (doneC, stopC chan struct{}, err error) {
 	endpoint := fmt.Sprintf("%s/%s@forceOrder", getWsEndpoint(), strings.ToLower(symbol))
-	cfg := newWsConfig(endpoint)
+	cfg := newWsConfig(endpoint, opts...)
 	wsHandler := func(message []byte) {
 		event := new(WsLiquidationOrderEvent)
 		err := json.Unmarshal(message, &event)
@@ -484,14 +550,15 @@ func WsLiquidationOrderServe(symbol string, handler WsLiquidationOrderHandler, e
 			return
 		}
 		handler(event)
+		cfg.HandleEvent(message, event)
 	}
 	return wsServe(cfg, wsHandler, errHandler)
 }
 
 // WsAllLiquidationOrderServe serve websocket that pushes force liquidation order information for all symbols.
-func WsAllLiquidationOrderServe(handler WsLiquidationOrderHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+func WsAllLiquidationOrderServe(handler WsLiquidationOrderHandler, errHandler ErrHandler, opts ...WsOption) (doneC, stopC chan struct{}, err error) {
 	endpoint := fmt.Sprintf("%s/!forceOrder@arr", getWsEndpoint())
-	cfg := newWsConfig(endpoint)
+	cfg := newWsConfig(endpoint, opts...)
 	wsHandler := func(message []byte) {
 		event := new(WsLiquidationOrderEvent)
 		err := json.Unmarshal(message, &event)
@@ -500,6 +567,52 @@ func WsAllLiquidationOrderServe(handler WsLiquidationOrderHandler, errHandler Er
 			return
 		}
 		handler(event)
+		cfg.HandleEvent(message, event)
+	}
+	return wsServe(cfg, wsHandler, errHandler)
+}
+
+// WsContractInfoEvent define websocket contract info event, pushed when the
+// status, brackets or delivery of a symbol change
+type WsContractInfoEvent struct {
+	Event        string              `json:"e"`
+	Time         int64               `json:"E"`
+	Symbol       string              `json:"s"`
+	Pair         string              `json:"ps"`
+	ContractType ContractType        `json:"ct"`
+	DeliveryDate int64               `json:"dt"`
+	OnboardDate  int64               `json:"ot"`
+	Status       string              `json:"cs"`
+	Brackets     []WsContractBracket `json:"bks"`
+}
+
+// WsContractBracket define a notional bracket of a contract info event
+type WsContractBracket struct {
+	Bracket          int64   `json:"bs"`
+	NotionalFloor    float64 `json:"bnf"`
+	NotionalCap      float64 `json:"bnc"`
+	MaintMarginRatio float64 `json:"mmr"`
+	Cum              float64 `json:"cf"`
+	MinLeverage      int64   `json:"mi"`
+	MaxLeverage      int64   `json:"ma"`
+}
+
+// WsContractInfoHandler handle websocket contract info event
+type WsContractInfoHandler func(event *WsContractInfoEvent)
+
+// WsContractInfoServe serve websocket that pushes contract info updates of all symbols
+func WsContractInfoServe(handler WsContractInfoHandler, errHandler ErrHandler, opts ...WsOption) (doneC, stopC chan struct{}, err error) {
+	endpoint := fmt.Sprintf("%s/!contractInfo", getWsEndpoint())
+	cfg := newWsConfig(endpoint, opts...)
+	wsHandler := func(message []byte) {
+		event := new(WsContractInfoEvent)
+		err := json.Unmarshal(message, &event)
+		if err != nil {
+			errHandler(err)
+			return
+		}
+		handler(event)
+		cfg.HandleEvent(message, event)
 	}
 	return wsServe(cfg, wsHandler, errHandler)
 }
@@ -520,37 +633,37 @@ type WsDepthEvent struct {
 // WsDepthHandler handle websocket depth event
 type WsDepthHandler func(event *WsDepthEvent)
 
-func wsPartialDepthServe(symbol string, levels int, rate *time.Duration, handler WsDepthHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+func wsPartialDepthServe(symbol string, levels int, rate *time.Duration, handler WsDepthHandler, errHandler ErrHandler, opts ...WsOption) (doneC, stopC chan struct{}, err error) {
 	if levels != 5 && levels != 10 && levels != 20 {
 		return nil, nil, errors.New("Invalid levels")
 	}
 	levelsStr := fmt.Sprintf("%d", levels)
-	return wsDepthServe(symbol, levelsStr, rate, handler, errHandler)
+	return wsDepthServe(symbol, levelsStr, rate, handler, errHandler, opts...)
 }
 
 // WsPartialDepthServe serve websocket partial depth handler.
-func WsPartialDepthServe(symbol string, levels int, handler WsDepthHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
-	return wsPartialDepthServe(symbol, levels, nil, handler, errHandler)
+func WsPartialDepthServe(symbol string, levels int, handler WsDepthHandler, errHandler ErrHandler, opts ...WsOption) (doneC, stopC chan struct{}, err error) {
+	return wsPartialDepthServe(symbol, levels, nil, handler, errHandler, opts...)
 }
 
 // WsPartialDepthServeWithRate serve websocket partial depth handler with rate.
-func WsPartialDepthServeWithRate(symbol string, levels int, rate time.Duration, handler WsDepthHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
-	return wsPartialDepthServe(symbol, levels, &rate, handler, errHandler)
+func WsPartialDepthServeWithRate(symbol string, levels int, rate time.Duration, handler WsDepthHandler, errHandler ErrHandler, opts ...WsOption) (doneC, stopC chan struct{}, err error) {
+	return wsPartialDepthServe(symbol, levels, &rate, handler, errHandler, opts...)
 }
 
 // WsDiffDepthServe serve websocket diff. depth handler.
-func WsDiffDepthServe(symbol string, handler WsDepthHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
-	return wsDepthServe(symbol, "", nil, handler, errHandler)
+func WsDiffDepthServe(symbol string, handler WsDepthHandler, errHandler ErrHandler, opts ...WsOption) (doneC, stopC chan struct{}, err error) {
+	return wsDepthServe(symbol, "", nil, handler, errHandler, opts...)
 }
 
 // WsCombinedDepthServe is similar to WsPartialDepthServe, but it for multiple symbols
-func WsCombinedDepthServe(symbolLevels map[string]string, handler WsDepthHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+func WsCombinedDepthServe(symbolLevels map[string]string, handler WsDepthHandler, errHandler ErrHandler, opts ...WsOption) (doneC, stopC chan struct{}, err error) {
 	endpoint := getCombinedEndpoint()
 	for s, l := range symbolLevels {
 		endpoint += fmt.Sprintf("%s@depth%s", strings.ToLower(s), l) + "/"
 	}
 	endpoint = endpoint[:len(endpoint)-1]
-	cfg := newWsConfig(endpoint)
+	cfg := newWsConfig(endpoint, opts...)
 	wsHandler := func(message []byte) {
 		j, err := newJSON(message)
 		if err != nil {
@@ -585,18 +698,19 @@ func WsCombinedDepthServe(symbolLevels map[string]string, handler WsDepthHandler
 			}
 		}
 		handler(event)
+		cfg.HandleEvent(message, event)
 	}
 	return wsServe(cfg, wsHandler, errHandler)
 }
 
 // WsCombinedDiffDepthServe is similar to WsDiffDepthServe, but it for multiple symbols
-func WsCombinedDiffDepthServe(symbols []string, handler WsDepthHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+func WsCombinedDiffDepthServe(symbols []string, handler WsDepthHandler, errHandler ErrHandler, opts ...WsOption) (doneC, stopC chan struct{}, err error) {
 	endpoint := getCombinedEndpoint()
 	for _, s := range symbols {
 		endpoint += fmt.Sprintf("%s@depth", strings.ToLower(s)) + "/"
 	}
 	endpoint = endpoint[:len(endpoint)-1]
-	cfg := newWsConfig(endpoint)
+	cfg := newWsConfig(endpoint, opts...)
 	wsHandler := func(message []byte) {
 		j, err := newJSON(message)
 		if err != nil {
@@ -631,16 +745,17 @@ func WsCombinedDiffDepthServe(symbols []string, handler WsDepthHandler, errHandl
 			}
 		}
 		handler(event)
+		cfg.HandleEvent(message, event)
 	}
 	return wsServe(cfg, wsHandler, errHandler)
 }
 
 // WsDiffDepthServeWithRate serve websocket diff. depth handler with rate.
-func WsDiffDepthServeWithRate(symbol string, rate time.Duration, handler WsDepthHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
-	return wsDepthServe(symbol, "", &rate, handler, errHandler)
+func WsDiffDepthServeWithRate(symbol string, rate time.Duration, handler WsDepthHandler, errHandler ErrHandler, opts ...WsOption) (doneC, stopC chan struct{}, err error) {
+	return wsDepthServe(symbol, "", &rate, handler, errHandler, opts...)
 }
 
-func wsDepthServe(symbol string, levels string, rate *time.Duration, handler WsDepthHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+func wsDepthServe(symbol string, levels string, rate *time.Duration, handler WsDepthHandler, errHandler ErrHandler, opts ...WsOption) (doneC, stopC chan struct{}, err error) {
 	var rateStr string
 	if rate != nil {
 		switch *rate {
@@ -655,7 +770,7 @@ func wsDepthServe(symbol string, levels string, rate *time.Duration, handler WsD
 		}
 	}
 	endpoint := fmt.Sprintf("%s/%s@depth%s%s", getWsEndpoint(), strings.ToLower(symbol), levels, rateStr)
-	cfg := newWsConfig(endpoint)
+	cfg := newWsConfig(endpoint, opts...)
 	wsHandler := func(message []byte) {
 		j, err := newJSON(message)
 		if err != nil {
@@ -689,6 +804,7 @@ func wsDepthServe(symbol string, levels string, rate *time.Duration, handler WsD
 			}
 		}
 		handler(event)
+		cfg.HandleEvent(message, event)
 	}
 	return wsServe(cfg, wsHandler, errHandler)
 }
@@ -716,9 +832,9 @@ type WsBLVTBasket struct {
 type WsBLVTInfoHandler func(event *WsBLVTInfoEvent)
 
 // WsBLVTInfoServe serve BLVT info stream
-func WsBLVTInfoServe(name string, handler WsBLVTInfoHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+func WsBLVTInfoServe(name string, handler WsBLVTInfoHandler, errHandler ErrHandler, opts ...WsOption) (doneC, stopC chan struct{}, err error) {
 	endpoint := fmt.Sprintf("%s/%s@tokenNav", getWsEndpoint(), strings.ToUpper(name))
-	cfg := newWsConfig(endpoint)
+	cfg := newWsConfig(endpoint, opts...)
 	wsHandler := func(message []byte) {
 		event := new(WsBLVTInfoEvent)
 		err := json.Unmarshal(message, &event)
@@ -727,6 +843,7 @@ func WsBLVTInfoServe(name string, handler WsBLVTInfoHandler, errHandler ErrHandl
 			return
 		}
 		handler(event)
+		cfg.HandleEvent(message, event)
 	}
 	return wsServe(cfg, wsHandler, errHandler)
 }
@@ -759,9 +876,9 @@ type WsBLVTKline struct {
 type WsBLVTKlineHandler func(event *WsBLVTKlineEvent)
 
 // WsBLVTKlineServe serve BLVT kline stream
-func WsBLVTKlineServe(name string, interval string, handler WsBLVTKlineHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+func WsBLVTKlineServe(name string, interval string, handler WsBLVTKlineHandler, errHandler ErrHandler, opts ...WsOption) (doneC, stopC chan struct{}, err error) {
 	endpoint := fmt.Sprintf("%s/%s@nav_Kline_%s", getWsEndpoint(), strings.ToUpper(name), interval)
-	cfg := newWsConfig(endpoint)
+	cfg := newWsConfig(endpoint, opts...)
 	wsHandler := func(message []byte) {
 		event := new(WsBLVTKlineEvent)
 		err := json.Unmarshal(message, event)
@@ -770,6 +887,7 @@ func WsBLVTKlineServe(name string, interval string, handler WsBLVTKlineHandler,
 			return
 		}
 		handler(event)
+		cfg.HandleEvent(message, event)
 	}
 	return wsServe(cfg, wsHandler, errHandler)
 }
@@ -794,9 +912,9 @@ type WsComposition struct {
 type WsCompositeIndexHandler func(event *WsCompositeIndexEvent)
 
 // WsCompositiveIndexServe serve composite index information for index symbols
-func WsCompositiveIndexServe(symbol string, handler WsCompositeIndexHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+func WsCompositiveIndexServe(symbol string, handler WsCompositeIndexHandler, errHandler ErrHandler, opts ...WsOption) (doneC, stopC chan struct{}, err error) {
 	endpoint := fmt.Sprintf("%s/%s@compositeIndex", getWsEndpoint(), strings.ToLower(symbol))
-	cfg := newWsConfig(endpoint)
+	cfg := newWsConfig(endpoint, opts...)
 	wsHandler := func(message []byte) {
 		event := new(WsCompositeIndexEvent)
 		err := json.Unmarshal(message, event)
@@ -805,6 +923,7 @@ func WsCompositiveIndexServe(symbol string, handler WsCompositeIndexHandler, err
 			return
 		}
 		handler(event)
+		cfg.HandleEvent(message, event)
 	}
 	return wsServe(cfg, wsHandler, errHandler)
 }
@@ -819,6 +938,7 @@ type WsUserDataEvent struct {
 	AccountUpdate       WsAccountUpdate       `json:"a"`
 	OrderTradeUpdate    WsOrderTradeUpdate    `json:"o"`
 	AccountConfigUpdate WsAccountConfigUpdate `json:"ac"`
+	AccountInfoUpdate   WsAccountInfoUpdate   `json:"ai"`
 }
 
 // WsAccountUpdate define account update
@@ -890,13 +1010,46 @@ type WsAccountConfigUpdate struct {
 	Leverage int64  `json:"l"`
 }
 
+// WsAccountInfoUpdate define account info update
+type WsAccountInfoUpdate struct {
+	MultiAssetsMode bool `json:"j"`
+}
+
 // WsUserDataHandler handle WsUserDataEvent
 type WsUserDataHandler func(event *WsUserDataEvent)
 
+// WsUserDataOption define option of user data stream
+type WsUserDataOption func(*wsUserDataOptions)
+
+type wsUserDataOptions struct {
+	onExpired func()
+	wsOptions []WsOption
+}
+
+// WithOnExpired set the callback fired when a listenKeyExpired event is
+// received. The stream is dead after that event, so the callback is the place
+// to create a new listen key and serve a new stream.
+func WithOnExpired(f func()) WsUserDataOption {
+	return func(o *wsUserDataOptions) {
+		o.onExpired = f
+	}
+}
+
+// WithUserDataWsOptions set the options of the user data stream connection
+func WithUserDataWsOptions(opts ...WsOption) WsUserDataOption {
+	return func(o *wsUserDataOptions) {
+		o.wsOptions = append(o.wsOptions, opts...)
+	}
+}
+
 // WsUserDataServe serve user data handler with listen key
-func WsUserDataServe(listenKey string, handler WsUserDataHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+func WsUserDataServe(listenKey string, handler WsUserDataHandler, errHandler ErrHandler, opts ...WsUserDataOption) (doneC, stopC chan struct{}, err error) {
+	options := new(wsUserDataOptions)
+	for _, opt := range opts {
+		opt(options)
+	}
 	endpoint := fmt.Sprintf("%s/%s", getWsEndpoint(), listenKey)
-	cfg := newWsConfig(endpoint)
+	cfg := newWsConfig(endpoint, options.wsOptions...)
 	wsHandler := func(message []byte) {
 		event := new(WsUserDataEvent)
 		err := json.Unmarshal(message, event)
@@ -905,6 +1058,10 @@ func WsUserDataServe(listenKey string, handler WsUserDataHandler, errHandler Err
 			return
 		}
 		handler(event)
+		cfg.HandleEvent(message, event)
+		if event.Event == UserDataEventTypeListenKeyExpired && options.onExpired != nil {
+			options.onExpired()
+		}
 	}
 	return wsServe(cfg, wsHandler, errHandler)
 }
@@ -157,6 +157,7 @@ func (s *websocketServiceTestSuite) testMarkPriceServe(rate *time.Duration, expe
 		"s": "BTCUSDT",
 		"p": "11794.15000000",
 		"i": "11784.62659091",
+		"P": "11784.25641265",
 		"r": "0.00038167",
 		"T": 1562306400000  
 	  }`)
@@ -165,13 +166,14 @@ func (s *websocketServiceTestSuite) testMarkPriceServe(rate *time.Duration, expe
 
 	handler := func(event *WsMarkPriceEvent) {
 		e := &WsMarkPriceEvent{
-			Event:           "markPriceUpdate",
-			Time:            1562305380000,
-			Symbol:          "BTCUSDT",
-			MarkPrice:       "11794.15000000",
-			IndexPrice:      "11784.62659091",
-			FundingRate:     "0.00038167",
-			NextFundingTime: 1562306400000,
+			Event:                "markPriceUpdate",
+			Time:                 1562305380000,
+			Symbol:               "BTCUSDT",
+			MarkPrice:            "11794.15000000",
+			IndexPrice:           "11784.62659091",
+			EstimatedSettlePrice: "11784.25641265",
+			FundingRate:          "0.00038167",
+			NextFundingTime:      1562306400000,
 		}
 		s.assertWsMarkPriceEvent(e, event)
 	}
@@ -233,6 +235,7 @@ func (s *websocketServiceTestSuite) testAllMarkPriceServe(rate *time.Duration, e
 		"s": "BTCUSDT",
 		"p": "11794.15000000",
 		"i": "11784.62659091",
+		"P": "11784.25641265",
 		"r": "0.00038167",
 		"T": 1562306400000  
 	  }]`)
@@ -241,13 +244,14 @@ func (s *websocketServiceTestSuite) testAllMarkPriceServe(rate *time.Duration, e
 
 	handler := func(event WsAllMarkPriceEvent) {
 		e := WsAllMarkPriceEvent{{
-			Event:           "markPriceUpdate",
-			Time:            1562305380000,
-			Symbol:          "BTCUSDT",
-			MarkPrice:       "11794.15000000",
-			IndexPrice:      "11784.62659091",
-			FundingRate:     "0.00038167",
-			NextFundingTime: 1562306400000,
+			Event:                "markPriceUpdate",
+			Time:                 1562305380000,
+			Symbol:               "BTCUSDT",
+			MarkPrice:            "11794.15000000",
+			IndexPrice:           "11784.62659091",
+			EstimatedSettlePrice: "11784.25641265",
+			FundingRate:          "0.00038167",
+			NextFundingTime:      1562306400000,
 		}}
 		s.assertWsMarkPriceEvent(e[0], event[0])
 	}
@@ -282,6 +286,7 @@ func (s *websocketServiceTestSuite) assertWsMarkPriceEvent(e, a *WsMarkPriceEven
 	r.Equal(e.Symbol, a.Symbol, "Symbol")
 	r.Equal(e.MarkPrice, a.MarkPrice, "MarkPrice")
 	r.Equal(e.IndexPrice, a.IndexPrice, "IndexPrice")
+	r.Equal(e.EstimatedSettlePrice, a.EstimatedSettlePrice, "EstimatedSettlePrice")
 	r.Equal(e.FundingRate, a.FundingRate, "FundingRate")
 	r.Equal(e.NextFundingTime, a.NextFundingTime, "NextFundingTime")
 }
@@ -464,6 +469,141 @@ func (s *websocketServiceTestSuite) TestWsCombinedKlineServe() {
 	<-doneC
 }
 
+func (s *websocketServiceTestSuite) mockWsServeEndpoint(data []byte, endpoint *string) {
+	wsServe = func(cfg *WsConfig, handler WsHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+		s.serveCount++
+		*endpoint = cfg.Endpoint
+		doneC = make(chan struct{})
+		stopC = make(chan struct{})
+		go func() {
+			<-stopC
+			close(doneC)
+		}()
+		handler(data)
+		return doneC, stopC, nil
+	}
+}
+
+func (s *websocketServiceTestSuite) TestIndexPriceKlineServe() {
+	data := []byte(`{
+		"e": "indexPriceKline",
+		"E": 1591261236000,
+		"ps": "BTCUSDT",
+		"k": {
+			"t": 1591261200000,
+			"T": 1591261259999,
+			"s": "0",
+			"i": "1m",
+			"f": 1591261200000,
+			"L": 1591261220000,
+			"o": "9907.61",
+			"c": "9908.82",
+			"h": "9910.50",
+			"l": "9906.68",
+			"v": "0",
+			"n": 60,
+			"x": false,
+			"q": "0",
+			"V": "0",
+			"Q": "0",
+			"B": "0"
+		}
+	}`)
+	var endpoint string
+	s.mockWsServeEndpoint(data, &endpoint)
+	defer s.assertWsServe()
+
+	var event *WsPriceKlineEvent
+	doneC, stopC, err := WsIndexPriceKlineServe("BTCUSDT", "1m", func(e *WsPriceKlineEvent) {
+		event = e
+	}, func(err error) {
+		s.r().NoError(err)
+	})
+	r := s.r()
+	r.NoError(err)
+	r.Equal(getWsEndpoint()+"/btcusdt@indexPriceKline_1m", endpoint)
+	r.Equal(&WsPriceKlineEvent{
+		Event: "indexPriceKline",
+		Time:  1591261236000,
+		Pair:  "BTCUSDT",
+		Kline: WsPriceKline{
+			StartTime:       1591261200000,
+			EndTime:         1591261259999,
+			Interval:        "1m",
+			FirstUpdateTime: 1591261200000,
+			LastUpdateTime:  1591261220000,
+			Open:            "9907.61",
+			Close:           "9908.82",
+			High:            "9910.50",
+			Low:             "9906.68",
+			UpdateNum:       60,
+			IsFinal:         false,
+		},
+	}, event)
+	stopC <- struct{}{}
+	<-doneC
+}
+
+func (s *websocketServiceTestSuite) TestMarkPriceKlineServe() {
+	data := []byte(`{
+		"e": "markPriceKline",
+		"E": 1591267398004,
+		"ps": "BTCUSDT",
+		"k": {
+			"t": 1591267380000,
+			"T": 1591267439999,
+			"s": "0",
+			"i": "1m",
+			"f": 1591267380000,
+			"L": 1591267398000,
+			"o": "9539.67161333",
+			"c": "9540.82761333",
+			"h": "9540.82761333",
+			"l": "9539.66961333",
+			"v": "0",
+			"n": 19,
+			"x": true,
+			"q": "0",
+			"V": "0",
+			"Q": "0",
+			"B": "0"
+		}
+	}`)
+	var endpoint string
+	s.mockWsServeEndpoint(data, &endpoint)
+	defer s.assertWsServe()
+
+	var event *WsPriceKlineEvent
+	doneC, stopC, err := WsMarkPriceKlineServe("BTCUSDT", "1m", func(e *WsPriceKlineEvent) {
+		event = e
+	}, func(err error) {
+		s.r().NoError(err)
+	})
+	r := s.r()
+	r.NoError(err)
+	r.Equal(getWsEndpoint()+"/btcusdt@markPriceKline_1m", endpoint)
+	r.Equal(&WsPriceKlineEvent{
+		Event: "markPriceKline",
+		Time:  1591267398004,
+		Pair:  "BTCUSDT",
+		Kline: WsPriceKline{
+			StartTime:       1591267380000,
+			EndTime:         1591267439999,
+			Interval:        "1m",
+			FirstUpdateTime: 1591267380000,
+			LastUpdateTime:  1591267398000,
+			Open:            "9539.67161333",
+			Close:           "9540.82761333",
+			High:            "9540.82761333",
+			Low:             "9539.66961333",
+			UpdateNum:       19,
+			IsFinal:         true,
+		},
+	}, event)
+	stopC <- struct{}{}
+	<-doneC
+}
+
 func (s *websocketServiceTestSuite) TestMiniMarketTickerServe() {
 	data := []byte(`{
 		"e": "24hrMiniTicker", 
@@ -884,6 +1024,89 @@ func (s *websocketServiceTestSuite) TestAllLiquidationOrderServe() {
 	<-doneC
 }
 
+func (s *websocketServiceTestSuite) TestLiquidationOrderServeEndpoints() {
+	var endpoints []string
+	wsServe = func(cfg *WsConfig, handler WsHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+		endpoints = append(endpoints, cfg.Endpoint)
+		handler([]byte(`{"e":"forceOrder","E":1568014460894,"o":{"s":"ETHUSDT","S":"BUY","o":"LIMIT","f":"IOC",` +
+			`"q":"1.5","p":"1620.5","ap":"1620.1","X":"FILLED","l":"1.5","z":"1.5","T":1568014460893}}`))
+		return make(chan struct{}), make(chan struct{}), nil
+	}
+
+	var symbols []string
+	handler := func(event *WsLiquidationOrderEvent) {
+		symbols = append(symbols, event.LiquidationOrder.Symbol)
+		s.r().Equal("1620.1", event.LiquidationOrder.AvgPrice)
+	}
+	errHandler := func(err error) { s.r().NoError(err) }
+	_, _, err := WsLiquidationOrderServe("ETHUSDT", handler, errHandler)
+	s.r().NoError(err)
+	_, _, err = WsAllLiquidationOrderServe(handler, errHandler)
+	s.r().NoError(err)
+	s.r().Equal([]string{
+		getWsEndpoint() + "/ethusdt@forceOrder",
+		getWsEndpoint() + "/!forceOrder@arr",
+	}, endpoints)
+	s.r().Equal([]string{"ETHUSDT", "ETHUSDT"}, symbols)
+}
+
+func (s *websocketServiceTestSuite) TestContractInfoServe() {
+	data := []byte(`{
+		"e":"contractInfo",
+		"E":1669356423908,
+		"s":"IOTAUSDT",
+		"ps":"IOTAUSDT",
+		"ct":"PERPETUAL",
+		"dt":4133404800000,
+		"ot":1569398400000,
+		"cs":"TRADING",
+		"bks":[
+			{"bs":1, "bnf":0, "bnc":5000, "mmr":0.01, "cf":0, "mi":21, "ma":50},
+			{"bs":2, "bnf":5000, "bnc":25000, "mmr":0.025, "cf":75, "mi":11, "ma":20}
+		]
+	}`)
+	var endpoint string
+	wsServe = func(cfg *WsConfig, handler WsHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+		s.serveCount++
+		endpoint = cfg.Endpoint
+		doneC = make(chan struct{})
+		stopC = make(chan struct{})
+		go func() {
+			<-stopC
+			close(doneC)
+		}()
+		handler(data)
+		return doneC, stopC, nil
+	}
+	defer s.assertWsServe()
+
+	var event *WsContractInfoEvent
+	doneC, stopC, err := WsContractInfoServe(func(e *WsContractInfoEvent) {
+		event = e
+	}, func(err error) {
+		s.r().NoError(err)
+	})
+	r := s.r()
+	r.NoError(err)
+	r.Equal(getWsEndpoint()+"/!contractInfo", endpoint)
+	r.Equal(&WsContractInfoEvent{
+		Event:        "contractInfo",
+		Time:         1669356423908,
+		Symbol:       "IOTAUSDT",
+		Pair:         "IOTAUSDT",
+		ContractType: ContractTypePerpetual,
+		DeliveryDate: 4133404800000,
+		OnboardDate:  1569398400000,
+		Status:       "TRADING",
+		Brackets: []WsContractBracket{
+			{Bracket: 1, NotionalFloor: 0, NotionalCap: 5000, MaintMarginRatio: 0.01, Cum: 0, MinLeverage: 21, MaxLeverage: 50},
+			{Bracket: 2, NotionalFloor: 5000, NotionalCap: 25000, MaintMarginRatio: 0.025, Cum: 75, MinLeverage: 11, MaxLeverage: 20},
+		},
+	}, event)
+	stopC <- struct{}{}
+	<-doneC
+}
+
 func (s *websocketServiceTestSuite) assertLiquidationOrderEvent(e, a *WsLiquidationOrderEvent) {
 	r := s.r()
 	r.Equal(e.Event, a.Event, "Event")
@@ -1378,6 +1601,32 @@ func (s *websocketServiceTestSuite) TestWsUserDataServeStreamExpired() {
 	s.testWsUserDataServe(data, expectedEvent)
 }
 
+func (s *websocketServiceTestSuite) TestWsUserDataServeOnExpired() {
+	data := []byte(`{
+		"e": "listenKeyExpired",
+		"E": 1576653824250
+	}`)
+	s.mockWsServe(data, nil)
+	defer s.assertWsServe()
+
+	expired := 0
+	var event *WsUserDataEvent
+	doneC, stopC, err := WsUserDataServe("fakeListenKey", func(e *WsUserDataEvent) {
+		event = e
+	}, func(err error) {
+		s.r().FailNow("unexpected error", err)
+	}, WithOnExpired(func() {
+		expired++
+	}))
+
+	r := s.r()
+	r.NoError(err)
+	r.Equal(1, expired)
+	r.Equal(UserDataEventTypeListenKeyExpired, event.Event)
+	stopC <- struct{}{}
+	<-doneC
+}
+
 func (s *websocketServiceTestSuite) TestWsUserDataServeMarginCall() {
 	data := []byte(`{
 		"e":"MARGIN_CALL",
@@ -1625,6 +1874,26 @@ func (s *websocketServiceTestSuite) TestWsUserDataServeAccountConfigUpdate() {
 	s.testWsUserDataServe(data, expectedEvent)
 }
 
+func (s *websocketServiceTestSuite) TestWsUserDataServeAccountInfoUpdate() {
+	data := []byte(`{
+		"e":"ACCOUNT_CONFIG_UPDATE",
+		"E":1611646737479,
+		"T":1611646737476,
+		"ai":{
+		"j":true
+		}
+	}`)
+	expectedEvent := &WsUserDataEvent{
+		Event:           "ACCOUNT_CONFIG_UPDATE",
+		Time:            1611646737479,
+		TransactionTime: 1611646737476,
+		AccountInfoUpdate: WsAccountInfoUpdate{
+			MultiAssetsMode: true,
+		},
+	}
+	s.testWsUserDataServe(data, expectedEvent)
+}
+
 func (s *websocketServiceTestSuite) assertUserDataEvent(e, a *WsUserDataEvent) {
 	r := s.r()
 	r.Equal(e.Event, a.Event, "Event")
@@ -1638,6 +1907,7 @@ func (s *websocketServiceTestSuite) assertUserDataEvent(e, a *WsUserDataEvent) {
 	s.assertAccountUpdate(e.AccountUpdate, a.AccountUpdate)
 	s.assertOrderTradeUpdate(e.OrderTradeUpdate, a.OrderTradeUpdate)
 	s.assertAccountConfigUpdate(e.AccountConfigUpdate, a.AccountConfigUpdate)
+	r.Equal(e.AccountInfoUpdate, a.AccountInfoUpdate, "AccountInfoUpdate")
 }
 
 func (s *websocketServiceTestSuite) assertPosition(e, a WsPosition) {
@@ -0,0 +1,30 @@
+package futures
+
+import "time"
+
+func (s *websocketServiceTestSuite) TestWsOptionsPerServeCall() {
+	var configs []*WsConfig
+	wsServe = func(cfg *WsConfig, handler WsHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+		configs = append(configs, cfg)
+		return make(chan struct{}), make(chan struct{}), nil
+	}
+
+	r := s.r()
+	_, _, err := WsDiffDepthServe("BTCUSDT", func(event *WsDepthEvent) {}, func(err error) {},
+		WithWsReadTimeout(time.Second), WithWsKeepalive(time.Minute))
+	r.NoError(err)
+	_, _, err = WsAggTradeServe("BTCUSDT", func(event *WsAggTradeEvent) {}, func(err error) {})
+	r.NoError(err)
+	_, _, err = WsUserDataServe("listenKey", func(event *WsUserDataEvent) {}, func(err error) {},
+		WithUserDataWsOptions(WithWsReadTimeout(2*time.Second)))
+	r.NoError(err)
+
+	r.Len(configs, 3)
+	r.Equal(time.Second, configs[0].ReadTimeout)
+	r.True(configs[0].Keepalive)
+	r.Equal(time.Minute, configs[0].Timeout)
+	// options of a serve call do not leak into the others
+	r.Zero(configs[1].ReadTimeout)
+	r.Equal(WebsocketKeepalive, configs[1].Keepalive)
+	r.Equal(2*time.Second, configs[2].ReadTimeout)
+}
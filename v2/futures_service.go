@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"net/http"
+	"time"
 )
 
 // FuturesTransferService transfer asset between spot account and futures account
@@ -79,12 +80,22 @@ func (s *ListFuturesTransferService) StartTime(startTime int64) *ListFuturesTran
 	return s
 }
 
+// StartTimeFrom set startTime from t, converted to epoch milliseconds
+func (s *ListFuturesTransferService) StartTimeFrom(t time.Time) *ListFuturesTransferService {
+	return s.StartTime(FormatTimestamp(t))
+}
+
 // EndTime set end time
 func (s *ListFuturesTransferService) EndTime(endTime int64) *ListFuturesTransferService {
 	s.endTime = &endTime
 	return s
 }
 
+// EndTimeFrom set endTime from t, converted to epoch milliseconds
+func (s *ListFuturesTransferService) EndTimeFrom(t time.Time) *ListFuturesTransferService {
+	return s.EndTime(FormatTimestamp(t))
+}
+
 // Current currently querying page. Start from 1. Default:1
 func (s *ListFuturesTransferService) Current(current int64) *ListFuturesTransferService {
 	s.current = &current
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"net/http"
+	"time"
 )
 
 // InterestHistoryService fetches the interest history
@@ -36,6 +37,11 @@ func (s *InterestHistoryService) StartTime(startTime int64) *InterestHistoryServ
 	return s
 }
 
+// StartTimeFrom set startTime from t, converted to epoch milliseconds
+func (s *InterestHistoryService) StartTimeFrom(t time.Time) *InterestHistoryService {
+	return s.StartTime(FormatTimestamp(t))
+}
+
 // EndTime sets the endTime parameter.
 // If present, StartTime MUST be specified. The difference between EndTime - StartTime MUST be between 0-90 days.
 func (s *InterestHistoryService) EndTime(endTime int64) *InterestHistoryService {
@@ -43,6 +49,11 @@ func (s *InterestHistoryService) EndTime(endTime int64) *InterestHistoryService
 	return s
 }
 
+// EndTimeFrom set endTime from t, converted to epoch milliseconds
+func (s *InterestHistoryService) EndTimeFrom(t time.Time) *InterestHistoryService {
+	return s.EndTime(FormatTimestamp(t))
+}
+
 // Current sets the current parameter.
 func (s *InterestHistoryService) Current(current int32) *InterestHistoryService {
 	s.current = &current
@@ -2,8 +2,13 @@ package binance
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"math/big"
 	"net/http"
+	"time"
+
+	"github.com/adshao/go-binance/v2/common"
 )
 
 // KlinesService list klines
@@ -40,12 +45,22 @@ func (s *KlinesService) StartTime(startTime int64) *KlinesService {
 	return s
 }
 
+// StartTimeFrom set startTime from t, converted to epoch milliseconds
+func (s *KlinesService) StartTimeFrom(t time.Time) *KlinesService {
+	return s.StartTime(FormatTimestamp(t))
+}
+
 // EndTime set endTime
 func (s *KlinesService) EndTime(endTime int64) *KlinesService {
 	s.endTime = &endTime
 	return s
 }
 
+// EndTimeFrom set endTime from t, converted to epoch milliseconds
+func (s *KlinesService) EndTimeFrom(t time.Time) *KlinesService {
+	return s.EndTime(FormatTimestamp(t))
+}
+
 // Do send request
 func (s *KlinesService) Do(ctx context.Context, opts ...RequestOption) (res []*Kline, err error) {
 	r := &request{
@@ -110,3 +125,68 @@ type Kline struct {
 	TakerBuyBaseAssetVolume  string `json:"takerBuyBaseAssetVolume"`
 	TakerBuyQuoteAssetVolume string `json:"takerBuyQuoteAssetVolume"`
 }
+
+// klinesPageLimit is the default and max number of klines Iterate requests
+// per page
+var klinesPageLimit = 1000
+
+// Iterate pages forward through the klines of the symbol, calling fn for
+// every kline in ascending open time. Each following page starts right after
+// the close time of the last kline, until a page shorter than the limit is
+// returned. The limit is capped at 1000 and a limit <= 0 is an error.
+// endTime applies to every page. Returning an error from fn stops the
+// iteration and that error is returned.
+func (s *KlinesService) Iterate(ctx context.Context, fn func(*Kline) error, opts ...RequestOption) error {
+	limit, err := common.PageLimit(s.limit, klinesPageLimit)
+	if err != nil {
+		return err
+	}
+	page := *s
+	page.limit = &limit
+	return common.PageForward(limit, func(startTime *int64) (int, int64, bool, error) {
+		if startTime != nil {
+			page.startTime = startTime
+		}
+		klines, err := page.Do(ctx, opts...)
+		if err != nil || len(klines) == 0 {
+			return 0, 0, false, err
+		}
+		for _, k := range klines {
+			if err = fn(k); err != nil {
+				return 0, 0, false, err
+			}
+		}
+		return len(klines), klines[len(klines)-1].CloseTime, false, nil
+	})
+}
+
+// VWAP compute the volume weighted average price of symbol between start and
+// end from the typical price, (high + low + close) / 3, of its klines, which
+// are accumulated page by page
+func (c *Client) VWAP(ctx context.Context, symbol string, interval string, start, end time.Time) (string, error) {
+	three := big.NewRat(3, 1)
+	notional, volume := new(big.Rat), new(big.Rat)
+	err := c.NewKlinesService().Symbol(symbol).Interval(interval).
+		StartTimeFrom(start).EndTimeFrom(end).
+		Iterate(ctx, func(k *Kline) error {
+			var values [4]*big.Rat
+			for i, v := range []string{k.High, k.Low, k.Close, k.Volume} {
+				var err error
+				if values[i], err = common.ParseDecimal(v); err != nil {
+					return err
+				}
+			}
+			typical := new(big.Rat).Add(values[0], values[1])
+			typical.Add(typical, values[2]).Quo(typical, three)
+			notional.Add(notional, typical.Mul(typical, values[3]))
+			volume.Add(volume, values[3])
+			return nil
+		})
+	if err != nil {
+		return "", err
+	}
+	if volume.Sign() == 0 {
+		return "", errors.New("no volume traded in range")
+	}
+	return notional.Quo(notional, volume).FloatString(8), nil
+}
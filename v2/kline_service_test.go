@@ -1,7 +1,9 @@
 package binance
 
 import (
+	"net/http"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/suite"
 )
@@ -98,6 +100,28 @@ func (s *klineServiceTestSuite) TestKlines() {
 	s.assertKlineEqual(kline2, klines[1])
 }
 
+func (s *klineServiceTestSuite) TestKlinesTimeFrom() {
+	s.mockDo([]byte(`[]`), nil)
+	defer s.assertDo()
+
+	startTime := time.Date(2017, 7, 3, 0, 0, 0, 0, time.UTC)
+	// sub-millisecond precision is truncated
+	endTime := startTime.Add(time.Millisecond + 999*time.Microsecond)
+	s.assertReq(func(r *request) {
+		e := newRequest().setParams(params{
+			"symbol":    "LTCBTC",
+			"interval":  "15m",
+			"startTime": int64(1499040000000),
+			"endTime":   int64(1499040000001),
+		})
+		s.assertRequestEqual(e, r)
+	})
+	_, err := s.client.NewKlinesService().Symbol("LTCBTC").Interval("15m").
+		StartTimeFrom(startTime).EndTimeFrom(endTime.In(time.FixedZone("UTC+8", 8*3600))).
+		Do(newContext())
+	s.r().NoError(err)
+}
+
 func (s *klineServiceTestSuite) assertKlineEqual(e, a *Kline) {
 	r := s.r()
 	r.Equal(e.OpenTime, a.OpenTime, "OpenTime")
@@ -112,3 +136,87 @@ func (s *klineServiceTestSuite) assertKlineEqual(e, a *Kline) {
 	r.Equal(e.TakerBuyBaseAssetVolume, a.TakerBuyBaseAssetVolume, "TakerBuyBaseAssetVolume")
 	r.Equal(e.TakerBuyQuoteAssetVolume, a.TakerBuyQuoteAssetVolume, "TakerBuyQuoteAssetVolume")
 }
+
+func (s *klineServiceTestSuite) TestVWAP() {
+	defer func(limit int) { klinesPageLimit = limit }(klinesPageLimit)
+	klinesPageLimit = 2
+	pages := []string{
+		`[
+			[1499040000000, "10.0", "12.0", "8.0", "10.0", "2.0", 1499040059999, "20.0", 2, "1.0", "10.0", "0"],
+			[1499040060000, "10.0", "13.0", "10.0", "13.0", "1.0", 1499040119999, "13.0", 1, "1.0", "13.0", "0"]
+		]`,
+		`[
+			[1499040120000, "13.0", "15.0", "12.0", "12.0", "3.0", 1499040179999, "39.0", 3, "1.0", "12.0", "0"]
+		]`,
+	}
+	var starts []string
+	s.client.Client.do = func(req *http.Request) (*http.Response, error) {
+		q := req.URL.Query()
+		s.r().Equal("BTCUSDT", q.Get("symbol"))
+		s.r().Equal("1m", q.Get("interval"))
+		s.r().Equal("2", q.Get("limit"))
+		s.r().Equal("1499040179999", q.Get("endTime"))
+		starts = append(starts, q.Get("startTime"))
+		page := pages[0]
+		pages = pages[1:]
+		return newHTTPResponse([]byte(page), http.StatusOK), nil
+	}
+
+	vwap, err := s.client.VWAP(newContext(), "BTCUSDT", "1m",
+		time.Unix(0, 1499040000000*int64(time.Millisecond)),
+		time.Unix(0, 1499040179999*int64(time.Millisecond)))
+	r := s.r()
+	r.NoError(err)
+	r.Equal([]string{"1499040000000", "1499040120000"}, starts)
+	// typical prices 10, 12 and 13: (10*2 + 12*1 + 13*3) / 6
+	r.Equal("11.83333333", vwap)
+}
+
+func (s *klineServiceTestSuite) TestKlinesIterate() {
+	pages := []string{
+		`[
+			[1499040000000, "10.0", "12.0", "8.0", "10.0", "2.0", 1499040059999, "20.0", 2, "1.0", "10.0", "0"],
+			[1499040060000, "10.0", "13.0", "10.0", "13.0", "1.0", 1499040119999, "13.0", 1, "1.0", "13.0", "0"]
+		]`,
+		`[
+			[1499040120000, "13.0", "15.0", "12.0", "12.0", "3.0", 1499040179999, "39.0", 3, "1.0", "12.0", "0"],
+			[1499040180000, "12.0", "12.0", "11.0", "11.0", "1.0", 1499040239999, "11.0", 1, "1.0", "11.0", "0"]
+		]`,
+		`[]`,
+	}
+	var starts []string
+	s.client.Client.do = func(req *http.Request) (*http.Response, error) {
+		starts = append(starts, req.URL.Query().Get("startTime"))
+		page := pages[0]
+		pages = pages[1:]
+		return newHTTPResponse([]byte(page), http.StatusOK), nil
+	}
+
+	var openTimes []int64
+	err := s.client.NewKlinesService().Symbol("BTCUSDT").Interval("1m").
+		StartTime(1499040000000).Limit(2).
+		Iterate(newContext(), func(k *Kline) error {
+			openTimes = append(openTimes, k.OpenTime)
+			return nil
+		})
+	r := s.r()
+	r.NoError(err)
+	r.Equal([]string{"1499040000000", "1499040120000", "1499040240000"}, starts)
+	r.Equal([]int64{1499040000000, 1499040060000, 1499040120000, 1499040180000}, openTimes)
+}
+
+func (s *klineServiceTestSuite) TestKlinesIterateInvalidLimit() {
+	err := s.client.NewKlinesService().Symbol("BTCUSDT").Interval("1m").Limit(0).
+		Iterate(newContext(), func(k *Kline) error {
+			return nil
+		})
+	s.r().Error(err)
+}
+
+func (s *klineServiceTestSuite) TestVWAPNoVolume() {
+	s.mockDo([]byte(`[]`), nil)
+	defer s.assertDo()
+
+	_, err := s.client.VWAP(newContext(), "BTCUSDT", "1m", time.Unix(0, 0), time.Unix(60, 0))
+	s.r().EqualError(err, "no volume traded in range")
+}
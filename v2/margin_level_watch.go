@@ -0,0 +1,62 @@
+package binance
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// WatchMarginLevel poll the margin account every interval until ctx is done
+// and call onBreach with the margin level when it drops below threshold.
+// onBreach is called once per breach, it is called again only after the level
+// went back to threshold or above. onError is called with the error of every
+// failed poll, e.g. of a revoked API key, and polling goes on. With a nil
+// onError the first failed poll stops the watch and its error is returned.
+// Otherwise the returned error is the one of ctx.
+func (c *Client) WatchMarginLevel(ctx context.Context, threshold float64, interval time.Duration, onBreach func(level float64), onError func(err error)) error {
+	if interval <= 0 {
+		return errors.New("interval must be positive")
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	breached := false
+	for {
+		level, err := c.marginLevel(ctx)
+		if err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			if onError == nil {
+				return err
+			}
+			onError(err)
+		} else if level < threshold {
+			if !breached {
+				onBreach(level)
+			}
+			breached = true
+		} else {
+			breached = false
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// marginLevel return the current margin level of the margin account
+func (c *Client) marginLevel(ctx context.Context) (float64, error) {
+	account, err := c.NewGetMarginAccountService().Do(ctx)
+	if err != nil {
+		return 0, err
+	}
+	level, err := strconv.ParseFloat(account.MarginLevel, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid margin level %q", account.MarginLevel)
+	}
+	return level, nil
+}
@@ -0,0 +1,82 @@
+package binance
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/adshao/go-binance/v2/common"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWatchMarginLevel(t *testing.T) {
+	levels := []string{"2.5", "1.25", "1.2", "1.28", "1.5", "1.1", "3"}
+	ctx, cancel := context.WithCancel(newContext())
+	defer cancel()
+
+	c := NewClient("dummyAPIKey", "dummySecretKey")
+	polls := 0
+	c.do = func(req *http.Request) (*http.Response, error) {
+		level := levels[polls]
+		polls++
+		if polls == len(levels) {
+			cancel()
+		}
+		return newHTTPResponse([]byte(fmt.Sprintf(`{"marginLevel": "%s"}`, level)), http.StatusOK), nil
+	}
+
+	var breaches []float64
+	err := c.WatchMarginLevel(ctx, 1.3, time.Millisecond, func(level float64) {
+		breaches = append(breaches, level)
+	}, func(err error) {
+		t.Errorf("unexpected error: %v", err)
+	})
+	r := require.New(t)
+	r.Equal(context.Canceled, err)
+	r.Equal(len(levels), polls)
+	r.Equal([]float64{1.25, 1.1}, breaches)
+}
+
+func TestWatchMarginLevelErrors(t *testing.T) {
+	ctx, cancel := context.WithCancel(newContext())
+	defer cancel()
+
+	c := NewClient("dummyAPIKey", "dummySecretKey")
+	polls := 0
+	c.do = func(req *http.Request) (*http.Response, error) {
+		polls++
+		if polls == 3 {
+			cancel()
+		}
+		return newHTTPResponse([]byte(`{"code":-2015,"msg":"Invalid API-key, IP, or permissions for action."}`), http.StatusUnauthorized), nil
+	}
+
+	var errs []error
+	err := c.WatchMarginLevel(ctx, 1.3, time.Millisecond, func(level float64) {
+		t.Errorf("unexpected breach: %v", level)
+	}, func(err error) {
+		errs = append(errs, err)
+	})
+	r := require.New(t)
+	r.Equal(context.Canceled, err)
+	r.Len(errs, 2)
+	r.True(common.IsAPIError(errs[0]))
+
+	polls = 0
+	err = c.WatchMarginLevel(newContext(), 1.3, time.Millisecond, func(level float64) {}, nil)
+	r.Error(err)
+	r.True(common.IsAPIError(err))
+	r.Equal(1, polls)
+}
+
+func TestWatchMarginLevelInvalidInterval(t *testing.T) {
+	c := NewClient("dummyAPIKey", "dummySecretKey")
+	c.do = func(req *http.Request) (*http.Response, error) {
+		t.Error("unexpected poll")
+		return nil, nil
+	}
+	err := c.WatchMarginLevel(newContext(), 1.3, 0, func(level float64) {}, func(err error) {})
+	require.Error(t, err)
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"net/http"
+	"time"
 )
 
 // CreateMarginOrderService create order
@@ -381,12 +382,22 @@ func (s *ListMarginOrdersService) StartTime(startTime int64) *ListMarginOrdersSe
 	return s
 }
 
+// StartTimeFrom set startTime from t, converted to epoch milliseconds
+func (s *ListMarginOrdersService) StartTimeFrom(t time.Time) *ListMarginOrdersService {
+	return s.StartTime(FormatTimestamp(t))
+}
+
 // EndTime set endtime
 func (s *ListMarginOrdersService) EndTime(endTime int64) *ListMarginOrdersService {
 	s.endTime = &endTime
 	return s
 }
 
+// EndTimeFrom set endTime from t, converted to epoch milliseconds
+func (s *ListMarginOrdersService) EndTimeFrom(t time.Time) *ListMarginOrdersService {
+	return s.EndTime(FormatTimestamp(t))
+}
+
 // Limit set limit
 func (s *ListMarginOrdersService) Limit(limit int) *ListMarginOrdersService {
 	s.limit = &limit
@@ -719,7 +730,11 @@ func (s *CancelMarginOCOService) Do(ctx context.Context, opts ...RequestOption)
 		r.setFormParam("listClientOrderId", s.listClientOrderID)
 	}
 	if s.isIsolated != nil {
-		r.setFormParam("isIsolated", *s.isIsolated)
+		if *s.isIsolated {
+			r.setFormParam("isIsolated", "TRUE")
+		} else {
+			r.setFormParam("isIsolated", "FALSE")
+		}
 	}
 	if s.orderListID != 0 {
 		r.setFormParam("orderListId", s.orderListID)
@@ -75,6 +75,59 @@ func (s *marginOrderServiceTestSuite) TestCreateOrder() {
 	s.assertCreateOrderResponseEqual(e, res)
 }
 
+func (s *marginOrderServiceTestSuite) TestCreateOrderSideEffectType() {
+	data := []byte(`{
+		"symbol": "LTCBTC",
+		"orderId": 1,
+		"status": "FILLED",
+		"type": "MARKET",
+		"side": "BUY"
+	}`)
+	s.client.Client.do = s.client.do
+	for i := 0; i < 5; i++ {
+		s.client.On("do", anyHTTPRequest()).Return(newHTTPResponse(data, 200), nil).Once()
+	}
+	defer s.assertDo()
+	symbol := "LTCBTC"
+	side := SideTypeBuy
+	orderType := OrderTypeMarket
+	quantity := "12.00"
+	for _, sideEffectType := range []SideEffectType{
+		SideEffectTypeNoSideEffect,
+		SideEffectTypeMarginBuy,
+		SideEffectTypeAutoRepay,
+		SideEffectTypeAutoBorrowRepay,
+	} {
+		s.assertReq(func(r *request) {
+			e := newSignedRequest().setFormParams(params{
+				"symbol":         symbol,
+				"side":           side,
+				"type":           orderType,
+				"quantity":       quantity,
+				"sideEffectType": sideEffectType,
+			})
+			s.assertRequestEqual(e, r)
+		})
+		_, err := s.client.NewCreateMarginOrderService().Symbol(symbol).Side(side).
+			Type(orderType).Quantity(quantity).SideEffectType(sideEffectType).
+			Do(newContext())
+		s.r().NoError(err)
+	}
+
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setFormParams(params{
+			"symbol":   symbol,
+			"side":     side,
+			"type":     orderType,
+			"quantity": quantity,
+		})
+		s.assertRequestEqual(e, r)
+	})
+	_, err := s.client.NewCreateMarginOrderService().Symbol(symbol).Side(side).
+		Type(orderType).Quantity(quantity).Do(newContext())
+	s.r().NoError(err)
+}
+
 func (s *marginOrderServiceTestSuite) TestCreateOrderFull() {
 	data := []byte(`{
 		"symbol": "LTCBTC",
@@ -766,6 +819,30 @@ func (s *marginOrderServiceTestSuite) TestCancelOCO() {
 	s.assertCancelMarginOCOResponseEqual(e, res)
 }
 
+func (s *marginOrderServiceTestSuite) TestCancelIsolatedOCO() {
+	for isIsolated, param := range map[bool]string{true: "TRUE", false: "FALSE"} {
+		s.SetupTest()
+		s.mockDo([]byte(`{"orderListId": 1000, "symbol": "LTCBTC", "isIsolated": true}`), nil)
+		s.assertReq(func(r *request) {
+			e := newSignedRequest().setFormParams(params{
+				"symbol":      "LTCBTC",
+				"isIsolated":  param,
+				"orderListId": 1000,
+			})
+			s.assertRequestEqual(e, r)
+		})
+
+		_, err := s.client.
+			NewCancelMarginOCOService().
+			Symbol("LTCBTC").
+			IsIsolated(isIsolated).
+			OrderListID(1000).
+			Do(newContext())
+		s.r().NoError(err)
+		s.assertDo()
+	}
+}
+
 func (s *marginOrderServiceTestSuite) assertCancelMarginOCOResponseEqual(e, a *CancelMarginOCOResponse) {
 	r := s.r()
 	r.Equal(e.OrderListID, a.OrderListID, "OrderListID")
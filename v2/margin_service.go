@@ -3,8 +3,13 @@ package binance
 import (
 	"context"
 	"encoding/json"
+	"fmt"
+	"math/big"
 	"net/http"
 	"strings"
+	"time"
+
+	"github.com/adshao/go-binance/v2/common"
 )
 
 // MarginTransferService transfer between spot account and margin account
@@ -58,6 +63,196 @@ func (s *MarginTransferService) Do(ctx context.Context, opts ...RequestOption) (
 	return res, nil
 }
 
+// GetMarginTransferHistoryService list cross margin transfer history, or
+// isolated margin transfer history when isolatedSymbol is set
+type GetMarginTransferHistoryService struct {
+	c              *Client
+	asset          *string
+	transferType   *MarginTransferHistoryType
+	startTime      *int64
+	endTime        *int64
+	current        *int64
+	size           *int64
+	isolatedSymbol *string
+}
+
+// Asset set asset
+func (s *GetMarginTransferHistoryService) Asset(asset string) *GetMarginTransferHistoryService {
+	s.asset = &asset
+	return s
+}
+
+// Type set transfer type, ROLL_IN or ROLL_OUT
+func (s *GetMarginTransferHistoryService) Type(transferType MarginTransferHistoryType) *GetMarginTransferHistoryService {
+	s.transferType = &transferType
+	return s
+}
+
+// StartTime set start time
+func (s *GetMarginTransferHistoryService) StartTime(startTime int64) *GetMarginTransferHistoryService {
+	s.startTime = &startTime
+	return s
+}
+
+// StartTimeFrom set startTime from t, converted to epoch milliseconds
+func (s *GetMarginTransferHistoryService) StartTimeFrom(t time.Time) *GetMarginTransferHistoryService {
+	return s.StartTime(FormatTimestamp(t))
+}
+
+// EndTime set end time
+func (s *GetMarginTransferHistoryService) EndTime(endTime int64) *GetMarginTransferHistoryService {
+	s.endTime = &endTime
+	return s
+}
+
+// EndTimeFrom set endTime from t, converted to epoch milliseconds
+func (s *GetMarginTransferHistoryService) EndTimeFrom(t time.Time) *GetMarginTransferHistoryService {
+	return s.EndTime(FormatTimestamp(t))
+}
+
+// Current currently querying page. Start from 1. Default:1
+func (s *GetMarginTransferHistoryService) Current(current int64) *GetMarginTransferHistoryService {
+	s.current = &current
+	return s
+}
+
+// Size default:10 max:100
+func (s *GetMarginTransferHistoryService) Size(size int64) *GetMarginTransferHistoryService {
+	s.size = &size
+	return s
+}
+
+// IsolatedSymbol set isolated symbol to query isolated margin transfers
+func (s *GetMarginTransferHistoryService) IsolatedSymbol(isolatedSymbol string) *GetMarginTransferHistoryService {
+	s.isolatedSymbol = &isolatedSymbol
+	return s
+}
+
+// Do send request
+func (s *GetMarginTransferHistoryService) Do(ctx context.Context, opts ...RequestOption) (res *MarginTransferHistoryResponse, err error) {
+	r := &request{
+		method:   http.MethodGet,
+		endpoint: "/sapi/v1/margin/transfer",
+		secType:  secTypeSigned,
+	}
+	if s.asset != nil {
+		r.setParam("asset", *s.asset)
+	}
+	if s.transferType != nil {
+		r.setParam("type", *s.transferType)
+	}
+	if s.startTime != nil {
+		r.setParam("startTime", *s.startTime)
+	}
+	if s.endTime != nil {
+		r.setParam("endTime", *s.endTime)
+	}
+	if s.current != nil {
+		r.setParam("current", *s.current)
+	}
+	if s.size != nil {
+		r.setParam("size", *s.size)
+	}
+	if s.isolatedSymbol != nil {
+		r.setParam("isolatedSymbol", *s.isolatedSymbol)
+	}
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(MarginTransferHistoryResponse)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// MarginTransferHistoryResponse define margin transfer history response
+type MarginTransferHistoryResponse struct {
+	Rows  []MarginTransfer `json:"rows"`
+	Total int64            `json:"total"`
+}
+
+// MarginTransfer define margin transfer history record
+type MarginTransfer struct {
+	Amount    string                    `json:"amount"`
+	Asset     string                    `json:"asset"`
+	Status    string                    `json:"status"`
+	Timestamp int64                     `json:"timestamp"`
+	TxID      int64                     `json:"txId"`
+	Type      MarginTransferHistoryType `json:"type"`
+	TransFrom string                    `json:"transFrom"`
+	TransTo   string                    `json:"transTo"`
+}
+
+// IsolatedMarginTransferService transfer between spot account and isolated margin account
+type IsolatedMarginTransferService struct {
+	c         *Client
+	asset     string
+	symbol    string
+	transFrom IsolatedMarginTransferAccountType
+	transTo   IsolatedMarginTransferAccountType
+	amount    string
+}
+
+// Asset set asset being transferred, e.g., BTC
+func (s *IsolatedMarginTransferService) Asset(asset string) *IsolatedMarginTransferService {
+	s.asset = asset
+	return s
+}
+
+// Symbol set isolated symbol, e.g., BTCUSDT
+func (s *IsolatedMarginTransferService) Symbol(symbol string) *IsolatedMarginTransferService {
+	s.symbol = symbol
+	return s
+}
+
+// TransFrom set the account to transfer from, "SPOT" or "ISOLATED_MARGIN"
+func (s *IsolatedMarginTransferService) TransFrom(transFrom IsolatedMarginTransferAccountType) *IsolatedMarginTransferService {
+	s.transFrom = transFrom
+	return s
+}
+
+// TransTo set the account to transfer to, "SPOT" or "ISOLATED_MARGIN"
+func (s *IsolatedMarginTransferService) TransTo(transTo IsolatedMarginTransferAccountType) *IsolatedMarginTransferService {
+	s.transTo = transTo
+	return s
+}
+
+// Amount the amount to be transferred
+func (s *IsolatedMarginTransferService) Amount(amount string) *IsolatedMarginTransferService {
+	s.amount = amount
+	return s
+}
+
+// Do send request
+func (s *IsolatedMarginTransferService) Do(ctx context.Context, opts ...RequestOption) (res *TransactionResponse, err error) {
+	r := &request{
+		method:   http.MethodPost,
+		endpoint: "/sapi/v1/margin/isolated/transfer",
+		secType:  secTypeSigned,
+	}
+	m := params{
+		"asset":     s.asset,
+		"symbol":    s.symbol,
+		"transFrom": s.transFrom,
+		"transTo":   s.transTo,
+		"amount":    s.amount,
+	}
+	r.setFormParams(m)
+	res = new(TransactionResponse)
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
 // TransactionResponse define transaction response
 type TransactionResponse struct {
 	TranID int64 `json:"tranId"`
@@ -169,6 +364,187 @@ func (s *MarginRepayService) Do(ctx context.Context, opts ...RequestOption) (res
 	return res, nil
 }
 
+// repayAllAttempts is the max number of repays RepayAll sends
+var repayAllAttempts = 3
+
+// RepayAll repay the whole liability of asset, borrowed plus interest, of the
+// cross margin account, or of the isolated margin account of isolatedSymbol if
+// not empty. The liability is read again after every repay so interest accrued
+// meanwhile is repaid too. It returns the transactions of the repays sent.
+func (c *Client) RepayAll(ctx context.Context, asset string, isolatedSymbol string) ([]*TransactionResponse, error) {
+	var txs []*TransactionResponse
+	for attempt := 0; ; attempt++ {
+		liability, err := c.marginLiability(ctx, asset, isolatedSymbol)
+		if err != nil {
+			return txs, err
+		}
+		if liability.Sign() <= 0 {
+			return txs, nil
+		}
+		if attempt == repayAllAttempts {
+			return txs, fmt.Errorf("%s still owes %s after %d repays", asset, liability.FloatString(8), attempt)
+		}
+		service := c.NewMarginRepayService().Asset(asset).Amount(liability.FloatString(8))
+		if isolatedSymbol != "" {
+			service.IsolatedSymbol(isolatedSymbol)
+		}
+		tx, err := service.Do(ctx)
+		if err != nil {
+			return txs, err
+		}
+		txs = append(txs, tx)
+	}
+}
+
+// marginLiability return borrowed plus interest of asset in the cross margin
+// account, or in the isolated margin account of isolatedSymbol if not empty
+func (c *Client) marginLiability(ctx context.Context, asset string, isolatedSymbol string) (*big.Rat, error) {
+	var borrowed, interest string
+	if isolatedSymbol == "" {
+		account, err := c.NewGetMarginAccountService().Do(ctx)
+		if err != nil {
+			return nil, err
+		}
+		userAsset := account.UserAsset(asset)
+		if userAsset == nil {
+			return new(big.Rat), nil
+		}
+		borrowed, interest = userAsset.Borrowed, userAsset.Interest
+	} else {
+		account, err := c.NewGetIsolatedMarginAccountService().Symbols(isolatedSymbol).Do(ctx)
+		if err != nil {
+			return nil, err
+		}
+		var userAsset *IsolatedUserAsset
+		for i := range account.Assets {
+			if account.Assets[i].Symbol != isolatedSymbol {
+				continue
+			}
+			switch asset {
+			case account.Assets[i].BaseAsset.Asset:
+				userAsset = &account.Assets[i].BaseAsset
+			case account.Assets[i].QuoteAsset.Asset:
+				userAsset = &account.Assets[i].QuoteAsset
+			}
+		}
+		if userAsset == nil {
+			return nil, fmt.Errorf("asset %s not found in isolated margin account %s", asset, isolatedSymbol)
+		}
+		borrowed, interest = userAsset.Borrowed, userAsset.Interest
+	}
+	b, err := common.ParseDecimal(borrowed)
+	if err != nil {
+		return nil, err
+	}
+	i, err := common.ParseDecimal(interest)
+	if err != nil {
+		return nil, err
+	}
+	return b.Add(b, i), nil
+}
+
+// GetMarginInterestRateService get the next hourly interest rate of margin assets
+type GetMarginInterestRateService struct {
+	c          *Client
+	assets     []string
+	isIsolated bool
+}
+
+// Assets set assets, at most 20
+func (s *GetMarginInterestRateService) Assets(assets ...string) *GetMarginInterestRateService {
+	s.assets = assets
+	return s
+}
+
+// IsIsolated set isIsolated
+func (s *GetMarginInterestRateService) IsIsolated(isIsolated bool) *GetMarginInterestRateService {
+	s.isIsolated = isIsolated
+	return s
+}
+
+// Do send request
+func (s *GetMarginInterestRateService) Do(ctx context.Context, opts ...RequestOption) (res []*MarginInterestRate, err error) {
+	r := &request{
+		method:   http.MethodGet,
+		endpoint: "/sapi/v1/margin/next-hourly-interest-rate",
+		secType:  secTypeSigned,
+	}
+	r.setParam("assets", strings.Join(s.assets, ","))
+	if s.isIsolated {
+		r.setParam("isIsolated", "TRUE")
+	} else {
+		r.setParam("isIsolated", "FALSE")
+	}
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = make([]*MarginInterestRate, 0)
+	err = json.Unmarshal(data, &res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// MarginInterestRate define the next hourly interest rate of an asset
+type MarginInterestRate struct {
+	Asset                  string `json:"asset"`
+	NextHourlyInterestRate string `json:"nextHourlyInterestRate"`
+}
+
+// marginInterestRateMaxAssets is the max number of assets of a
+// GetMarginInterestRateService request
+const marginInterestRateMaxAssets = 20
+
+// NextHourlyMarginInterest estimate the interest charged at the next hour on
+// the borrowed assets of the cross margin account, keyed by asset. Assets
+// without liability are left out.
+func (c *Client) NextHourlyMarginInterest(ctx context.Context) (map[string]string, error) {
+	account, err := c.NewGetMarginAccountService().Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	borrowed := make(map[string]*big.Rat)
+	var assets []string
+	for _, userAsset := range account.UserAssets {
+		b, err := common.ParseDecimal(userAsset.Borrowed)
+		if err != nil {
+			return nil, err
+		}
+		if b.Sign() <= 0 {
+			continue
+		}
+		borrowed[userAsset.Asset] = b
+		assets = append(assets, userAsset.Asset)
+	}
+
+	res := make(map[string]string, len(assets))
+	for len(assets) > 0 {
+		n := len(assets)
+		if n > marginInterestRateMaxAssets {
+			n = marginInterestRateMaxAssets
+		}
+		rates, err := c.NewGetMarginInterestRateService().Assets(assets[:n]...).Do(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, rate := range rates {
+			b, ok := borrowed[rate.Asset]
+			if !ok {
+				continue
+			}
+			r, err := common.ParseDecimal(rate.NextHourlyInterestRate)
+			if err != nil {
+				return nil, err
+			}
+			res[rate.Asset] = new(big.Rat).Mul(b, r).FloatString(8)
+		}
+		assets = assets[n:]
+	}
+	return res, nil
+}
+
 // ListMarginLoansService list loan record
 type ListMarginLoansService struct {
 	c         *Client
@@ -198,12 +574,22 @@ func (s *ListMarginLoansService) StartTime(startTime int64) *ListMarginLoansServ
 	return s
 }
 
+// StartTimeFrom set startTime from t, converted to epoch milliseconds
+func (s *ListMarginLoansService) StartTimeFrom(t time.Time) *ListMarginLoansService {
+	return s.StartTime(FormatTimestamp(t))
+}
+
 // EndTime set end time
 func (s *ListMarginLoansService) EndTime(endTime int64) *ListMarginLoansService {
 	s.endTime = &endTime
 	return s
 }
 
+// EndTimeFrom set endTime from t, converted to epoch milliseconds
+func (s *ListMarginLoansService) EndTimeFrom(t time.Time) *ListMarginLoansService {
+	return s.EndTime(FormatTimestamp(t))
+}
+
 // Current currently querying page. Start from 1. Default:1
 func (s *ListMarginLoansService) Current(current int64) *ListMarginLoansService {
 	s.current = &current
@@ -265,6 +651,29 @@ type MarginLoan struct {
 	Status    MarginLoanStatusType `json:"status"`
 }
 
+// ProjectInterest return the interest accrued on principal at hourlyRate, e.g.
+// the hourly interest rate of the asset, over hours, rounded to 8 decimals.
+// Margin interest is charged hourly on the principal, so it does not compound.
+func ProjectInterest(principal string, hourlyRate string, hours int) (string, error) {
+	if hours < 0 {
+		return "", fmt.Errorf("hours %d must not be negative", hours)
+	}
+	p, err := common.ParseDecimal(principal)
+	if err != nil {
+		return "", err
+	}
+	rate, err := common.ParseDecimal(hourlyRate)
+	if err != nil {
+		return "", err
+	}
+	if p.Sign() < 0 || rate.Sign() < 0 {
+		return "", fmt.Errorf("principal %s and hourly rate %s must not be negative", principal, hourlyRate)
+	}
+	interest := new(big.Rat).Mul(p, rate)
+	interest.Mul(interest, new(big.Rat).SetInt64(int64(hours)))
+	return interest.FloatString(8), nil
+}
+
 // ListMarginRepaysService list repay record
 type ListMarginRepaysService struct {
 	c         *Client
@@ -294,12 +703,22 @@ func (s *ListMarginRepaysService) StartTime(startTime int64) *ListMarginRepaysSe
 	return s
 }
 
+// StartTimeFrom set startTime from t, converted to epoch milliseconds
+func (s *ListMarginRepaysService) StartTimeFrom(t time.Time) *ListMarginRepaysService {
+	return s.StartTime(FormatTimestamp(t))
+}
+
 // EndTime set end time
 func (s *ListMarginRepaysService) EndTime(endTime int64) *ListMarginRepaysService {
 	s.endTime = &endTime
 	return s
 }
 
+// EndTimeFrom set endTime from t, converted to epoch milliseconds
+func (s *ListMarginRepaysService) EndTimeFrom(t time.Time) *ListMarginRepaysService {
+	return s.EndTime(FormatTimestamp(t))
+}
+
 // Current currently querying page. Start from 1. Default:1
 func (s *ListMarginRepaysService) Current(current int64) *ListMarginRepaysService {
 	s.current = &current
@@ -466,14 +885,30 @@ func (s *GetMarginAccountService) Do(ctx context.Context, opts ...RequestOption)
 
 // MarginAccount define margin account info
 type MarginAccount struct {
-	BorrowEnabled       bool        `json:"borrowEnabled"`
-	MarginLevel         string      `json:"marginLevel"`
-	TotalAssetOfBTC     string      `json:"totalAssetOfBtc"`
-	TotalLiabilityOfBTC string      `json:"totalLiabilityOfBtc"`
-	TotalNetAssetOfBTC  string      `json:"totalNetAssetOfBtc"`
-	TradeEnabled        bool        `json:"tradeEnabled"`
-	TransferEnabled     bool        `json:"transferEnabled"`
-	UserAssets          []UserAsset `json:"userAssets"`
+	Created                    bool        `json:"created"`
+	BorrowEnabled              bool        `json:"borrowEnabled"`
+	MarginLevel                string      `json:"marginLevel"`
+	CollateralMarginLevel      string      `json:"collateralMarginLevel"`
+	TotalAssetOfBTC            string      `json:"totalAssetOfBtc"`
+	TotalLiabilityOfBTC        string      `json:"totalLiabilityOfBtc"`
+	TotalNetAssetOfBTC         string      `json:"totalNetAssetOfBtc"`
+	TotalCollateralValueInUSDT string      `json:"totalCollateralValueInUSDT"`
+	TradeEnabled               bool        `json:"tradeEnabled"`
+	TransferEnabled            bool        `json:"transferEnabled"`
+	TransferInEnabled          bool        `json:"transferInEnabled"`
+	TransferOutEnabled         bool        `json:"transferOutEnabled"`
+	AccountType                string      `json:"accountType"`
+	UserAssets                 []UserAsset `json:"userAssets"`
+}
+
+// UserAsset return the asset row of the account, or nil if the account has no such asset
+func (a *MarginAccount) UserAsset(asset string) *UserAsset {
+	for i := range a.UserAssets {
+		if a.UserAssets[i].Asset == asset {
+			return &a.UserAssets[i]
+		}
+	}
+	return nil
 }
 
 // UserAsset define user assets of margin account
@@ -674,12 +1109,22 @@ func (s *ListMarginTradesService) StartTime(startTime int64) *ListMarginTradesSe
 	return s
 }
 
+// StartTimeFrom set startTime from t, converted to epoch milliseconds
+func (s *ListMarginTradesService) StartTimeFrom(t time.Time) *ListMarginTradesService {
+	return s.StartTime(FormatTimestamp(t))
+}
+
 // EndTime set endtime
 func (s *ListMarginTradesService) EndTime(endTime int64) *ListMarginTradesService {
 	s.endTime = &endTime
 	return s
 }
 
+// EndTimeFrom set endTime from t, converted to epoch milliseconds
+func (s *ListMarginTradesService) EndTimeFrom(t time.Time) *ListMarginTradesService {
+	return s.EndTime(FormatTimestamp(t))
+}
+
 // Limit set limit
 func (s *ListMarginTradesService) Limit(limit int) *ListMarginTradesService {
 	s.limit = &limit
@@ -1045,3 +1490,277 @@ type IsolatedMarginAllPair struct {
 	IsBuyAllowed  bool   `json:"isBuyAllowed"`
 	IsSellAllowed bool   `json:"isSellAllowed"`
 }
+
+// GetCrossMarginCollateralRatioService get cross margin collateral ratio
+type GetCrossMarginCollateralRatioService struct {
+	c *Client
+}
+
+// Do send request
+func (s *GetCrossMarginCollateralRatioService) Do(ctx context.Context, opts ...RequestOption) (res []*CrossMarginCollateralRatio, err error) {
+	r := &request{
+		method:   http.MethodGet,
+		endpoint: "/sapi/v1/margin/crossMarginCollateralRatio",
+		secType:  secTypeAPIKey,
+	}
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return []*CrossMarginCollateralRatio{}, err
+	}
+	res = make([]*CrossMarginCollateralRatio, 0)
+	err = json.Unmarshal(data, &res)
+	if err != nil {
+		return []*CrossMarginCollateralRatio{}, err
+	}
+	return res, nil
+}
+
+// CrossMarginCollateralRatio define collateral tiers shared by a group of assets
+type CrossMarginCollateralRatio struct {
+	Collaterals []CrossMarginCollateral `json:"collaterals"`
+	AssetNames  []string                `json:"assetNames"`
+}
+
+// CrossMarginCollateral define discount rate of a collateral value tier,
+// MaxUsdValue is empty for the last tier
+type CrossMarginCollateral struct {
+	MinUsdValue  string `json:"minUsdValue"`
+	MaxUsdValue  string `json:"maxUsdValue"`
+	DiscountRate string `json:"discountRate"`
+}
+
+// GetMarginForceLiquidationService list force liquidation records of the
+// cross margin account, or of an isolated margin account when isolatedSymbol is set
+type GetMarginForceLiquidationService struct {
+	c              *Client
+	startTime      *int64
+	endTime        *int64
+	isolatedSymbol *string
+	current        *int64
+	size           *int64
+}
+
+// StartTime set start time
+func (s *GetMarginForceLiquidationService) StartTime(startTime int64) *GetMarginForceLiquidationService {
+	s.startTime = &startTime
+	return s
+}
+
+// StartTimeFrom set startTime from t, converted to epoch milliseconds
+func (s *GetMarginForceLiquidationService) StartTimeFrom(t time.Time) *GetMarginForceLiquidationService {
+	return s.StartTime(FormatTimestamp(t))
+}
+
+// EndTime set end time
+func (s *GetMarginForceLiquidationService) EndTime(endTime int64) *GetMarginForceLiquidationService {
+	s.endTime = &endTime
+	return s
+}
+
+// EndTimeFrom set endTime from t, converted to epoch milliseconds
+func (s *GetMarginForceLiquidationService) EndTimeFrom(t time.Time) *GetMarginForceLiquidationService {
+	return s.EndTime(FormatTimestamp(t))
+}
+
+// IsolatedSymbol set isolated symbol to query isolated margin liquidations
+func (s *GetMarginForceLiquidationService) IsolatedSymbol(isolatedSymbol string) *GetMarginForceLiquidationService {
+	s.isolatedSymbol = &isolatedSymbol
+	return s
+}
+
+// Current currently querying page. Start from 1. Default:1
+func (s *GetMarginForceLiquidationService) Current(current int64) *GetMarginForceLiquidationService {
+	s.current = &current
+	return s
+}
+
+// Size default:10 max:100
+func (s *GetMarginForceLiquidationService) Size(size int64) *GetMarginForceLiquidationService {
+	s.size = &size
+	return s
+}
+
+// Do send request
+func (s *GetMarginForceLiquidationService) Do(ctx context.Context, opts ...RequestOption) (res *MarginForceLiquidationResponse, err error) {
+	r := &request{
+		method:   http.MethodGet,
+		endpoint: "/sapi/v1/margin/forceLiquidationRec",
+		secType:  secTypeSigned,
+	}
+	if s.startTime != nil {
+		r.setParam("startTime", *s.startTime)
+	}
+	if s.endTime != nil {
+		r.setParam("endTime", *s.endTime)
+	}
+	if s.isolatedSymbol != nil {
+		r.setParam("isolatedSymbol", *s.isolatedSymbol)
+	}
+	if s.current != nil {
+		r.setParam("current", *s.current)
+	}
+	if s.size != nil {
+		r.setParam("size", *s.size)
+	}
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(MarginForceLiquidationResponse)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// MarginForceLiquidationResponse define margin force liquidation record response
+type MarginForceLiquidationResponse struct {
+	Rows  []MarginForceLiquidation `json:"rows"`
+	Total int64                    `json:"total"`
+}
+
+// MarginForceLiquidation define margin force liquidation record
+type MarginForceLiquidation struct {
+	AvgPrice         string          `json:"avgPrice"`
+	ExecutedQuantity string          `json:"executedQty"`
+	OrderID          int64           `json:"orderId"`
+	Price            string          `json:"price"`
+	Quantity         string          `json:"qty"`
+	Side             SideType        `json:"side"`
+	Symbol           string          `json:"symbol"`
+	TimeInForce      TimeInForceType `json:"timeInForce"`
+	IsIsolated       bool            `json:"isIsolated"`
+	UpdatedTime      int64           `json:"updatedTime"`
+}
+
+// MarginSmallLiabilityExchangeService exchange small cross margin liabilities to BNB
+type MarginSmallLiabilityExchangeService struct {
+	c          *Client
+	assetNames []string
+}
+
+// AssetNames set the assets whose small liability is exchanged
+func (s *MarginSmallLiabilityExchangeService) AssetNames(assetNames ...string) *MarginSmallLiabilityExchangeService {
+	s.assetNames = assetNames
+	return s
+}
+
+// Do send request
+func (s *MarginSmallLiabilityExchangeService) Do(ctx context.Context, opts ...RequestOption) (err error) {
+	r := &request{
+		method:   http.MethodPost,
+		endpoint: "/sapi/v1/margin/exchange-small-liability",
+		secType:  secTypeSigned,
+	}
+	r.setFormParam("assetNames", strings.Join(s.assetNames, ","))
+	_, err = s.c.callAPI(ctx, r, opts...)
+	return err
+}
+
+// GetMarginSmallLiabilityListService list cross margin liabilities small enough to be exchanged
+type GetMarginSmallLiabilityListService struct {
+	c *Client
+}
+
+// Do send request
+func (s *GetMarginSmallLiabilityListService) Do(ctx context.Context, opts ...RequestOption) (res []*MarginSmallLiability, err error) {
+	r := &request{
+		method:   http.MethodGet,
+		endpoint: "/sapi/v1/margin/exchange-small-liability",
+		secType:  secTypeSigned,
+	}
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return []*MarginSmallLiability{}, err
+	}
+	res = make([]*MarginSmallLiability, 0)
+	err = json.Unmarshal(data, &res)
+	if err != nil {
+		return []*MarginSmallLiability{}, err
+	}
+	return res, nil
+}
+
+// MarginSmallLiability define a small cross margin liability
+type MarginSmallLiability struct {
+	Asset          string `json:"asset"`
+	Interest       string `json:"interest"`
+	Principal      string `json:"principal"`
+	LiabilityAsset string `json:"liabilityAsset"`
+	LiabilityQty   string `json:"liabilityQty"`
+}
+
+// ToggleBnbBurnService toggle paying spot trading fees and margin interest with BNB
+type ToggleBnbBurnService struct {
+	c               *Client
+	spotBNBBurn     *bool
+	interestBNBBurn *bool
+}
+
+// SpotBNBBurn set whether spot trading fees are paid with BNB
+func (s *ToggleBnbBurnService) SpotBNBBurn(spotBNBBurn bool) *ToggleBnbBurnService {
+	s.spotBNBBurn = &spotBNBBurn
+	return s
+}
+
+// InterestBNBBurn set whether margin interest is paid with BNB
+func (s *ToggleBnbBurnService) InterestBNBBurn(interestBNBBurn bool) *ToggleBnbBurnService {
+	s.interestBNBBurn = &interestBNBBurn
+	return s
+}
+
+// Do send request
+func (s *ToggleBnbBurnService) Do(ctx context.Context, opts ...RequestOption) (res *BnbBurnStatus, err error) {
+	r := &request{
+		method:   http.MethodPost,
+		endpoint: "/sapi/v1/bnbBurn",
+		secType:  secTypeSigned,
+	}
+	if s.spotBNBBurn != nil {
+		r.setFormParam("spotBNBBurn", *s.spotBNBBurn)
+	}
+	if s.interestBNBBurn != nil {
+		r.setFormParam("interestBNBBurn", *s.interestBNBBurn)
+	}
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(BnbBurnStatus)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// GetBnbBurnStatusService get whether spot trading fees and margin interest are paid with BNB
+type GetBnbBurnStatusService struct {
+	c *Client
+}
+
+// Do send request
+func (s *GetBnbBurnStatusService) Do(ctx context.Context, opts ...RequestOption) (res *BnbBurnStatus, err error) {
+	r := &request{
+		method:   http.MethodGet,
+		endpoint: "/sapi/v1/bnbBurn",
+		secType:  secTypeSigned,
+	}
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(BnbBurnStatus)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// BnbBurnStatus define BNB burn status
+type BnbBurnStatus struct {
+	SpotBNBBurn     bool `json:"spotBNBBurn"`
+	InterestBNBBurn bool `json:"interestBNBBurn"`
+}
@@ -1,8 +1,13 @@
 package binance
 
 import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
 	"testing"
 
+	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 )
 
@@ -40,6 +45,35 @@ func (s *marginTestSuite) TestTransfer() {
 	s.assertTransactionResponseEqual(e, res)
 }
 
+func (s *marginTestSuite) TestIsolatedTransfer() {
+	data := []byte(`{
+		"tranId": 100000002
+	}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+	asset := "USDT"
+	symbol := "BTCUSDT"
+	amount := "10.00"
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setFormParams(params{
+			"asset":     asset,
+			"symbol":    symbol,
+			"transFrom": "SPOT",
+			"transTo":   "ISOLATED_MARGIN",
+			"amount":    amount,
+		})
+		s.assertRequestEqual(e, r)
+	})
+	res, err := s.client.NewIsolatedMarginTransferService().Asset(asset).Symbol(symbol).
+		TransFrom(IsolatedMarginTransferAccountTypeSpot).TransTo(IsolatedMarginTransferAccountTypeIsolatedMargin).
+		Amount(amount).Do(newContext())
+	s.r().NoError(err)
+	e := &TransactionResponse{
+		TranID: 100000002,
+	}
+	s.assertTransactionResponseEqual(e, res)
+}
+
 func (s *marginTestSuite) assertTransactionResponseEqual(a, e *TransactionResponse) {
 	s.r().Equal(a.TranID, e.TranID, "TranID")
 }
@@ -92,6 +126,81 @@ func (s *marginTestSuite) TestRepay() {
 	s.assertTransactionResponseEqual(e, res)
 }
 
+func (s *marginTestSuite) TestGetMarginTransferHistory() {
+	data := []byte(`{
+		"rows": [
+			{
+				"amount": "0.10000000",
+				"asset": "BNB",
+				"status": "CONFIRMED",
+				"timestamp": 1566898617000,
+				"txId": 5240372201,
+				"type": "ROLL_IN",
+				"transFrom": "SPOT",
+				"transTo": "ISOLATED_MARGIN"
+			},
+			{
+				"amount": "5.00000000",
+				"asset": "BNB",
+				"status": "CONFIRMED",
+				"timestamp": 1566888436123,
+				"txId": 5239810406,
+				"type": "ROLL_OUT",
+				"transFrom": "ISOLATED_MARGIN",
+				"transTo": "ISOLATED_MARGIN"
+			}
+		],
+		"total": 2
+	}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+	asset := "BNB"
+	startTime := int64(1566888436000)
+	current := int64(1)
+	size := int64(10)
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setParams(params{
+			"asset":          asset,
+			"type":           MarginTransferHistoryTypeRollIn,
+			"startTime":      startTime,
+			"current":        current,
+			"size":           size,
+			"isolatedSymbol": "BNBUSDT",
+		})
+		s.assertRequestEqual(e, r)
+	})
+	res, err := s.client.NewGetMarginTransferHistoryService().Asset(asset).
+		Type(MarginTransferHistoryTypeRollIn).StartTime(startTime).
+		Current(current).Size(size).IsolatedSymbol("BNBUSDT").Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	r.Equal(&MarginTransferHistoryResponse{
+		Rows: []MarginTransfer{
+			{
+				Amount:    "0.10000000",
+				Asset:     "BNB",
+				Status:    "CONFIRMED",
+				Timestamp: 1566898617000,
+				TxID:      5240372201,
+				Type:      MarginTransferHistoryTypeRollIn,
+				TransFrom: "SPOT",
+				TransTo:   "ISOLATED_MARGIN",
+			},
+			{
+				Amount:    "5.00000000",
+				Asset:     "BNB",
+				Status:    "CONFIRMED",
+				Timestamp: 1566888436123,
+				TxID:      5239810406,
+				Type:      MarginTransferHistoryTypeRollOut,
+				TransFrom: "ISOLATED_MARGIN",
+				TransTo:   "ISOLATED_MARGIN",
+			},
+		},
+		Total: 2,
+	}, res)
+}
+
 func (s *marginTestSuite) TestListMarginLoans() {
 	data := []byte(`{
 		"rows": [
@@ -235,13 +344,19 @@ func (s *marginTestSuite) assertMarginRepayEqual(e, a *MarginRepay) {
 
 func (s *marginTestSuite) TestGetMarginAccount() {
 	data := []byte(`{
+		"created": true,
 		"borrowEnabled": true,
 		"marginLevel": "11.64405625",
+		"collateralMarginLevel": "3.2",
 		"totalAssetOfBtc": "6.82728457",
 		"totalLiabilityOfBtc": "0.58633215",
 		"totalNetAssetOfBtc": "6.24095242",
+		"totalCollateralValueInUSDT": "5.82728457",
 		"tradeEnabled": true,
 		"transferEnabled": true,
+		"transferInEnabled": true,
+		"transferOutEnabled": true,
+		"accountType": "MARGIN_1",
 		"userAssets": [
 			{
 				"asset": "BTC",
@@ -286,13 +401,19 @@ func (s *marginTestSuite) TestGetMarginAccount() {
 	res, err := s.client.NewGetMarginAccountService().Do(newContext())
 	s.r().NoError(err)
 	e := &MarginAccount{
-		BorrowEnabled:       true,
-		MarginLevel:         "11.64405625",
-		TotalAssetOfBTC:     "6.82728457",
-		TotalLiabilityOfBTC: "0.58633215",
-		TotalNetAssetOfBTC:  "6.24095242",
-		TradeEnabled:        true,
-		TransferEnabled:     true,
+		Created:                    true,
+		BorrowEnabled:              true,
+		MarginLevel:                "11.64405625",
+		CollateralMarginLevel:      "3.2",
+		TotalAssetOfBTC:            "6.82728457",
+		TotalLiabilityOfBTC:        "0.58633215",
+		TotalNetAssetOfBTC:         "6.24095242",
+		TotalCollateralValueInUSDT: "5.82728457",
+		TradeEnabled:               true,
+		TransferEnabled:            true,
+		TransferInEnabled:          true,
+		TransferOutEnabled:         true,
+		AccountType:                "MARGIN_1",
 		UserAssets: []UserAsset{
 			{
 				Asset:    "BTC",
@@ -329,6 +450,8 @@ func (s *marginTestSuite) TestGetMarginAccount() {
 		},
 	}
 	s.assertMarginAccountEqual(e, res)
+	s.r().Equal("201.66666672", res.UserAsset("BNB").Borrowed)
+	s.r().Nil(res.UserAsset("DOGE"))
 }
 
 func (s *marginTestSuite) TestGetIsolatedMarginAccount() {
@@ -404,6 +527,74 @@ func (s *marginTestSuite) TestGetIsolatedMarginAccount() {
 	s.assertIsolatedMarginAccountEqual(e, res)
 }
 
+func (s *marginTestSuite) TestGetIsolatedMarginAccountSymbols() {
+	data := []byte(`{
+		"assets": [
+			{
+				"baseAsset": {"asset": "BTC", "borrowed": "0.10000000", "free": "0.50000000", "netAsset": "0.40000000"},
+				"quoteAsset": {"asset": "USDT", "borrowed": "0.00000000", "free": "100.00000000", "netAsset": "100.00000000"},
+				"symbol": "BTCUSDT",
+				"isolatedCreated": true,
+				"marginLevel": "2.50000000",
+				"marginLevelStatus": "NORMAL",
+				"marginRatio": "10.00000000",
+				"indexPrice": "10000.00000000",
+				"liquidatePrice": "6000.00000000",
+				"liquidateRate": "1.00000000",
+				"tradeEnabled": true
+			},
+			{
+				"baseAsset": {"asset": "ETH", "borrowed": "0.00000000", "free": "1.00000000", "netAsset": "1.00000000"},
+				"quoteAsset": {"asset": "USDT", "borrowed": "50.00000000", "free": "0.00000000", "netAsset": "-50.00000000"},
+				"symbol": "ETHUSDT",
+				"isolatedCreated": true,
+				"marginLevel": "3.00000000",
+				"marginLevelStatus": "NORMAL",
+				"marginRatio": "5.00000000",
+				"indexPrice": "300.00000000",
+				"liquidatePrice": "55.00000000",
+				"liquidateRate": "1.00000000",
+				"tradeEnabled": true
+			}
+		]
+	}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setParam("symbols", "BTCUSDT,ETHUSDT")
+		s.assertRequestEqual(e, r)
+	})
+
+	res, err := s.client.NewGetIsolatedMarginAccountService().Symbols("BTCUSDT", "ETHUSDT").Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	r.Len(res.Assets, 2)
+	e := &IsolatedMarginAccount{
+		Assets: []IsolatedMarginAsset{
+			{
+				Symbol:            "BTCUSDT",
+				MarginLevel:       "2.50000000",
+				MarginLevelStatus: "NORMAL",
+				MarginRatio:       "10.00000000",
+				IndexPrice:        "10000.00000000",
+				LiquidatePrice:    "6000.00000000",
+			},
+			{
+				Symbol:            "ETHUSDT",
+				MarginLevel:       "3.00000000",
+				MarginLevelStatus: "NORMAL",
+				MarginRatio:       "5.00000000",
+				IndexPrice:        "300.00000000",
+				LiquidatePrice:    "55.00000000",
+			},
+		},
+	}
+	s.assertIsolatedMarginAccountEqual(e, res)
+	r.Equal("BTC", res.Assets[0].BaseAsset.Asset, "BaseAsset")
+	r.Equal("0.10000000", res.Assets[0].BaseAsset.Borrowed, "Borrowed")
+	r.Equal("-50.00000000", res.Assets[1].QuoteAsset.NetAsset, "NetAsset")
+}
+
 func (s *marginTestSuite) assertIsolatedMarginAccountEqual(e, a *IsolatedMarginAccount) {
 	r := s.r()
 	r.Equal(e.TotalAssetOfBTC, a.TotalAssetOfBTC, "TotalAssetOfBTC")
@@ -433,6 +624,12 @@ func (s *marginTestSuite) assertMarginAccountEqual(e, a *MarginAccount) {
 	r.Equal(e.TotalNetAssetOfBTC, a.TotalNetAssetOfBTC, "TotalNetAssetOfBTC")
 	r.Equal(e.TradeEnabled, a.TradeEnabled, "TradeEnabled")
 	r.Equal(e.TransferEnabled, a.TransferEnabled, "TransferEnabled")
+	r.Equal(e.Created, a.Created, "Created")
+	r.Equal(e.CollateralMarginLevel, a.CollateralMarginLevel, "CollateralMarginLevel")
+	r.Equal(e.TotalCollateralValueInUSDT, a.TotalCollateralValueInUSDT, "TotalCollateralValueInUSDT")
+	r.Equal(e.TransferInEnabled, a.TransferInEnabled, "TransferInEnabled")
+	r.Equal(e.TransferOutEnabled, a.TransferOutEnabled, "TransferOutEnabled")
+	r.Equal(e.AccountType, a.AccountType, "AccountType")
 	r.Len(a.UserAssets, len(e.UserAssets), "UserAssets")
 	for i := 0; i < len(a.UserAssets); i++ {
 		s.assertUserAssetEqual(e.UserAssets[i], a.UserAssets[i])
@@ -873,3 +1070,334 @@ func (s *marginTestSuite) assertIsolatedMarginAllPairsEqual(e, a *IsolatedMargin
 	r.Equal(e.IsBuyAllowed, a.IsBuyAllowed, "IsBuyAllowed")
 	r.Equal(e.IsSellAllowed, a.IsSellAllowed, "IsSellAllowed")
 }
+
+func (s *marginTestSuite) TestGetCrossMarginCollateralRatio() {
+	data := []byte(`[
+		{
+			"collaterals": [
+				{"minUsdValue": "0", "maxUsdValue": "13000000", "discountRate": "1"},
+				{"minUsdValue": "13000000", "maxUsdValue": "20000000", "discountRate": "0.975"},
+				{"minUsdValue": "20000000", "discountRate": "0"}
+			],
+			"assetNames": ["BNX"]
+		},
+		{
+			"collaterals": [
+				{"minUsdValue": "0", "discountRate": "1"}
+			],
+			"assetNames": ["BTC", "BUSD"]
+		}
+	]`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	s.assertReq(func(r *request) {
+		e := newRequest()
+		s.assertRequestEqual(e, r)
+	})
+	res, err := s.client.NewGetCrossMarginCollateralRatioService().Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	e := []*CrossMarginCollateralRatio{
+		{
+			Collaterals: []CrossMarginCollateral{
+				{MinUsdValue: "0", MaxUsdValue: "13000000", DiscountRate: "1"},
+				{MinUsdValue: "13000000", MaxUsdValue: "20000000", DiscountRate: "0.975"},
+				{MinUsdValue: "20000000", DiscountRate: "0"},
+			},
+			AssetNames: []string{"BNX"},
+		},
+		{
+			Collaterals: []CrossMarginCollateral{
+				{MinUsdValue: "0", DiscountRate: "1"},
+			},
+			AssetNames: []string{"BTC", "BUSD"},
+		},
+	}
+	r.Equal(e, res)
+}
+
+func (s *marginTestSuite) TestGetMarginForceLiquidation() {
+	data := []byte(`{
+		"rows": [
+			{
+				"avgPrice": "0.00388359",
+				"executedQty": "31.39000000",
+				"orderId": 180015097,
+				"price": "0.00388110",
+				"qty": "31.39000000",
+				"side": "SELL",
+				"symbol": "BNBBTC",
+				"timeInForce": "GTC",
+				"isIsolated": true,
+				"updatedTime": 1558941374745
+			}
+		],
+		"total": 1
+	}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+	startTime := int64(1558941374000)
+	endTime := int64(1558941375000)
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setParams(params{
+			"startTime":      startTime,
+			"endTime":        endTime,
+			"isolatedSymbol": "BNBBTC",
+			"current":        int64(1),
+			"size":           int64(10),
+		})
+		s.assertRequestEqual(e, r)
+	})
+	res, err := s.client.NewGetMarginForceLiquidationService().StartTime(startTime).
+		EndTime(endTime).IsolatedSymbol("BNBBTC").Current(1).Size(10).Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	r.Equal(&MarginForceLiquidationResponse{
+		Rows: []MarginForceLiquidation{
+			{
+				AvgPrice:         "0.00388359",
+				ExecutedQuantity: "31.39000000",
+				OrderID:          180015097,
+				Price:            "0.00388110",
+				Quantity:         "31.39000000",
+				Side:             SideTypeSell,
+				Symbol:           "BNBBTC",
+				TimeInForce:      TimeInForceTypeGTC,
+				IsIsolated:       true,
+				UpdatedTime:      1558941374745,
+			},
+		},
+		Total: 1,
+	}, res)
+}
+
+func (s *marginTestSuite) TestRepayAll() {
+	accounts := []string{
+		`{"userAssets": [{"asset": "BTC", "borrowed": "1.00000000", "interest": "0.00010000"}]}`,
+		// interest accrued between reading and repaying
+		`{"userAssets": [{"asset": "BTC", "borrowed": "0.00000000", "interest": "0.00000002"}]}`,
+		`{"userAssets": [{"asset": "BTC", "borrowed": "0.00000000", "interest": "0.00000000"}]}`,
+	}
+	var repays []url.Values
+	s.client.Client.do = func(req *http.Request) (*http.Response, error) {
+		if req.URL.Path == "/sapi/v1/margin/repay" {
+			body, _ := ioutil.ReadAll(req.Body)
+			form, _ := url.ParseQuery(string(body))
+			repays = append(repays, form)
+			return newHTTPResponse([]byte(fmt.Sprintf(`{"tranId": %d}`, len(repays))), http.StatusOK), nil
+		}
+		account := accounts[0]
+		accounts = accounts[1:]
+		return newHTTPResponse([]byte(account), http.StatusOK), nil
+	}
+
+	txs, err := s.client.RepayAll(newContext(), "BTC", "")
+	r := s.r()
+	r.NoError(err)
+	r.Len(repays, 2)
+	r.Equal("BTC", repays[0].Get("asset"))
+	r.Equal("1.00010000", repays[0].Get("amount"))
+	r.Equal("0.00000002", repays[1].Get("amount"))
+	r.Equal([]*TransactionResponse{{TranID: 1}, {TranID: 2}}, txs)
+	r.Empty(accounts)
+}
+
+func (s *marginTestSuite) TestRepayAllIsolated() {
+	var repay *http.Request
+	var repayForm url.Values
+	s.client.Client.do = func(req *http.Request) (*http.Response, error) {
+		if req.URL.Path == "/sapi/v1/margin/repay" {
+			repay = req
+			body, _ := ioutil.ReadAll(req.Body)
+			repayForm, _ = url.ParseQuery(string(body))
+			return newHTTPResponse([]byte(`{"tranId": 1}`), http.StatusOK), nil
+		}
+		borrowed, interest := "25.50000000", "0.01250000"
+		if repay != nil {
+			borrowed, interest = "0", "0"
+		}
+		return newHTTPResponse([]byte(`{"assets": [{
+			"symbol": "BNBUSDT",
+			"baseAsset": {"asset": "BNB", "borrowed": "1.00000000", "interest": "0"},
+			"quoteAsset": {"asset": "USDT", "borrowed": "`+borrowed+`", "interest": "`+interest+`"}
+		}]}`), http.StatusOK), nil
+	}
+
+	txs, err := s.client.RepayAll(newContext(), "USDT", "BNBUSDT")
+	r := s.r()
+	r.NoError(err)
+	r.Len(txs, 1)
+	r.Equal("BNBUSDT", repay.URL.Query().Get("isolatedSymbol"))
+	r.Equal("USDT", repayForm.Get("asset"))
+	r.Equal("25.51250000", repayForm.Get("amount"))
+}
+
+func (s *marginTestSuite) TestRepayAllStillOwes() {
+	s.client.Client.do = func(req *http.Request) (*http.Response, error) {
+		if req.URL.Path == "/sapi/v1/margin/repay" {
+			return newHTTPResponse([]byte(`{"tranId": 1}`), http.StatusOK), nil
+		}
+		return newHTTPResponse([]byte(`{"userAssets": [{"asset": "BTC", "borrowed": "0.5", "interest": "0"}]}`), http.StatusOK), nil
+	}
+
+	txs, err := s.client.RepayAll(newContext(), "BTC", "")
+	r := s.r()
+	r.EqualError(err, "BTC still owes 0.50000000 after 3 repays")
+	r.Len(txs, 3)
+}
+
+func (s *marginTestSuite) TestMarginSmallLiabilityExchange() {
+	s.mockDo([]byte(`{}`), nil)
+	defer s.assertDo()
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setFormParams(params{
+			"assetNames": "BTC,ETH",
+		})
+		s.assertRequestEqual(e, r)
+	})
+	err := s.client.NewMarginSmallLiabilityExchangeService().AssetNames("BTC", "ETH").Do(newContext())
+	s.r().NoError(err)
+}
+
+func (s *marginTestSuite) TestGetMarginSmallLiabilityList() {
+	data := []byte(`[
+		{
+			"asset": "ETH",
+			"interest": "0.00083334",
+			"principal": "0.001",
+			"liabilityAsset": "USDT",
+			"liabilityQty": "0.3552"
+		}
+	]`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+	s.assertReq(func(r *request) {
+		e := newSignedRequest()
+		s.assertRequestEqual(e, r)
+	})
+	res, err := s.client.NewGetMarginSmallLiabilityListService().Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	r.Equal([]*MarginSmallLiability{
+		{
+			Asset:          "ETH",
+			Interest:       "0.00083334",
+			Principal:      "0.001",
+			LiabilityAsset: "USDT",
+			LiabilityQty:   "0.3552",
+		},
+	}, res)
+}
+
+func (s *marginTestSuite) TestGetMarginInterestRate() {
+	data := []byte(`[
+		{"asset": "BTC", "nextHourlyInterestRate": "0.00000571"},
+		{"asset": "ETH", "nextHourlyInterestRate": "0.00000578"}
+	]`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setParams(params{
+			"assets":     "BTC,ETH",
+			"isIsolated": "FALSE",
+		})
+		s.assertRequestEqual(e, r)
+	})
+	res, err := s.client.NewGetMarginInterestRateService().Assets("BTC", "ETH").Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	r.Equal([]*MarginInterestRate{
+		{Asset: "BTC", NextHourlyInterestRate: "0.00000571"},
+		{Asset: "ETH", NextHourlyInterestRate: "0.00000578"},
+	}, res)
+}
+
+func (s *marginTestSuite) TestNextHourlyMarginInterest() {
+	var rateQuery url.Values
+	s.client.Client.do = func(req *http.Request) (*http.Response, error) {
+		if req.URL.Path == "/sapi/v1/margin/next-hourly-interest-rate" {
+			rateQuery = req.URL.Query()
+			return newHTTPResponse([]byte(`[
+				{"asset": "BTC", "nextHourlyInterestRate": "0.00000571"},
+				{"asset": "USDT", "nextHourlyInterestRate": "0.00000400"}
+			]`), http.StatusOK), nil
+		}
+		return newHTTPResponse([]byte(`{"userAssets": [
+			{"asset": "BTC", "borrowed": "2.00000000", "interest": "0.00001000"},
+			{"asset": "ETH", "borrowed": "0.00000000", "interest": "0.00000000"},
+			{"asset": "USDT", "borrowed": "1500.00000000", "interest": "0.12000000"}
+		]}`), http.StatusOK), nil
+	}
+
+	res, err := s.client.NextHourlyMarginInterest(newContext())
+	r := s.r()
+	r.NoError(err)
+	r.Equal("BTC,USDT", rateQuery.Get("assets"))
+	r.Equal("FALSE", rateQuery.Get("isIsolated"))
+	r.Equal(map[string]string{
+		"BTC":  "0.00001142",
+		"USDT": "0.00600000",
+	}, res)
+}
+
+func (s *marginTestSuite) TestToggleBnbBurn() {
+	s.mockDo([]byte(`{"spotBNBBurn": true, "interestBNBBurn": false}`), nil)
+	defer s.assertDo()
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setFormParams(params{
+			"spotBNBBurn":     "true",
+			"interestBNBBurn": "false",
+		})
+		s.assertRequestEqual(e, r)
+	})
+	res, err := s.client.NewToggleBnbBurnService().SpotBNBBurn(true).InterestBNBBurn(false).Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	r.Equal(&BnbBurnStatus{SpotBNBBurn: true, InterestBNBBurn: false}, res)
+}
+
+func (s *marginTestSuite) TestGetBnbBurnStatus() {
+	s.mockDo([]byte(`{"spotBNBBurn": false, "interestBNBBurn": true}`), nil)
+	defer s.assertDo()
+	s.assertReq(func(r *request) {
+		e := newSignedRequest()
+		s.assertRequestEqual(e, r)
+	})
+	res, err := s.client.NewGetBnbBurnStatusService().Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	r.Equal(&BnbBurnStatus{SpotBNBBurn: false, InterestBNBBurn: true}, res)
+}
+
+func TestProjectInterest(t *testing.T) {
+	tests := []struct {
+		name       string
+		principal  string
+		hourlyRate string
+		hours      int
+		want       string
+	}{
+		{"one day", "1000", "0.00000417", 24, "0.10008000"},
+		{"zero rate", "1000", "0", 24, "0.00000000"},
+		{"zero hours", "1000", "0.00000417", 0, "0.00000000"},
+		{"large principal", "123456789012.12345678", "0.00000125", 720, "111111110.11091111"},
+		{"tiny rate exact", "0.1", "0.0000001", 3, "0.00000003"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ProjectInterest(tt.principal, tt.hourlyRate, tt.hours)
+			r := require.New(t)
+			r.NoError(err)
+			r.Equal(tt.want, got)
+		})
+	}
+
+	r := require.New(t)
+	_, err := ProjectInterest("1000", "0.00000417", -1)
+	r.Error(err)
+	_, err = ProjectInterest("-1000", "0.00000417", 1)
+	r.Error(err)
+	_, err = ProjectInterest("abc", "0.00000417", 1)
+	r.Error(err)
+}
@@ -0,0 +1,96 @@
+package binance
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/adshao/go-binance/v2/common"
+)
+
+// MarketBuyQuote spend quoteAmount of the quote asset of symbol, e.g. "100"
+// USDT of BTCUSDT, with a quoteOrderQty MARKET order. The amount is rounded
+// down to the quote asset precision and checked against the MIN_NOTIONAL or
+// NOTIONAL filter, as far as they apply to market orders, and the free
+// balance of the quote asset before the order is placed.
+// It returns the FULL order response, whose Fills hold the executions, and the
+// average fill price.
+func (c *Client) MarketBuyQuote(ctx context.Context, symbol string, quoteAmount string) (order *CreateOrderResponse, avgPrice string, err error) {
+	s, err := c.SymbolInfo().Get(ctx, symbol)
+	if err != nil {
+		return nil, "", err
+	}
+	var step string
+	if s.QuoteAssetPrecision > 0 {
+		step = "0." + strings.Repeat("0", s.QuoteAssetPrecision-1) + "1"
+	}
+	amount, err := common.RoundToFilter(quoteAmount, step, "", "", "quote amount", symbol)
+	if err != nil {
+		return nil, "", err
+	}
+	if f := s.MinNotionalFilter(); f != nil && f.ApplyToMarket {
+		if err = checkNotional(amount, f.MinNotional, "", symbol); err != nil {
+			return nil, "", err
+		}
+	}
+	if f := s.NotionalFilter(); f != nil {
+		var min, max string
+		if f.ApplyMinToMarket {
+			min = f.MinNotional
+		}
+		if f.ApplyMaxToMarket {
+			max = f.MaxNotional
+		}
+		if err = checkNotional(amount, min, max, symbol); err != nil {
+			return nil, "", err
+		}
+	}
+
+	account, err := c.NewGetAccountService().Do(ctx)
+	if err != nil {
+		return nil, "", err
+	}
+	free := new(big.Rat)
+	for _, b := range account.Balances {
+		if b.Asset == s.QuoteAsset {
+			if free, err = common.ParseDecimal(b.Free); err != nil {
+				return nil, "", err
+			}
+			break
+		}
+	}
+	if amount.Cmp(free) > 0 {
+		return nil, "", fmt.Errorf("quote amount %s of %s exceeds free %s balance %s",
+			quoteAmount, symbol, s.QuoteAsset, free.FloatString(s.QuoteAssetPrecision))
+	}
+
+	order, err = c.NewCreateOrderService().Symbol(symbol).Side(SideTypeBuy).
+		Type(OrderTypeMarket).QuoteOrderQty(amount.FloatString(common.StepDecimals(step))).
+		NewOrderRespType(NewOrderRespTypeFULL).Do(ctx)
+	if err != nil {
+		return nil, "", err
+	}
+	avgPrice, err = averageFillPrice(order, s.QuoteAssetPrecision)
+	if err != nil {
+		return nil, "", err
+	}
+	return order, avgPrice, nil
+}
+
+// averageFillPrice return the cumulative quote quantity of order divided by its
+// executed quantity, or an empty string for an order without executions
+func averageFillPrice(order *CreateOrderResponse, decimals int) (string, error) {
+	executed, err := common.ParseDecimal(order.ExecutedQuantity)
+	if err != nil {
+		return "", err
+	}
+	if executed.Sign() == 0 {
+		return "", nil
+	}
+	quote, err := common.ParseDecimal(order.CummulativeQuoteQuantity)
+	if err != nil {
+		return "", err
+	}
+	return new(big.Rat).Quo(quote, executed).FloatString(decimals), nil
+}
@@ -0,0 +1,200 @@
+package binance
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type marketBuyTestSuite struct {
+	baseTestSuite
+}
+
+func TestMarketBuy(t *testing.T) {
+	suite.Run(t, new(marketBuyTestSuite))
+}
+
+var marketBuyExchangeInfo = []byte(`{
+	"timezone": "UTC",
+	"serverTime": 1565246363776,
+	"symbols": [
+		{
+			"symbol": "BTCUSDT",
+			"status": "TRADING",
+			"baseAsset": "BTC",
+			"baseAssetPrecision": 8,
+			"quoteAsset": "USDT",
+			"quoteAssetPrecision": 8,
+			"quoteOrderQtyMarketAllowed": true,
+			"filters": [
+				{
+					"filterType": "PRICE_FILTER",
+					"minPrice": "0.01000000",
+					"maxPrice": "1000000.00000000",
+					"tickSize": "0.01000000"
+				},
+				{
+					"filterType": "LOT_SIZE",
+					"minQty": "0.00000100",
+					"maxQty": "9000.00000000",
+					"stepSize": "0.00000100"
+				},
+				{
+					"filterType": "MIN_NOTIONAL",
+					"minNotional": "10.00000000",
+					"applyToMarket": true,
+					"avgPriceMins": 5
+				}
+			]
+		},
+		{
+			"symbol": "ETHUSDT",
+			"status": "TRADING",
+			"baseAsset": "ETH",
+			"baseAssetPrecision": 8,
+			"quoteAsset": "USDT",
+			"quoteAssetPrecision": 8,
+			"quoteOrderQtyMarketAllowed": true,
+			"filters": [
+				{
+					"filterType": "PRICE_FILTER",
+					"minPrice": "0.01000000",
+					"maxPrice": "1000000.00000000",
+					"tickSize": "0.01000000"
+				},
+				{
+					"filterType": "LOT_SIZE",
+					"minQty": "0.00010000",
+					"maxQty": "9000.00000000",
+					"stepSize": "0.00010000"
+				},
+				{
+					"filterType": "NOTIONAL",
+					"minNotional": "5.00000000",
+					"applyMinToMarket": true,
+					"maxNotional": "100.00000000",
+					"applyMaxToMarket": true,
+					"avgPriceMins": 5
+				}
+			]
+		}
+	]
+}`)
+
+var marketBuyAccount = []byte(`{
+	"makerCommission": 10,
+	"takerCommission": 10,
+	"canTrade": true,
+	"balances": [
+		{"asset": "BTC", "free": "0.00000000", "locked": "0.00000000"},
+		{"asset": "USDT", "free": "150.00000000", "locked": "0.00000000"}
+	]
+}`)
+
+func (s *marketBuyTestSuite) TestMarketBuyQuote() {
+	order := []byte(`{
+		"symbol": "BTCUSDT",
+		"orderId": 28,
+		"clientOrderId": "6gCrw2kRUAF9CvJDGP16IP",
+		"transactTime": 1507725176595,
+		"price": "0.00000000",
+		"origQty": "0.00400000",
+		"executedQty": "0.00400000",
+		"cummulativeQuoteQty": "100.00000000",
+		"status": "FILLED",
+		"timeInForce": "GTC",
+		"type": "MARKET",
+		"side": "BUY",
+		"fills": [
+			{"price": "24990.00000000", "qty": "0.00300000", "commission": "0.00000300", "commissionAsset": "BTC"},
+			{"price": "25030.00000000", "qty": "0.00100000", "commission": "0.00000100", "commissionAsset": "BTC"}
+		]
+	}`)
+	var orderReq *http.Request
+	s.client.Client.do = func(req *http.Request) (*http.Response, error) {
+		switch req.URL.Path {
+		case "/api/v3/exchangeInfo":
+			return newHTTPResponse(marketBuyExchangeInfo, http.StatusOK), nil
+		case "/api/v3/account":
+			return newHTTPResponse(marketBuyAccount, http.StatusOK), nil
+		}
+		req.ParseForm()
+		orderReq = req
+		return newHTTPResponse(order, http.StatusOK), nil
+	}
+
+	res, avgPrice, err := s.client.MarketBuyQuote(newContext(), "BTCUSDT", "100.000000001")
+	r := s.r()
+	r.NoError(err)
+	r.Equal("25000.00000000", avgPrice)
+	r.Len(res.Fills, 2)
+	r.Equal("0.00300000", res.Fills[0].Quantity)
+	r.NotNil(orderReq)
+	r.Equal("/api/v3/order", orderReq.URL.Path)
+	r.Equal("MARKET", orderReq.Form.Get("type"))
+	r.Equal("BUY", orderReq.Form.Get("side"))
+	r.Equal("100.00000000", orderReq.Form.Get("quoteOrderQty"))
+	r.Equal("FULL", orderReq.Form.Get("newOrderRespType"))
+	r.Empty(orderReq.Form.Get("quantity"))
+}
+
+func (s *marketBuyTestSuite) TestMarketBuyQuoteRejected() {
+	var ordered bool
+	s.client.Client.do = func(req *http.Request) (*http.Response, error) {
+		switch req.URL.Path {
+		case "/api/v3/exchangeInfo":
+			return newHTTPResponse(marketBuyExchangeInfo, http.StatusOK), nil
+		case "/api/v3/account":
+			return newHTTPResponse(marketBuyAccount, http.StatusOK), nil
+		}
+		ordered = true
+		return newHTTPResponse([]byte(`{}`), http.StatusOK), nil
+	}
+
+	r := s.r()
+	_, _, err := s.client.MarketBuyQuote(newContext(), "BTCUSDT", "9.99")
+	r.Error(err)
+	r.Contains(err.Error(), "min notional")
+	_, _, err = s.client.MarketBuyQuote(newContext(), "BTCUSDT", "150.01")
+	r.Error(err)
+	r.Contains(err.Error(), "exceeds free USDT balance")
+	r.False(ordered)
+}
+
+func (s *marketBuyTestSuite) TestMarketBuyQuoteNotional() {
+	order := []byte(`{
+		"symbol": "ETHUSDT",
+		"orderId": 29,
+		"executedQty": "0.02500000",
+		"cummulativeQuoteQty": "50.00000000",
+		"status": "FILLED",
+		"type": "MARKET",
+		"side": "BUY"
+	}`)
+	var orders int
+	s.client.Client.do = func(req *http.Request) (*http.Response, error) {
+		switch req.URL.Path {
+		case "/api/v3/exchangeInfo":
+			return newHTTPResponse(marketBuyExchangeInfo, http.StatusOK), nil
+		case "/api/v3/account":
+			return newHTTPResponse(marketBuyAccount, http.StatusOK), nil
+		}
+		orders++
+		return newHTTPResponse(order, http.StatusOK), nil
+	}
+
+	r := s.r()
+	_, _, err := s.client.MarketBuyQuote(newContext(), "ETHUSDT", "4.99")
+	r.Error(err)
+	r.Contains(err.Error(), "below min notional")
+	_, _, err = s.client.MarketBuyQuote(newContext(), "ETHUSDT", "120")
+	r.Error(err)
+	r.Contains(err.Error(), "above max notional")
+	r.Equal(0, orders)
+
+	_, avgPrice, err := s.client.MarketBuyQuote(newContext(), "ETHUSDT", "50")
+	r.NoError(err)
+	r.Equal("2000.00000000", avgPrice)
+	r.Equal(1, orders)
+}
@@ -0,0 +1,182 @@
+package binance
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"sort"
+	"sync"
+
+	"github.com/adshao/go-binance/v2/common"
+)
+
+// OrderBookManager maintain a local order book of a symbol from a REST depth
+// snapshot and the diff depth stream. The book is fetched again whenever the
+// stream skips updates. It is safe for concurrent use.
+type OrderBookManager struct {
+	c          *Client
+	symbol     string
+	limit      int
+	errHandler ErrHandler
+
+	mu           sync.RWMutex
+	synced       bool
+	lastUpdateID int64
+	bids         map[string]string
+	asks         map[string]string
+}
+
+// orderBookSnapshot define the persisted state of an OrderBookManager
+type orderBookSnapshot struct {
+	Symbol       string `json:"symbol"`
+	LastUpdateID int64  `json:"lastUpdateId"`
+	Bids         []Bid  `json:"bids"`
+	Asks         []Ask  `json:"asks"`
+}
+
+// NewOrderBookManager init an order book of symbol synced from depth snapshots
+// of limit levels, errHandler is called for stream and resync errors
+func (c *Client) NewOrderBookManager(symbol string, limit int, errHandler ErrHandler) *OrderBookManager {
+	return &OrderBookManager{
+		c:          c,
+		symbol:     symbol,
+		limit:      limit,
+		errHandler: errHandler,
+		bids:       make(map[string]string),
+		asks:       make(map[string]string),
+	}
+}
+
+// Serve the diff depth stream with opts and keep the book up to date, the book
+// is synced on the first event unless restored by Restore
+func (m *OrderBookManager) Serve(opts ...WsOption) (doneC, stopC chan struct{}, err error) {
+	return WsDepthServe100Ms(m.symbol, m.handleEvent, m.errHandler, opts...)
+}
+
+// LastUpdateID return the update id the book is at
+func (m *OrderBookManager) LastUpdateID() int64 {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.lastUpdateID
+}
+
+// Depth return the book with bids by descending and asks by ascending price
+func (m *OrderBookManager) Depth() *DepthResponse {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return &DepthResponse{
+		LastUpdateID: m.lastUpdateID,
+		Bids:         sortedLevels(m.bids, true),
+		Asks:         sortedLevels(m.asks, false),
+	}
+}
+
+// Export the book so it can be restored by Restore, e.g. after a restart
+func (m *OrderBookManager) Export() ([]byte, error) {
+	depth := m.Depth()
+	return json.Marshal(&orderBookSnapshot{
+		Symbol:       m.symbol,
+		LastUpdateID: depth.LastUpdateID,
+		Bids:         depth.Bids,
+		Asks:         depth.Asks,
+	})
+}
+
+// Restore the book exported by Export. Stream events continue from the
+// restored update id, if the stream moved past it the book is fetched again.
+func (m *OrderBookManager) Restore(data []byte) error {
+	snapshot := new(orderBookSnapshot)
+	if err := json.Unmarshal(data, snapshot); err != nil {
+		return err
+	}
+	if snapshot.Symbol != m.symbol {
+		return fmt.Errorf("order book snapshot of %s cannot be restored to %s", snapshot.Symbol, m.symbol)
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.setBook(&DepthResponse{
+		LastUpdateID: snapshot.LastUpdateID,
+		Bids:         snapshot.Bids,
+		Asks:         snapshot.Asks,
+	})
+	return nil
+}
+
+func (m *OrderBookManager) handleEvent(event *WsDepthEvent) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if !m.synced || event.FirstUpdateID > m.lastUpdateID+1 {
+		// the book is empty or missed updates
+		if err := m.resync(); err != nil {
+			m.errHandler(err)
+			return
+		}
+	}
+	if event.LastUpdateID <= m.lastUpdateID {
+		// already part of the book
+		return
+	}
+	if event.FirstUpdateID > m.lastUpdateID+1 {
+		m.synced = false
+		m.errHandler(fmt.Errorf("depth of %s skipped from update %d to %d",
+			m.symbol, m.lastUpdateID, event.FirstUpdateID))
+		return
+	}
+	applyLevels(m.bids, event.Bids)
+	applyLevels(m.asks, event.Asks)
+	m.lastUpdateID = event.LastUpdateID
+}
+
+// resync must be called with mu held
+func (m *OrderBookManager) resync() error {
+	m.synced = false
+	depth, err := m.c.NewDepthService().Symbol(m.symbol).Limit(m.limit).Do(context.Background())
+	if err != nil {
+		return err
+	}
+	m.setBook(depth)
+	return nil
+}
+
+// setBook must be called with mu held
+func (m *OrderBookManager) setBook(depth *DepthResponse) {
+	m.bids = make(map[string]string, len(depth.Bids))
+	m.asks = make(map[string]string, len(depth.Asks))
+	applyLevels(m.bids, depth.Bids)
+	applyLevels(m.asks, depth.Asks)
+	m.lastUpdateID = depth.LastUpdateID
+	m.synced = true
+}
+
+// applyLevels set the quantity of every level, removing levels of zero quantity
+func applyLevels(book map[string]string, levels []Bid) {
+	for _, level := range levels {
+		if q, err := common.ParseDecimal(level.Quantity); err == nil && q.Sign() == 0 {
+			delete(book, level.Price)
+			continue
+		}
+		book[level.Price] = level.Quantity
+	}
+}
+
+func sortedLevels(book map[string]string, descending bool) []Bid {
+	levels := make([]Bid, 0, len(book))
+	prices := make(map[string]*big.Rat, len(book))
+	for price, quantity := range book {
+		levels = append(levels, Bid{Price: price, Quantity: quantity})
+		p, err := common.ParseDecimal(price)
+		if err != nil {
+			p = new(big.Rat)
+		}
+		prices[price] = p
+	}
+	sort.Slice(levels, func(i, j int) bool {
+		c := prices[levels[i].Price].Cmp(prices[levels[j].Price])
+		if descending {
+			return c > 0
+		}
+		return c < 0
+	})
+	return levels
+}
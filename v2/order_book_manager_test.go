@@ -0,0 +1,100 @@
+package binance
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type orderBookManagerTestSuite struct {
+	baseTestSuite
+}
+
+func TestOrderBookManager(t *testing.T) {
+	suite.Run(t, new(orderBookManagerTestSuite))
+}
+
+func (s *orderBookManagerTestSuite) TestSyncAndApply() {
+	s.mockDo([]byte(`{
+		"lastUpdateId": 100,
+		"bids": [["0.10000000", "1.00000000"], ["0.09900000", "2.00000000"]],
+		"asks": [["0.10100000", "3.00000000"], ["0.10200000", "4.00000000"]]
+	}`), nil)
+	defer s.assertDo()
+
+	m := s.client.NewOrderBookManager("ETHBTC", 10, func(err error) { s.r().NoError(err) })
+	// already in the snapshot
+	m.handleEvent(&WsDepthEvent{FirstUpdateID: 95, LastUpdateID: 99})
+	m.handleEvent(&WsDepthEvent{
+		FirstUpdateID: 98,
+		LastUpdateID:  102,
+		Bids:          []Bid{{Price: "0.09900000", Quantity: "0.00000000"}, {Price: "0.10050000", Quantity: "5.00000000"}},
+		Asks:          []Ask{{Price: "0.10100000", Quantity: "2.50000000"}},
+	})
+	r := s.r()
+	r.Equal(int64(102), m.LastUpdateID())
+	r.Equal(&DepthResponse{
+		LastUpdateID: 102,
+		Bids:         []Bid{{Price: "0.10050000", Quantity: "5.00000000"}, {Price: "0.10000000", Quantity: "1.00000000"}},
+		Asks:         []Ask{{Price: "0.10100000", Quantity: "2.50000000"}, {Price: "0.10200000", Quantity: "4.00000000"}},
+	}, m.Depth())
+	s.client.AssertNumberOfCalls(s.T(), "do", 1)
+}
+
+func (s *orderBookManagerTestSuite) TestExportRestore() {
+	m := s.client.NewOrderBookManager("ETHBTC", 10, func(err error) { s.r().NoError(err) })
+	m.setBook(&DepthResponse{
+		LastUpdateID: 200,
+		Bids:         []Bid{{Price: "0.10000000", Quantity: "1.00000000"}},
+		Asks:         []Ask{{Price: "0.10100000", Quantity: "3.00000000"}},
+	})
+	data, err := m.Export()
+	r := s.r()
+	r.NoError(err)
+
+	restored := s.client.NewOrderBookManager("ETHBTC", 10, func(err error) { s.r().NoError(err) })
+	r.NoError(restored.Restore(data))
+	r.Equal(m.Depth(), restored.Depth())
+
+	// the next update continues the restored book without a snapshot
+	restored.handleEvent(&WsDepthEvent{
+		FirstUpdateID: 201,
+		LastUpdateID:  203,
+		Bids:          []Bid{{Price: "0.10000000", Quantity: "1.50000000"}},
+	})
+	r.Equal(int64(203), restored.LastUpdateID())
+	r.Equal("1.50000000", restored.Depth().Bids[0].Quantity)
+	s.client.AssertNotCalled(s.T(), "do", anyHTTPRequest())
+
+	other := s.client.NewOrderBookManager("BNBBTC", 10, func(error) {})
+	r.Error(other.Restore(data))
+}
+
+func (s *orderBookManagerTestSuite) TestRestoreStaleResyncs() {
+	s.mockDo([]byte(`{
+		"lastUpdateId": 500,
+		"bids": [["0.11000000", "1.00000000"]],
+		"asks": [["0.11100000", "1.00000000"]]
+	}`), nil)
+	defer s.assertDo()
+
+	m := s.client.NewOrderBookManager("ETHBTC", 10, func(err error) { s.r().NoError(err) })
+	s.r().NoError(m.Restore([]byte(`{
+		"symbol": "ETHBTC",
+		"lastUpdateId": 200,
+		"bids": [{"Price": "0.10000000", "Quantity": "1.00000000"}],
+		"asks": [{"Price": "0.10100000", "Quantity": "3.00000000"}]
+	}`)))
+	// the stream moved on while the book was persisted
+	m.handleEvent(&WsDepthEvent{
+		FirstUpdateID: 499,
+		LastUpdateID:  501,
+		Asks:          []Ask{{Price: "0.11200000", Quantity: "2.00000000"}},
+	})
+	r := s.r()
+	r.Equal(int64(501), m.LastUpdateID())
+	depth := m.Depth()
+	r.Equal([]Bid{{Price: "0.11000000", Quantity: "1.00000000"}}, depth.Bids)
+	r.Len(depth.Asks, 2)
+	s.client.AssertNumberOfCalls(s.T(), "do", 1)
+}
@@ -0,0 +1,118 @@
+package binance
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"time"
+
+	"github.com/adshao/go-binance/v2/common"
+)
+
+// BookPressure define the cumulative quantity of the top levels of both sides
+// of an order book
+type BookPressure struct {
+	Symbol       string
+	LastUpdateID int64
+	BidQuantity  string
+	AskQuantity  string
+	// Imbalance is (bid - ask) / (bid + ask) like DepthResponse.Imbalance,
+	// from -1 when only asks are quoted to 1 when only bids are, 0 for an
+	// empty book
+	Imbalance float64
+}
+
+// Pressure return the pressure of the top levels of the book, or nil if the
+// book is not synced yet. levels must be positive.
+func (m *OrderBookManager) Pressure(levels int) (*BookPressure, error) {
+	if levels <= 0 {
+		return nil, errors.New("levels must be positive")
+	}
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	if !m.synced {
+		return nil, nil
+	}
+	depth := &DepthResponse{
+		LastUpdateID: m.lastUpdateID,
+		Bids:         sortedLevels(m.bids, true),
+		Asks:         sortedLevels(m.asks, false),
+	}
+	imbalance, err := depth.Imbalance(levels)
+	if err != nil && err != ErrEmptyOrderBook {
+		return nil, err
+	}
+	bids, bidDecimals := topQuantity(depth.Bids, levels)
+	asks, askDecimals := topQuantity(depth.Asks, levels)
+	return &BookPressure{
+		Symbol:       m.symbol,
+		LastUpdateID: depth.LastUpdateID,
+		BidQuantity:  bids.FloatString(bidDecimals),
+		AskQuantity:  asks.FloatString(askDecimals),
+		Imbalance:    imbalance,
+	}, nil
+}
+
+// ServePressure serve the book like Serve, with opts, and emit its pressure over the top
+// levels every interval until ctx is done. Nothing is emitted until the book
+// is synced. The channel is closed once the stream stopped. levels and
+// interval must be positive.
+func (m *OrderBookManager) ServePressure(ctx context.Context, levels int, interval time.Duration, opts ...WsOption) (<-chan *BookPressure, error) {
+	if levels <= 0 {
+		return nil, errors.New("levels must be positive")
+	}
+	if interval <= 0 {
+		return nil, errors.New("interval must be positive")
+	}
+	doneC, stopC, err := m.Serve(opts...)
+	if err != nil {
+		return nil, err
+	}
+	pressureC := make(chan *BookPressure)
+	go func() {
+		defer close(pressureC)
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				close(stopC)
+				<-doneC
+				return
+			case <-doneC:
+				return
+			case <-ticker.C:
+				pressure, err := m.Pressure(levels)
+				if err != nil {
+					m.errHandler(err)
+					continue
+				}
+				if pressure == nil {
+					continue
+				}
+				select {
+				case pressureC <- pressure:
+				case <-ctx.Done():
+				}
+			}
+		}
+	}()
+	return pressureC, nil
+}
+
+// topQuantity return the sum of the quantities of the first n levels and the
+// number of decimals of the largest quantity precision
+func topQuantity(book []Bid, n int) (*big.Rat, int) {
+	if n < len(book) {
+		book = book[:n]
+	}
+	sum := new(big.Rat)
+	quantities := make([]string, 0, len(book))
+	for _, level := range book {
+		if q, err := common.ParseDecimal(level.Quantity); err == nil {
+			sum.Add(sum, q)
+		}
+		quantities = append(quantities, level.Quantity)
+	}
+	return sum, priceDecimals(quantities...)
+}
@@ -0,0 +1,151 @@
+package binance
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type orderBookPressureTestSuite struct {
+	baseTestSuite
+	origWsServe func(*WsConfig, WsHandler, ErrHandler) (chan struct{}, chan struct{}, error)
+}
+
+func TestOrderBookPressure(t *testing.T) {
+	suite.Run(t, new(orderBookPressureTestSuite))
+}
+
+func (s *orderBookPressureTestSuite) SetupTest() {
+	s.baseTestSuite.SetupTest()
+	s.origWsServe = wsServe
+}
+
+func (s *orderBookPressureTestSuite) TearDownTest() {
+	wsServe = s.origWsServe
+}
+
+func (s *orderBookPressureTestSuite) TestPressure() {
+	m := s.client.NewOrderBookManager("ETHBTC", 10, func(err error) { s.r().NoError(err) })
+	r := s.r()
+	pressure, err := m.Pressure(2)
+	r.NoError(err)
+	r.Nil(pressure)
+
+	m.setBook(&DepthResponse{
+		LastUpdateID: 100,
+		Bids:         []Bid{{Price: "0.10000000", Quantity: "1.00"}, {Price: "0.09900000", Quantity: "2.50"}, {Price: "0.09800000", Quantity: "7.00"}},
+		Asks:         []Ask{{Price: "0.10100000", Quantity: "0.5"}},
+	})
+	pressure, err = m.Pressure(2)
+	r.NoError(err)
+	r.Equal(&BookPressure{
+		Symbol:       "ETHBTC",
+		LastUpdateID: 100,
+		BidQuantity:  "3.50",
+		AskQuantity:  "0.5",
+		Imbalance:    0.75,
+	}, pressure)
+
+	_, err = m.Pressure(0)
+	r.Error(err)
+	_, err = m.Pressure(-1)
+	r.Error(err)
+
+	m.setBook(&DepthResponse{LastUpdateID: 101})
+	pressure, err = m.Pressure(2)
+	r.NoError(err)
+	r.Equal(0.0, pressure.Imbalance)
+}
+
+func (s *orderBookPressureTestSuite) TestServePressureInvalidArguments() {
+	served := false
+	wsServe = func(cfg *WsConfig, handler WsHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+		served = true
+		return make(chan struct{}), make(chan struct{}), nil
+	}
+
+	m := s.client.NewOrderBookManager("ETHBTC", 10, func(err error) { s.r().NoError(err) })
+	r := s.r()
+	_, err := m.ServePressure(newContext(), 0, time.Second)
+	r.Error(err)
+	_, err = m.ServePressure(newContext(), 2, 0)
+	r.Error(err)
+	r.False(served)
+}
+
+func (s *orderBookPressureTestSuite) TestServePressure() {
+	s.mockDo([]byte(`{
+		"lastUpdateId": 100,
+		"bids": [["0.10000000", "1.00000000"], ["0.09900000", "2.00000000"], ["0.09800000", "5.00000000"]],
+		"asks": [["0.10100000", "3.00000000"], ["0.10200000", "4.00000000"]]
+	}`), nil)
+	defer s.assertDo()
+
+	feed := make(chan []byte)
+	wsServe = func(cfg *WsConfig, handler WsHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+		s.r().Equal(getWsEndpoint()+"/ethbtc@depth@100ms", cfg.Endpoint)
+		doneC = make(chan struct{})
+		stopC = make(chan struct{})
+		go func() {
+			defer close(doneC)
+			for {
+				select {
+				case message := <-feed:
+					handler(message)
+				case <-stopC:
+					return
+				}
+			}
+		}()
+		return doneC, stopC, nil
+	}
+
+	ctx, cancel := context.WithCancel(newContext())
+	defer cancel()
+	m := s.client.NewOrderBookManager("ETHBTC", 10, func(err error) { s.r().NoError(err) })
+	pressureC, err := m.ServePressure(ctx, 2, 5*time.Millisecond)
+	r := s.r()
+	r.NoError(err)
+
+	waitFor := func(updateID int64) *BookPressure {
+		timeout := time.After(time.Second)
+		for {
+			select {
+			case pressure := <-pressureC:
+				if pressure.LastUpdateID == updateID {
+					return pressure
+				}
+			case <-timeout:
+				r.FailNow("pressure not received", "update %d", updateID)
+			}
+		}
+	}
+
+	feed <- []byte(`{"e":"depthUpdate","E":1,"s":"ETHBTC","U":101,"u":102,
+		"b":[["0.09900000","0.00000000"]],"a":[["0.10100000","1.00000000"]]}`)
+	r.Equal(&BookPressure{
+		Symbol:       "ETHBTC",
+		LastUpdateID: 102,
+		BidQuantity:  "6.00000000",
+		AskQuantity:  "5.00000000",
+		Imbalance:    1.0 / 11,
+	}, waitFor(102))
+
+	feed <- []byte(`{"e":"depthUpdate","E":2,"s":"ETHBTC","U":103,"u":103,
+		"b":[],"a":[["0.10050000","9.00000000"]]}`)
+	pressure := waitFor(103)
+	r.Equal("10.00000000", pressure.AskQuantity)
+	r.InDelta(-0.25, pressure.Imbalance, 1e-9)
+
+	cancel()
+	select {
+	case _, ok := <-pressureC:
+		for ok {
+			_, ok = <-pressureC
+		}
+	case <-time.After(time.Second):
+		r.FailNow("pressure channel not closed")
+	}
+}
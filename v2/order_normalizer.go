@@ -0,0 +1,107 @@
+package binance
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/adshao/go-binance/v2/common"
+)
+
+// RoundPrice round price down to the tick size of the PRICE_FILTER of symbol,
+// and check it is within the min and max price. Symbol filters are read from
+// the SymbolInfo cache of the client
+func (c *Client) RoundPrice(ctx context.Context, symbol string, price string) (string, error) {
+	s, err := c.SymbolInfo().Get(ctx, symbol)
+	if err != nil {
+		return "", err
+	}
+	p, err := roundPrice(s, price)
+	if err != nil {
+		return "", err
+	}
+	return p.FloatString(common.StepDecimals(s.PriceFilter().TickSize)), nil
+}
+
+// RoundQuantity round quantity down to the step size of the LOT_SIZE filter of
+// symbol, and check it is within the min and max quantity
+func (c *Client) RoundQuantity(ctx context.Context, symbol string, quantity string) (string, error) {
+	s, err := c.SymbolInfo().Get(ctx, symbol)
+	if err != nil {
+		return "", err
+	}
+	q, err := roundQuantity(s, quantity)
+	if err != nil {
+		return "", err
+	}
+	return q.FloatString(common.StepDecimals(s.LotSizeFilter().StepSize)), nil
+}
+
+// NormalizeOrder round price and quantity like RoundPrice and RoundQuantity,
+// then check the notional satisfies the MIN_NOTIONAL or NOTIONAL filter of
+// symbol.
+// Quantities are never rounded up, an order too small for the filters returns
+// an error instead.
+func (c *Client) NormalizeOrder(ctx context.Context, symbol string, price string, quantity string) (normPrice string, normQuantity string, err error) {
+	s, err := c.SymbolInfo().Get(ctx, symbol)
+	if err != nil {
+		return "", "", err
+	}
+	p, err := roundPrice(s, price)
+	if err != nil {
+		return "", "", err
+	}
+	q, err := roundQuantity(s, quantity)
+	if err != nil {
+		return "", "", err
+	}
+	notional := new(big.Rat).Mul(p, q)
+	if f := s.MinNotionalFilter(); f != nil {
+		if err = checkNotional(notional, f.MinNotional, "", symbol); err != nil {
+			return "", "", err
+		}
+	}
+	if f := s.NotionalFilter(); f != nil {
+		if err = checkNotional(notional, f.MinNotional, f.MaxNotional, symbol); err != nil {
+			return "", "", err
+		}
+	}
+	return p.FloatString(common.StepDecimals(s.PriceFilter().TickSize)),
+		q.FloatString(common.StepDecimals(s.LotSizeFilter().StepSize)), nil
+}
+
+// checkNotional check notional is within min and max, an empty or zero max
+// disables the max check
+func checkNotional(notional *big.Rat, min, max, symbol string) error {
+	lo, err := common.ParseDecimal(min)
+	if err != nil {
+		return err
+	}
+	if notional.Cmp(lo) < 0 {
+		return fmt.Errorf("notional %s of %s below min notional %s", notional.FloatString(8), symbol, min)
+	}
+	hi, err := common.ParseDecimal(max)
+	if err != nil {
+		return err
+	}
+	if hi.Sign() > 0 && notional.Cmp(hi) > 0 {
+		return fmt.Errorf("notional %s of %s above max notional %s", notional.FloatString(8), symbol, max)
+	}
+	return nil
+}
+
+func roundPrice(s *Symbol, price string) (*big.Rat, error) {
+	f := s.PriceFilter()
+	if f == nil {
+		return nil, fmt.Errorf("no price filter for %s", s.Symbol)
+	}
+	return common.RoundToFilter(price, f.TickSize, f.MinPrice, f.MaxPrice, "price", s.Symbol)
+}
+
+func roundQuantity(s *Symbol, quantity string) (*big.Rat, error) {
+	f := s.LotSizeFilter()
+	if f == nil {
+		return nil, fmt.Errorf("no lot size filter for %s", s.Symbol)
+	}
+	return common.RoundToFilter(quantity, f.StepSize, f.MinQuantity, f.MaxQuantity, "quantity", s.Symbol)
+}
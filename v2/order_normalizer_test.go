@@ -0,0 +1,151 @@
+package binance
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type orderNormalizerTestSuite struct {
+	baseTestSuite
+}
+
+func TestOrderNormalizer(t *testing.T) {
+	suite.Run(t, new(orderNormalizerTestSuite))
+}
+
+var normalizerExchangeInfo = []byte(`{
+	"timezone": "UTC",
+	"serverTime": 1565246363776,
+	"symbols": [
+		{
+			"symbol": "ETHBTC",
+			"status": "TRADING",
+			"baseAsset": "ETH",
+			"quoteAsset": "BTC",
+			"filters": [
+				{
+					"filterType": "PRICE_FILTER",
+					"minPrice": "0.00000100",
+					"maxPrice": "100000.00000000",
+					"tickSize": "0.00000100"
+				},
+				{
+					"filterType": "LOT_SIZE",
+					"minQty": "0.00100000",
+					"maxQty": "100000.00000000",
+					"stepSize": "0.00100000"
+				},
+				{
+					"filterType": "MIN_NOTIONAL",
+					"minNotional": "0.00010000",
+					"applyToMarket": true,
+					"avgPriceMins": 5
+				}
+			]
+		},
+		{
+			"symbol": "BTCUSDT",
+			"status": "TRADING",
+			"baseAsset": "BTC",
+			"quoteAsset": "USDT",
+			"filters": [
+				{
+					"filterType": "PRICE_FILTER",
+					"minPrice": "0.01000000",
+					"maxPrice": "1000000.00000000",
+					"tickSize": "0.01000000"
+				},
+				{
+					"filterType": "LOT_SIZE",
+					"minQty": "0.00001000",
+					"maxQty": "9000.00000000",
+					"stepSize": "0.00001000"
+				},
+				{
+					"filterType": "NOTIONAL",
+					"minNotional": "5.00000000",
+					"applyMinToMarket": true,
+					"maxNotional": "9000000.00000000",
+					"applyMaxToMarket": false,
+					"avgPriceMins": 5
+				}
+			]
+		}
+	]
+}`)
+
+func (s *orderNormalizerTestSuite) TestNormalizeOrderPriceBelowTick() {
+	s.mockDo(normalizerExchangeInfo, nil)
+	defer s.assertDo()
+
+	price, quantity, err := s.client.NormalizeOrder(newContext(), "ETHBTC", "0.0712345678", "1.23456")
+	r := s.r()
+	r.NoError(err)
+	r.Equal("0.071234", price)
+	r.Equal("1.234", quantity)
+}
+
+func (s *orderNormalizerTestSuite) TestNormalizeOrderBelowMinNotional() {
+	s.mockDo(normalizerExchangeInfo, nil)
+	defer s.assertDo()
+
+	// 0.01 * 0.0059 = 0.000059 < 0.0001
+	_, _, err := s.client.NormalizeOrder(newContext(), "ETHBTC", "0.01", "0.0059")
+	r := s.r()
+	r.Error(err)
+	r.Contains(err.Error(), "min notional")
+}
+
+func (s *orderNormalizerTestSuite) TestNormalizeOrderNotional() {
+	s.mockDo(normalizerExchangeInfo, nil)
+	defer s.assertDo()
+
+	r := s.r()
+	price, quantity, err := s.client.NormalizeOrder(newContext(), "BTCUSDT", "30000.005", "0.001")
+	r.NoError(err)
+	r.Equal("30000.00", price)
+	r.Equal("0.00100", quantity)
+	// 30000 * 0.0001 = 3 < 5
+	_, _, err = s.client.NormalizeOrder(newContext(), "BTCUSDT", "30000", "0.0001")
+	r.Error(err)
+	r.Contains(err.Error(), "below min notional")
+	// 1000000 * 9000 = 9000000000 > 9000000
+	_, _, err = s.client.NormalizeOrder(newContext(), "BTCUSDT", "1000000", "9000")
+	r.Error(err)
+	r.Contains(err.Error(), "above max notional")
+}
+
+func (s *orderNormalizerTestSuite) TestNormalizeOrderFilterViolations() {
+	s.mockDo(normalizerExchangeInfo, nil)
+	defer s.assertDo()
+
+	r := s.r()
+	_, _, err := s.client.NormalizeOrder(newContext(), "ETHBTC", "0.0000009", "1")
+	r.Error(err)
+	r.Contains(err.Error(), "min price")
+	_, _, err = s.client.NormalizeOrder(newContext(), "ETHBTC", "0.07", "0.0009")
+	r.Error(err)
+	r.Contains(err.Error(), "min quantity")
+	_, _, err = s.client.NormalizeOrder(newContext(), "ETHBTC", "0.07", "100001")
+	r.Error(err)
+	r.Contains(err.Error(), "max quantity")
+	_, _, err = s.client.NormalizeOrder(newContext(), "BNBBTC", "0.07", "1")
+	r.Error(err)
+	r.Contains(err.Error(), "not found")
+}
+
+func (s *orderNormalizerTestSuite) TestRoundPriceAndQuantityCached() {
+	// a single exchange info response serves every call within the TTL
+	s.mockDo(normalizerExchangeInfo, nil)
+	defer s.assertDo()
+
+	r := s.r()
+	price, err := s.client.RoundPrice(newContext(), "ETHBTC", "0.0700009")
+	r.NoError(err)
+	r.Equal("0.070000", price)
+	quantity, err := s.client.RoundQuantity(newContext(), "ETHBTC", "2")
+	r.NoError(err)
+	r.Equal("2.000", quantity)
+	s.client.AssertNumberOfCalls(s.T(), "do", 1)
+}
@@ -2,8 +2,16 @@ package binance
 
 import (
 	"context"
+	"crypto/rand"
 	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
 	"net/http"
+	"strings"
+	"time"
+
+	"github.com/adshao/go-binance/v2/common"
 )
 
 // CreateOrderService create order
@@ -20,6 +28,9 @@ type CreateOrderService struct {
 	newClientOrderID *string
 	stopPrice        *string
 	icebergQuantity  *string
+	trailingDelta    *int
+	dryRun           bool
+	clientOrderIDErr error
 }
 
 // Symbol set symbol
@@ -52,7 +63,8 @@ func (s *CreateOrderService) Quantity(quantity string) *CreateOrderService {
 	return s
 }
 
-// QuoteOrderQty set quoteOrderQty
+// QuoteOrderQty set quoteOrderQty, the amount of the quote asset to spend or
+// receive with a MARKET order instead of a base asset quantity
 func (s *CreateOrderService) QuoteOrderQty(quoteOrderQty string) *CreateOrderService {
 	s.quoteOrderQty = &quoteOrderQty
 	return s
@@ -67,9 +79,66 @@ func (s *CreateOrderService) Price(price string) *CreateOrderService {
 // NewClientOrderID set newClientOrderID
 func (s *CreateOrderService) NewClientOrderID(newClientOrderID string) *CreateOrderService {
 	s.newClientOrderID = &newClientOrderID
+	s.clientOrderIDErr = nil
 	return s
 }
 
+// NewClientOrderIDAuto set newClientOrderID to a generated id. The id is
+// generated once, so calling Do again to retry a failed request sends the
+// same id and lets the server reject duplicates. Failing to generate the id
+// makes Do return the error.
+func (s *CreateOrderService) NewClientOrderIDAuto() *CreateOrderService {
+	id, err := GenerateClientOrderID("")
+	if err != nil {
+		s.clientOrderIDErr = err
+		return s
+	}
+	return s.NewClientOrderID(id)
+}
+
+const (
+	// clientOrderIDMaxLen is the max length of a client order id
+	clientOrderIDMaxLen = 36
+	// clientOrderIDMinRandom is the min number of random characters of a
+	// generated client order id, the prefix is truncated to keep them
+	clientOrderIDMinRandom = 20
+	clientOrderIDAlphabet  = "0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz"
+)
+
+// randRead fill b with random bytes, replaced in tests
+var randRead = rand.Read
+
+// GenerateClientOrderID generate a random client order id starting with
+// prefix. Characters not allowed by Binance are removed from prefix, and it
+// is truncated so the id fits in 36 characters with at least 20 random ones.
+// An error is returned when the system random source fails.
+func GenerateClientOrderID(prefix string) (string, error) {
+	id := make([]byte, 0, clientOrderIDMaxLen)
+	for i := 0; i < len(prefix) && len(id) < clientOrderIDMaxLen-clientOrderIDMinRandom; i++ {
+		if isClientOrderIDChar(prefix[i]) {
+			id = append(id, prefix[i])
+		}
+	}
+	random := make([]byte, clientOrderIDMaxLen-len(id))
+	if _, err := randRead(random); err != nil {
+		return "", fmt.Errorf("generate client order id: %w", err)
+	}
+	for _, b := range random {
+		// 62 doesn't divide 256, the small bias is fine for uniqueness
+		id = append(id, clientOrderIDAlphabet[int(b)%len(clientOrderIDAlphabet)])
+	}
+	return string(id), nil
+}
+
+// isClientOrderIDChar check c matches ^[\.A-Z\:/a-z0-9_-]$
+func isClientOrderIDChar(c byte) bool {
+	switch {
+	case c >= 'a' && c <= 'z', c >= 'A' && c <= 'Z', c >= '0' && c <= '9':
+		return true
+	}
+	return strings.IndexByte(".:/_-", c) >= 0
+}
+
 // StopPrice set stopPrice
 func (s *CreateOrderService) StopPrice(stopPrice string) *CreateOrderService {
 	s.stopPrice = &stopPrice
@@ -82,13 +151,69 @@ func (s *CreateOrderService) IcebergQuantity(icebergQuantity string) *CreateOrde
 	return s
 }
 
+// IcebergQty set icebergQty, same as IcebergQuantity. Iceberg orders must be
+// LIMIT orders with GTC time in force
+func (s *CreateOrderService) IcebergQty(icebergQty string) *CreateOrderService {
+	return s.IcebergQuantity(icebergQty)
+}
+
+// TrailingDelta set trailingDelta in basis points, it makes a STOP_LOSS,
+// STOP_LOSS_LIMIT, TAKE_PROFIT or TAKE_PROFIT_LIMIT order a trailing stop
+func (s *CreateOrderService) TrailingDelta(trailingDelta int) *CreateOrderService {
+	s.trailingDelta = &trailingDelta
+	return s
+}
+
 // NewOrderRespType set icebergQuantity
 func (s *CreateOrderService) NewOrderRespType(newOrderRespType NewOrderRespType) *CreateOrderService {
 	s.newOrderRespType = &newOrderRespType
 	return s
 }
 
+// DryRun makes Do validate the order on the test endpoint without sending it to
+// the matching engine, an empty response is returned on success
+func (s *CreateOrderService) DryRun(dryRun bool) *CreateOrderService {
+	s.dryRun = dryRun
+	return s
+}
+
+// ErrMarketOrderQuantity is returned for a MARKET order which does not set
+// exactly one of quantity and quoteOrderQty
+var ErrMarketOrderQuantity = errors.New("market order requires exactly one of quantity and quoteOrderQty")
+
+// ErrIcebergOrderType is returned for an iceberg order which is not a LIMIT,
+// LIMIT_MAKER, STOP_LOSS_LIMIT or TAKE_PROFIT_LIMIT order, or has a time in
+// force other than GTC
+var ErrIcebergOrderType = errors.New("iceberg order requires a limit order type with GTC time in force")
+
+// ErrTrailingDeltaOrderType is returned for a trailing delta set on an order
+// which is not a stop loss or take profit order
+var ErrTrailingDeltaOrderType = errors.New("trailing delta requires a stop loss or take profit order type")
+
 func (s *CreateOrderService) createOrder(ctx context.Context, endpoint string, opts ...RequestOption) (data []byte, err error) {
+	if s.clientOrderIDErr != nil {
+		return []byte{}, s.clientOrderIDErr
+	}
+	if s.orderType == OrderTypeMarket && (s.quantity == nil) == (s.quoteOrderQty == nil) {
+		return []byte{}, ErrMarketOrderQuantity
+	}
+	if s.icebergQuantity != nil {
+		switch s.orderType {
+		case OrderTypeLimit, OrderTypeLimitMaker, OrderTypeStopLossLimit, OrderTypeTakeProfitLimit:
+		default:
+			return []byte{}, ErrIcebergOrderType
+		}
+		if s.timeInForce != nil && *s.timeInForce != TimeInForceTypeGTC {
+			return []byte{}, ErrIcebergOrderType
+		}
+	}
+	if s.trailingDelta != nil {
+		switch s.orderType {
+		case OrderTypeStopLoss, OrderTypeStopLossLimit, OrderTypeTakeProfit, OrderTypeTakeProfitLimit:
+		default:
+			return []byte{}, ErrTrailingDeltaOrderType
+		}
+	}
 	r := &request{
 		method:   http.MethodPost,
 		endpoint: endpoint,
@@ -120,6 +245,9 @@ func (s *CreateOrderService) createOrder(ctx context.Context, endpoint string, o
 	if s.icebergQuantity != nil {
 		m["icebergQty"] = *s.icebergQuantity
 	}
+	if s.trailingDelta != nil {
+		m["trailingDelta"] = *s.trailingDelta
+	}
 	if s.newOrderRespType != nil {
 		m["newOrderRespType"] = *s.newOrderRespType
 	}
@@ -133,6 +261,13 @@ func (s *CreateOrderService) createOrder(ctx context.Context, endpoint string, o
 
 // Do send request
 func (s *CreateOrderService) Do(ctx context.Context, opts ...RequestOption) (res *CreateOrderResponse, err error) {
+	if s.dryRun {
+		err = s.Test(ctx, opts...)
+		if err != nil {
+			return nil, err
+		}
+		return new(CreateOrderResponse), nil
+	}
 	data, err := s.createOrder(ctx, "/api/v3/order", opts...)
 	if err != nil {
 		return nil, err
@@ -378,6 +513,414 @@ type OCOOrderReport struct {
 	IcebergQuantity          string          `json:"icebergQty"`
 }
 
+// CreateOrderListOCOService create an OCO with the orderList endpoint, whose
+// legs are set independently as the order above and the order below the
+// market price. It replaces CreateOCOService.
+type CreateOrderListOCOService struct {
+	c                 *Client
+	symbol            string
+	listClientOrderID *string
+	side              SideType
+	quantity          string
+	above             orderListLeg
+	below             orderListLeg
+	newOrderRespType  *NewOrderRespType
+}
+
+// orderListLeg define the optional params of an order of an order list
+type orderListLeg struct {
+	orderType     OrderType
+	clientOrderID *string
+	price         *string
+	stopPrice     *string
+	trailingDelta *int
+	icebergQty    *string
+	timeInForce   *TimeInForceType
+}
+
+// setParams set the params of the leg, named with prefix
+func (l *orderListLeg) setParams(m params, prefix string) {
+	m[prefix+"Type"] = l.orderType
+	if l.clientOrderID != nil {
+		m[prefix+"ClientOrderId"] = *l.clientOrderID
+	}
+	if l.price != nil {
+		m[prefix+"Price"] = *l.price
+	}
+	if l.stopPrice != nil {
+		m[prefix+"StopPrice"] = *l.stopPrice
+	}
+	if l.trailingDelta != nil {
+		m[prefix+"TrailingDelta"] = *l.trailingDelta
+	}
+	if l.icebergQty != nil {
+		m[prefix+"IcebergQty"] = *l.icebergQty
+	}
+	if l.timeInForce != nil {
+		m[prefix+"TimeInForce"] = *l.timeInForce
+	}
+}
+
+// Symbol set symbol
+func (s *CreateOrderListOCOService) Symbol(symbol string) *CreateOrderListOCOService {
+	s.symbol = symbol
+	return s
+}
+
+// Side set side
+func (s *CreateOrderListOCOService) Side(side SideType) *CreateOrderListOCOService {
+	s.side = side
+	return s
+}
+
+// Quantity set quantity of both legs
+func (s *CreateOrderListOCOService) Quantity(quantity string) *CreateOrderListOCOService {
+	s.quantity = quantity
+	return s
+}
+
+// ListClientOrderID set listClientOrderID
+func (s *CreateOrderListOCOService) ListClientOrderID(listClientOrderID string) *CreateOrderListOCOService {
+	s.listClientOrderID = &listClientOrderID
+	return s
+}
+
+// AboveType set aboveType, STOP_LOSS_LIMIT, STOP_LOSS, LIMIT_MAKER, TAKE_PROFIT or TAKE_PROFIT_LIMIT
+func (s *CreateOrderListOCOService) AboveType(orderType OrderType) *CreateOrderListOCOService {
+	s.above.orderType = orderType
+	return s
+}
+
+// AboveClientOrderID set aboveClientOrderId
+func (s *CreateOrderListOCOService) AboveClientOrderID(clientOrderID string) *CreateOrderListOCOService {
+	s.above.clientOrderID = &clientOrderID
+	return s
+}
+
+// AbovePrice set abovePrice
+func (s *CreateOrderListOCOService) AbovePrice(price string) *CreateOrderListOCOService {
+	s.above.price = &price
+	return s
+}
+
+// AboveStopPrice set aboveStopPrice
+func (s *CreateOrderListOCOService) AboveStopPrice(stopPrice string) *CreateOrderListOCOService {
+	s.above.stopPrice = &stopPrice
+	return s
+}
+
+// AboveTrailingDelta set aboveTrailingDelta in basis points
+func (s *CreateOrderListOCOService) AboveTrailingDelta(trailingDelta int) *CreateOrderListOCOService {
+	s.above.trailingDelta = &trailingDelta
+	return s
+}
+
+// AboveIcebergQuantity set aboveIcebergQty
+func (s *CreateOrderListOCOService) AboveIcebergQuantity(icebergQty string) *CreateOrderListOCOService {
+	s.above.icebergQty = &icebergQty
+	return s
+}
+
+// AboveTimeInForce set aboveTimeInForce
+func (s *CreateOrderListOCOService) AboveTimeInForce(timeInForce TimeInForceType) *CreateOrderListOCOService {
+	s.above.timeInForce = &timeInForce
+	return s
+}
+
+// BelowType set belowType, STOP_LOSS_LIMIT, STOP_LOSS, LIMIT_MAKER, TAKE_PROFIT or TAKE_PROFIT_LIMIT
+func (s *CreateOrderListOCOService) BelowType(orderType OrderType) *CreateOrderListOCOService {
+	s.below.orderType = orderType
+	return s
+}
+
+// BelowClientOrderID set belowClientOrderId
+func (s *CreateOrderListOCOService) BelowClientOrderID(clientOrderID string) *CreateOrderListOCOService {
+	s.below.clientOrderID = &clientOrderID
+	return s
+}
+
+// BelowPrice set belowPrice
+func (s *CreateOrderListOCOService) BelowPrice(price string) *CreateOrderListOCOService {
+	s.below.price = &price
+	return s
+}
+
+// BelowStopPrice set belowStopPrice
+func (s *CreateOrderListOCOService) BelowStopPrice(stopPrice string) *CreateOrderListOCOService {
+	s.below.stopPrice = &stopPrice
+	return s
+}
+
+// BelowTrailingDelta set belowTrailingDelta in basis points
+func (s *CreateOrderListOCOService) BelowTrailingDelta(trailingDelta int) *CreateOrderListOCOService {
+	s.below.trailingDelta = &trailingDelta
+	return s
+}
+
+// BelowIcebergQuantity set belowIcebergQty
+func (s *CreateOrderListOCOService) BelowIcebergQuantity(icebergQty string) *CreateOrderListOCOService {
+	s.below.icebergQty = &icebergQty
+	return s
+}
+
+// BelowTimeInForce set belowTimeInForce
+func (s *CreateOrderListOCOService) BelowTimeInForce(timeInForce TimeInForceType) *CreateOrderListOCOService {
+	s.below.timeInForce = &timeInForce
+	return s
+}
+
+// NewOrderRespType set newOrderRespType
+func (s *CreateOrderListOCOService) NewOrderRespType(newOrderRespType NewOrderRespType) *CreateOrderListOCOService {
+	s.newOrderRespType = &newOrderRespType
+	return s
+}
+
+// Do send request
+func (s *CreateOrderListOCOService) Do(ctx context.Context, opts ...RequestOption) (res *CreateOCOResponse, err error) {
+	r := &request{
+		method:   http.MethodPost,
+		endpoint: "/api/v3/orderList/oco",
+		secType:  secTypeSigned,
+	}
+	m := params{
+		"symbol":   s.symbol,
+		"side":     s.side,
+		"quantity": s.quantity,
+	}
+	s.above.setParams(m, "above")
+	s.below.setParams(m, "below")
+	if s.listClientOrderID != nil {
+		m["listClientOrderId"] = *s.listClientOrderID
+	}
+	if s.newOrderRespType != nil {
+		m["newOrderRespType"] = *s.newOrderRespType
+	}
+	r.setFormParams(m)
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(CreateOCOResponse)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// CreateOTOCOService create an OTOCO order list, a working order which, once
+// filled, places a pending OCO made of an order above and an order below the
+// market price.
+type CreateOTOCOService struct {
+	c                 *Client
+	symbol            string
+	listClientOrderID *string
+	workingSide       SideType
+	workingQuantity   string
+	working           orderListLeg
+	pendingSide       SideType
+	pendingQuantity   string
+	pendingAbove      orderListLeg
+	pendingBelow      orderListLeg
+	newOrderRespType  *NewOrderRespType
+}
+
+// CreateOTOCOResponse define create OTOCO response, holding the working order
+// followed by the two pending orders
+type CreateOTOCOResponse = CreateOCOResponse
+
+// Symbol set symbol
+func (s *CreateOTOCOService) Symbol(symbol string) *CreateOTOCOService {
+	s.symbol = symbol
+	return s
+}
+
+// ListClientOrderID set listClientOrderID
+func (s *CreateOTOCOService) ListClientOrderID(listClientOrderID string) *CreateOTOCOService {
+	s.listClientOrderID = &listClientOrderID
+	return s
+}
+
+// WorkingType set workingType, LIMIT or LIMIT_MAKER
+func (s *CreateOTOCOService) WorkingType(orderType OrderType) *CreateOTOCOService {
+	s.working.orderType = orderType
+	return s
+}
+
+// WorkingSide set workingSide
+func (s *CreateOTOCOService) WorkingSide(side SideType) *CreateOTOCOService {
+	s.workingSide = side
+	return s
+}
+
+// WorkingClientOrderID set workingClientOrderId
+func (s *CreateOTOCOService) WorkingClientOrderID(clientOrderID string) *CreateOTOCOService {
+	s.working.clientOrderID = &clientOrderID
+	return s
+}
+
+// WorkingPrice set workingPrice
+func (s *CreateOTOCOService) WorkingPrice(price string) *CreateOTOCOService {
+	s.working.price = &price
+	return s
+}
+
+// WorkingQuantity set workingQuantity
+func (s *CreateOTOCOService) WorkingQuantity(quantity string) *CreateOTOCOService {
+	s.workingQuantity = quantity
+	return s
+}
+
+// WorkingIcebergQuantity set workingIcebergQty
+func (s *CreateOTOCOService) WorkingIcebergQuantity(icebergQty string) *CreateOTOCOService {
+	s.working.icebergQty = &icebergQty
+	return s
+}
+
+// WorkingTimeInForce set workingTimeInForce
+func (s *CreateOTOCOService) WorkingTimeInForce(timeInForce TimeInForceType) *CreateOTOCOService {
+	s.working.timeInForce = &timeInForce
+	return s
+}
+
+// PendingSide set pendingSide
+func (s *CreateOTOCOService) PendingSide(side SideType) *CreateOTOCOService {
+	s.pendingSide = side
+	return s
+}
+
+// PendingQuantity set pendingQuantity of both pending orders
+func (s *CreateOTOCOService) PendingQuantity(quantity string) *CreateOTOCOService {
+	s.pendingQuantity = quantity
+	return s
+}
+
+// PendingAboveType set pendingAboveType, LIMIT_MAKER, STOP_LOSS or STOP_LOSS_LIMIT
+func (s *CreateOTOCOService) PendingAboveType(orderType OrderType) *CreateOTOCOService {
+	s.pendingAbove.orderType = orderType
+	return s
+}
+
+// PendingAboveClientOrderID set pendingAboveClientOrderId
+func (s *CreateOTOCOService) PendingAboveClientOrderID(clientOrderID string) *CreateOTOCOService {
+	s.pendingAbove.clientOrderID = &clientOrderID
+	return s
+}
+
+// PendingAbovePrice set pendingAbovePrice
+func (s *CreateOTOCOService) PendingAbovePrice(price string) *CreateOTOCOService {
+	s.pendingAbove.price = &price
+	return s
+}
+
+// PendingAboveStopPrice set pendingAboveStopPrice
+func (s *CreateOTOCOService) PendingAboveStopPrice(stopPrice string) *CreateOTOCOService {
+	s.pendingAbove.stopPrice = &stopPrice
+	return s
+}
+
+// PendingAboveTrailingDelta set pendingAboveTrailingDelta in basis points
+func (s *CreateOTOCOService) PendingAboveTrailingDelta(trailingDelta int) *CreateOTOCOService {
+	s.pendingAbove.trailingDelta = &trailingDelta
+	return s
+}
+
+// PendingAboveIcebergQuantity set pendingAboveIcebergQty
+func (s *CreateOTOCOService) PendingAboveIcebergQuantity(icebergQty string) *CreateOTOCOService {
+	s.pendingAbove.icebergQty = &icebergQty
+	return s
+}
+
+// PendingAboveTimeInForce set pendingAboveTimeInForce
+func (s *CreateOTOCOService) PendingAboveTimeInForce(timeInForce TimeInForceType) *CreateOTOCOService {
+	s.pendingAbove.timeInForce = &timeInForce
+	return s
+}
+
+// PendingBelowType set pendingBelowType, LIMIT_MAKER, STOP_LOSS or STOP_LOSS_LIMIT
+func (s *CreateOTOCOService) PendingBelowType(orderType OrderType) *CreateOTOCOService {
+	s.pendingBelow.orderType = orderType
+	return s
+}
+
+// PendingBelowClientOrderID set pendingBelowClientOrderId
+func (s *CreateOTOCOService) PendingBelowClientOrderID(clientOrderID string) *CreateOTOCOService {
+	s.pendingBelow.clientOrderID = &clientOrderID
+	return s
+}
+
+// PendingBelowPrice set pendingBelowPrice
+func (s *CreateOTOCOService) PendingBelowPrice(price string) *CreateOTOCOService {
+	s.pendingBelow.price = &price
+	return s
+}
+
+// PendingBelowStopPrice set pendingBelowStopPrice
+func (s *CreateOTOCOService) PendingBelowStopPrice(stopPrice string) *CreateOTOCOService {
+	s.pendingBelow.stopPrice = &stopPrice
+	return s
+}
+
+// PendingBelowTrailingDelta set pendingBelowTrailingDelta in basis points
+func (s *CreateOTOCOService) PendingBelowTrailingDelta(trailingDelta int) *CreateOTOCOService {
+	s.pendingBelow.trailingDelta = &trailingDelta
+	return s
+}
+
+// PendingBelowIcebergQuantity set pendingBelowIcebergQty
+func (s *CreateOTOCOService) PendingBelowIcebergQuantity(icebergQty string) *CreateOTOCOService {
+	s.pendingBelow.icebergQty = &icebergQty
+	return s
+}
+
+// PendingBelowTimeInForce set pendingBelowTimeInForce
+func (s *CreateOTOCOService) PendingBelowTimeInForce(timeInForce TimeInForceType) *CreateOTOCOService {
+	s.pendingBelow.timeInForce = &timeInForce
+	return s
+}
+
+// NewOrderRespType set newOrderRespType
+func (s *CreateOTOCOService) NewOrderRespType(newOrderRespType NewOrderRespType) *CreateOTOCOService {
+	s.newOrderRespType = &newOrderRespType
+	return s
+}
+
+// Do send request
+func (s *CreateOTOCOService) Do(ctx context.Context, opts ...RequestOption) (res *CreateOTOCOResponse, err error) {
+	r := &request{
+		method:   http.MethodPost,
+		endpoint: "/api/v3/orderList/otoco",
+		secType:  secTypeSigned,
+	}
+	m := params{
+		"symbol":          s.symbol,
+		"workingSide":     s.workingSide,
+		"workingQuantity": s.workingQuantity,
+		"pendingSide":     s.pendingSide,
+		"pendingQuantity": s.pendingQuantity,
+	}
+	s.working.setParams(m, "working")
+	s.pendingAbove.setParams(m, "pendingAbove")
+	s.pendingBelow.setParams(m, "pendingBelow")
+	if s.listClientOrderID != nil {
+		m["listClientOrderId"] = *s.listClientOrderID
+	}
+	if s.newOrderRespType != nil {
+		m["newOrderRespType"] = *s.newOrderRespType
+	}
+	r.setFormParams(m)
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(CreateOTOCOResponse)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
 // ListOpenOcoService list opened oco
 type ListOpenOcoService struct {
 	c *Client
@@ -416,13 +959,24 @@ func (s *ListOpenOcoService) Do(ctx context.Context, opts ...RequestOption) (res
 
 // ListOpenOrdersService list opened orders
 type ListOpenOrdersService struct {
-	c      *Client
-	symbol string
+	c          *Client
+	symbol     string
+	allSymbols bool
 }
 
 // Symbol set symbol
 func (s *ListOpenOrdersService) Symbol(symbol string) *ListOpenOrdersService {
 	s.symbol = symbol
+	s.allSymbols = false
+	return s
+}
+
+// AllSymbols list the open orders of every symbol, which costs a much higher
+// request weight than a single symbol. Without a symbol Do lists all symbols
+// too, but logs a warning unless AllSymbols is set
+func (s *ListOpenOrdersService) AllSymbols() *ListOpenOrdersService {
+	s.symbol = ""
+	s.allSymbols = true
 	return s
 }
 
@@ -435,6 +989,8 @@ func (s *ListOpenOrdersService) Do(ctx context.Context, opts ...RequestOption) (
 	}
 	if s.symbol != "" {
 		r.setParam("symbol", s.symbol)
+	} else if !s.allSymbols && s.c.Logger != nil {
+		s.c.Logger.Printf("warning: listing open orders without a symbol costs the weight of all symbols, call AllSymbols to do it on purpose")
 	}
 	data, err := s.c.callAPI(ctx, r, opts...)
 	if err != nil {
@@ -474,8 +1030,16 @@ func (s *GetOrderService) OrigClientOrderID(origClientOrderID string) *GetOrderS
 	return s
 }
 
-// Do send request
+// ErrOrderIdentifierRequired is returned when neither orderId nor
+// origClientOrderId is set to identify an order
+var ErrOrderIdentifierRequired = errors.New("either orderId or origClientOrderId must be set")
+
+// Do send request, either OrderID or OrigClientOrderID must be set. When both
+// are set, the order is looked up by orderId
 func (s *GetOrderService) Do(ctx context.Context, opts ...RequestOption) (res *Order, err error) {
+	if s.orderID == nil && s.origClientOrderID == nil {
+		return nil, ErrOrderIdentifierRequired
+	}
 	r := &request{
 		method:   http.MethodGet,
 		endpoint: "/api/v3/order",
@@ -502,25 +1066,140 @@ func (s *GetOrderService) Do(ctx context.Context, opts ...RequestOption) (res *O
 
 // Order define order info
 type Order struct {
-	Symbol                   string          `json:"symbol"`
-	OrderID                  int64           `json:"orderId"`
-	OrderListId              int64           `json:"orderListId"`
-	ClientOrderID            string          `json:"clientOrderId"`
-	Price                    string          `json:"price"`
-	OrigQuantity             string          `json:"origQty"`
-	ExecutedQuantity         string          `json:"executedQty"`
-	CummulativeQuoteQuantity string          `json:"cummulativeQuoteQty"`
-	Status                   OrderStatusType `json:"status"`
-	TimeInForce              TimeInForceType `json:"timeInForce"`
-	Type                     OrderType       `json:"type"`
-	Side                     SideType        `json:"side"`
-	StopPrice                string          `json:"stopPrice"`
-	IcebergQuantity          string          `json:"icebergQty"`
-	Time                     int64           `json:"time"`
-	UpdateTime               int64           `json:"updateTime"`
-	IsWorking                bool            `json:"isWorking"`
-	IsIsolated               bool            `json:"isIsolated"`
-	OrigQuoteOrderQuantity   string          `json:"origQuoteOrderQty"`
+	Symbol                   string                  `json:"symbol"`
+	OrderID                  int64                   `json:"orderId"`
+	OrderListId              int64                   `json:"orderListId"`
+	ClientOrderID            string                  `json:"clientOrderId"`
+	Price                    string                  `json:"price"`
+	OrigQuantity             string                  `json:"origQty"`
+	ExecutedQuantity         string                  `json:"executedQty"`
+	CummulativeQuoteQuantity string                  `json:"cummulativeQuoteQty"`
+	Status                   OrderStatusType         `json:"status"`
+	TimeInForce              TimeInForceType         `json:"timeInForce"`
+	Type                     OrderType               `json:"type"`
+	Side                     SideType                `json:"side"`
+	StopPrice                string                  `json:"stopPrice"`
+	IcebergQuantity          string                  `json:"icebergQty"`
+	Time                     int64                   `json:"time"`
+	UpdateTime               int64                   `json:"updateTime"`
+	IsWorking                bool                    `json:"isWorking"`
+	IsIsolated               bool                    `json:"isIsolated"`
+	OrigQuoteOrderQuantity   string                  `json:"origQuoteOrderQty"`
+	WorkingTime              int64                   `json:"workingTime"`
+	SelfTradePreventionMode  SelfTradePreventionMode `json:"selfTradePreventionMode"`
+}
+
+// IsFinal reports whether the order reached a terminal status
+func (o *Order) IsFinal() bool {
+	switch o.Status {
+	case OrderStatusTypeFilled, OrderStatusTypeCanceled,
+		OrderStatusTypeRejected, OrderStatusTypeExpired:
+		return true
+	}
+	return false
+}
+
+// ErrCodeOrderNotFound is the code of the *common.APIError returned for an
+// order that does not exist, or is not visible yet
+const ErrCodeOrderNotFound int64 = -2013
+
+// WaitForOrder polls the order every pollInterval until it reaches a final
+// status, which is returned. An order not found yet is polled again with the
+// interval doubled up to 8 times pollInterval. Polling stops with an error when
+// ctx is done. pollInterval must be positive.
+func (c *Client) WaitForOrder(ctx context.Context, symbol string, orderID int64, pollInterval time.Duration) (*Order, error) {
+	if pollInterval <= 0 {
+		return nil, errors.New("poll interval must be positive")
+	}
+	var last *Order
+	delay := pollInterval
+	for attempt := 0; ; attempt++ {
+		if attempt > 0 {
+			select {
+			case <-ctx.Done():
+				return last, ctx.Err()
+			case <-time.After(delay):
+			}
+		}
+		order, err := c.NewGetOrderService().Symbol(symbol).OrderID(orderID).Do(ctx)
+		if err != nil {
+			if apiErr, ok := err.(*common.APIError); ok && apiErr.Code == ErrCodeOrderNotFound {
+				if delay < 8*pollInterval {
+					delay *= 2
+				}
+				continue
+			}
+			return last, err
+		}
+		delay = pollInterval
+		last = order
+		if order.IsFinal() {
+			return order, nil
+		}
+	}
+}
+
+// placeOrderIdempotentAttempts is the max number of times PlaceOrderIdempotent
+// sends the order
+var placeOrderIdempotentAttempts = 3
+
+// PlaceOrderIdempotent create the order of service, retrying on network errors
+// without placing it twice. A client order id is generated unless already set.
+// After a timed out or failed attempt the order is queried by its client order
+// id, and only sent again when it was not placed. An order found by the query is
+// returned without fills.
+func (c *Client) PlaceOrderIdempotent(ctx context.Context, service *CreateOrderService, opts ...RequestOption) (*CreateOrderResponse, error) {
+	if service.newClientOrderID == nil {
+		if service.NewClientOrderIDAuto().clientOrderIDErr != nil {
+			return nil, service.clientOrderIDErr
+		}
+	}
+	clientOrderID := *service.newClientOrderID
+	var err error
+	for attempt := 0; attempt < placeOrderIdempotentAttempts; attempt++ {
+		var res *CreateOrderResponse
+		res, err = service.Do(ctx, opts...)
+		if err == nil || !isRetryableOrderError(ctx, err) {
+			return res, err
+		}
+		order, queryErr := c.NewGetOrderService().Symbol(service.symbol).
+			OrigClientOrderID(clientOrderID).Do(ctx, opts...)
+		if queryErr != nil {
+			if apiErr, ok := queryErr.(*common.APIError); ok && apiErr.Code == ErrCodeOrderNotFound {
+				continue
+			}
+			return nil, fmt.Errorf("order %s may have been placed: %v, lookup failed: %w", clientOrderID, err, queryErr)
+		}
+		return &CreateOrderResponse{
+			Symbol:                   order.Symbol,
+			OrderID:                  order.OrderID,
+			ClientOrderID:            order.ClientOrderID,
+			TransactTime:             order.Time,
+			Price:                    order.Price,
+			OrigQuantity:             order.OrigQuantity,
+			ExecutedQuantity:         order.ExecutedQuantity,
+			CummulativeQuoteQuantity: order.CummulativeQuoteQuantity,
+			IsIsolated:               order.IsIsolated,
+			Status:                   order.Status,
+			TimeInForce:              order.TimeInForce,
+			Type:                     order.Type,
+			Side:                     order.Side,
+		}, nil
+	}
+	return nil, err
+}
+
+// isRetryableOrderError check err is a network error of a request which may or
+// may not have reached the server, and ctx allows to retry
+func isRetryableOrderError(ctx context.Context, err error) bool {
+	if ctx.Err() != nil || common.IsAPIError(err) {
+		return false
+	}
+	if errors.Is(err, common.ErrRequestTimeout) {
+		return true
+	}
+	var netErr net.Error
+	return errors.As(err, &netErr)
 }
 
 // ListOrdersService all account orders; active, canceled, or filled
@@ -551,12 +1230,22 @@ func (s *ListOrdersService) StartTime(startTime int64) *ListOrdersService {
 	return s
 }
 
+// StartTimeFrom set startTime from t, converted to epoch milliseconds
+func (s *ListOrdersService) StartTimeFrom(t time.Time) *ListOrdersService {
+	return s.StartTime(FormatTimestamp(t))
+}
+
 // EndTime set endtime
 func (s *ListOrdersService) EndTime(endTime int64) *ListOrdersService {
 	s.endTime = &endTime
 	return s
 }
 
+// EndTimeFrom set endTime from t, converted to epoch milliseconds
+func (s *ListOrdersService) EndTimeFrom(t time.Time) *ListOrdersService {
+	return s.EndTime(FormatTimestamp(t))
+}
+
 // Limit set limit
 func (s *ListOrdersService) Limit(limit int) *ListOrdersService {
 	s.limit = &limit
@@ -595,6 +1284,42 @@ func (s *ListOrdersService) Do(ctx context.Context, opts ...RequestOption) (res
 	return res, nil
 }
 
+// Iterate walk the order history of the symbol from /api/v3/allOrders,
+// calling fn for every order, open, canceled or filled, in ascending order
+// id. allOrders returns up to 1000 orders per call: the limit defaults to and
+// is capped at 1000, and a limit <= 0 is an error. The walk continues at
+// orderId = last order id + 1, which returns the orders from that id on, so
+// startTime and endTime only bound the first call and an order placed after
+// endTime ends the walk. An error returned by fn stops the walk and is
+// returned.
+func (s *ListOrdersService) Iterate(ctx context.Context, fn func(*Order) error, opts ...RequestOption) error {
+	limit, err := common.PageLimit(s.limit, 1000)
+	if err != nil {
+		return err
+	}
+	page := *s
+	page.limit = &limit
+	return common.PageForward(limit, func(orderID *int64) (int, int64, bool, error) {
+		if orderID != nil {
+			// orderId pages from the given id, the time window would restrict it
+			page.orderID, page.startTime, page.endTime = orderID, nil, nil
+		}
+		orders, err := page.Do(ctx, opts...)
+		if err != nil || len(orders) == 0 {
+			return 0, 0, false, err
+		}
+		for _, o := range orders {
+			if s.endTime != nil && o.Time > *s.endTime {
+				return 0, 0, true, nil
+			}
+			if err = fn(o); err != nil {
+				return 0, 0, false, err
+			}
+		}
+		return len(orders), orders[len(orders)-1].OrderID, false, nil
+	})
+}
+
 // CancelOrderService cancel an order
 type CancelOrderService struct {
 	c                 *Client
@@ -750,12 +1475,19 @@ func (s *CancelOpenOrdersService) Do(ctx context.Context, opts ...RequestOption)
 	}
 	cancelOpenOrdersResponse := new(CancelOpenOrdersResponse)
 	for _, j := range rawMessages {
-		o := new(CancelOrderResponse)
-		if err := json.Unmarshal(*j, o); err != nil {
+		// Only order lists have a contingency type, plain orders may omit
+		// orderListId
+		kind := struct {
+			ContingencyType string `json:"contingencyType"`
+		}{}
+		if err := json.Unmarshal(*j, &kind); err != nil {
 			return &CancelOpenOrdersResponse{}, err
 		}
-		// Non-OCO orders guaranteed to have order list ID of -1
-		if o.OrderListID == -1 {
+		if kind.ContingencyType == "" {
+			o := new(CancelOrderResponse)
+			if err := json.Unmarshal(*j, o); err != nil {
+				return &CancelOpenOrdersResponse{}, err
+			}
 			cancelOpenOrdersResponse.Orders = append(cancelOpenOrdersResponse.Orders, o)
 			continue
 		}
@@ -768,6 +1500,47 @@ func (s *CancelOpenOrdersService) Do(ctx context.Context, opts ...RequestOption)
 	return cancelOpenOrdersResponse, nil
 }
 
+// ErrCodeUnknownOrder is the code of the *common.APIError returned when
+// canceling orders of a symbol without open orders
+const ErrCodeUnknownOrder int64 = -2011
+
+// cancelAcrossSymbolsInterval is the delay between the cancel requests of
+// CancelAllAcrossSymbols
+var cancelAcrossSymbolsInterval = 100 * time.Millisecond
+
+// CancelSymbolResult define the result of canceling the open orders of a symbol
+type CancelSymbolResult struct {
+	Response *CancelOpenOrdersResponse
+	Err      error
+}
+
+// CancelAllAcrossSymbols cancel the open orders of every symbol, one request
+// at a time and paced to stay within the request rate limits. It continues
+// after errors and returns the result of every symbol, a symbol without open
+// orders has an empty response. Symbols not reached before ctx is done have
+// the error of ctx.
+func (c *Client) CancelAllAcrossSymbols(ctx context.Context, symbols []string) map[string]*CancelSymbolResult {
+	results := make(map[string]*CancelSymbolResult, len(symbols))
+	for i, symbol := range symbols {
+		if i > 0 {
+			select {
+			case <-ctx.Done():
+			case <-time.After(cancelAcrossSymbolsInterval):
+			}
+		}
+		if err := ctx.Err(); err != nil {
+			results[symbol] = &CancelSymbolResult{Err: err}
+			continue
+		}
+		res, err := c.NewCancelOpenOrdersService().Symbol(symbol).Do(ctx)
+		if apiErr, ok := err.(*common.APIError); ok && apiErr.Code == ErrCodeUnknownOrder {
+			res, err = &CancelOpenOrdersResponse{}, nil
+		}
+		results[symbol] = &CancelSymbolResult{Response: res, Err: err}
+	}
+	return results
+}
+
 // CancelOpenOrdersResponse defines cancel open orders response.
 type CancelOpenOrdersResponse struct {
 	Orders    []*CancelOrderResponse
@@ -1,8 +1,19 @@
 package binance
 
 import (
+	"bytes"
+	"context"
+	"errors"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
 	"testing"
+	"time"
 
+	"github.com/adshao/go-binance/v2/common"
 	"github.com/stretchr/testify/suite"
 )
 
@@ -82,6 +93,144 @@ func (s *orderServiceTestSuite) TestCreateOrder() {
 	s.r().NoError(err)
 }
 
+func (s *orderServiceTestSuite) TestCreateMarketOrderQuoteOrderQty() {
+	data := []byte(`{
+		"symbol": "BTCUSDT",
+		"orderId": 2,
+		"clientOrderId": "myOrder2",
+		"transactTime": 1499827319559,
+		"origQty": "0.002",
+		"executedQty": "0.002",
+		"cummulativeQuoteQty": "100.00",
+		"status": "FILLED",
+		"type": "MARKET",
+		"side": "BUY"
+	}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setFormParams(params{
+			"symbol":        "BTCUSDT",
+			"side":          SideTypeBuy,
+			"type":          OrderTypeMarket,
+			"quoteOrderQty": "100.00",
+		})
+		s.assertRequestEqual(e, r)
+	})
+	res, err := s.client.NewCreateOrderService().Symbol("BTCUSDT").Side(SideTypeBuy).
+		Type(OrderTypeMarket).QuoteOrderQty("100.00").Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	r.Equal("100.00", res.CummulativeQuoteQuantity)
+	r.Equal("0.002", res.ExecutedQuantity)
+}
+
+func (s *orderServiceTestSuite) TestCreateMarketOrderQuantityValidation() {
+	r := s.r()
+	_, err := s.client.NewCreateOrderService().Symbol("BTCUSDT").Side(SideTypeBuy).
+		Type(OrderTypeMarket).Quantity("0.002").QuoteOrderQty("100.00").Do(newContext())
+	r.Equal(ErrMarketOrderQuantity, err)
+	err = s.client.NewCreateOrderService().Symbol("BTCUSDT").Side(SideTypeBuy).
+		Type(OrderTypeMarket).Test(newContext())
+	r.Equal(ErrMarketOrderQuantity, err)
+	s.client.AssertNotCalled(s.T(), "do", anyHTTPRequest())
+}
+
+func (s *orderServiceTestSuite) TestCreateIcebergOrder() {
+	s.mockDo([]byte(`{"symbol": "LTCBTC", "orderId": 3, "status": "NEW", "type": "LIMIT", "side": "SELL"}`), nil)
+	defer s.assertDo()
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setFormParams(params{
+			"symbol":      "LTCBTC",
+			"side":        SideTypeSell,
+			"type":        OrderTypeLimit,
+			"timeInForce": TimeInForceTypeGTC,
+			"quantity":    "10.00",
+			"price":       "0.0001",
+			"icebergQty":  "1.00",
+		})
+		s.assertRequestEqual(e, r)
+	})
+	res, err := s.client.NewCreateOrderService().Symbol("LTCBTC").Side(SideTypeSell).
+		Type(OrderTypeLimit).TimeInForce(TimeInForceTypeGTC).Quantity("10.00").
+		Price("0.0001").IcebergQty("1.00").Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	r.Equal(int64(3), res.OrderID)
+
+	_, err = s.client.NewCreateOrderService().Symbol("LTCBTC").Side(SideTypeSell).
+		Type(OrderTypeLimit).TimeInForce(TimeInForceTypeIOC).Quantity("10.00").
+		Price("0.0001").IcebergQty("1.00").Do(newContext())
+	r.Equal(ErrIcebergOrderType, err)
+	_, err = s.client.NewCreateOrderService().Symbol("LTCBTC").Side(SideTypeSell).
+		Type(OrderTypeMarket).Quantity("10.00").IcebergQty("1.00").Do(newContext())
+	r.Equal(ErrIcebergOrderType, err)
+}
+
+func (s *orderServiceTestSuite) TestCreateIcebergLimitMakerOrder() {
+	s.mockDo([]byte(`{"symbol": "LTCBTC", "orderId": 5, "status": "NEW", "type": "LIMIT_MAKER", "side": "SELL"}`), nil)
+	defer s.assertDo()
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setFormParams(params{
+			"symbol":     "LTCBTC",
+			"side":       SideTypeSell,
+			"type":       OrderTypeLimitMaker,
+			"quantity":   "10.00",
+			"price":      "0.0001",
+			"icebergQty": "1.00",
+		})
+		s.assertRequestEqual(e, r)
+	})
+	res, err := s.client.NewCreateOrderService().Symbol("LTCBTC").Side(SideTypeSell).
+		Type(OrderTypeLimitMaker).Quantity("10.00").Price("0.0001").IcebergQty("1.00").
+		Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	r.Equal(int64(5), res.OrderID)
+}
+
+func (s *orderServiceTestSuite) TestCreateTrailingStopOrder() {
+	s.mockDo([]byte(`{"symbol": "BTCUSDT", "orderId": 4, "status": "NEW", "type": "STOP_LOSS_LIMIT", "side": "SELL"}`), nil)
+	defer s.assertDo()
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setFormParams(params{
+			"symbol":        "BTCUSDT",
+			"side":          SideTypeSell,
+			"type":          OrderTypeStopLossLimit,
+			"timeInForce":   TimeInForceTypeGTC,
+			"quantity":      "0.01",
+			"price":         "29000",
+			"trailingDelta": 200,
+		})
+		s.assertRequestEqual(e, r)
+	})
+	res, err := s.client.NewCreateOrderService().Symbol("BTCUSDT").Side(SideTypeSell).
+		Type(OrderTypeStopLossLimit).TimeInForce(TimeInForceTypeGTC).Quantity("0.01").
+		Price("29000").TrailingDelta(200).Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	r.Equal(OrderTypeStopLossLimit, res.Type)
+
+	_, err = s.client.NewCreateOrderService().Symbol("BTCUSDT").Side(SideTypeSell).
+		Type(OrderTypeLimit).TimeInForce(TimeInForceTypeGTC).Quantity("0.01").
+		Price("29000").TrailingDelta(200).Do(newContext())
+	r.Equal(ErrTrailingDeltaOrderType, err)
+}
+
+func (s *orderServiceTestSuite) TestCreateOrderDryRun() {
+	var path string
+	s.client.Client.do = func(req *http.Request) (*http.Response, error) {
+		path = req.URL.Path
+		return newHTTPResponse([]byte(`{}`), http.StatusOK), nil
+	}
+	res, err := s.client.NewCreateOrderService().Symbol("LTCBTC").Side(SideTypeBuy).
+		Type(OrderTypeMarket).Quantity("12.00").DryRun(true).Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	r.Equal("/api/v3/order/test", path)
+	r.Equal(&CreateOrderResponse{}, res)
+}
+
 func (s *orderServiceTestSuite) TestCreateOrderFull() {
 	data := []byte(`{
 		"symbol": "LTCBTC",
@@ -344,6 +493,361 @@ func (s *orderServiceTestSuite) TestCreateOCO() {
 	s.assertCreateOCOResponseEqual(e, res)
 }
 
+func (s *orderServiceTestSuite) TestCreateOrderListOCO() {
+	data := []byte(`{
+		"orderListId": 1,
+		"contingencyType": "OCO",
+		"listStatusType": "EXEC_STARTED",
+		"listOrderStatus": "EXECUTING",
+		"listClientOrderId": "lH1YDkuQKWiXVXHPSKYEIp",
+		"transactionTime": 1710485608839,
+		"symbol": "LTCBTC",
+		"orders": [
+		  {
+			"symbol": "LTCBTC",
+			"orderId": 10,
+			"clientOrderId": "44nZvqpemY7sVYgPYbvPih"
+		  },
+		  {
+			"symbol": "LTCBTC",
+			"orderId": 11,
+			"clientOrderId": "NuMp0nVYnciDiFmVqfpBqK"
+		  }
+		],
+		"orderReports": [
+		  {
+			"symbol": "LTCBTC",
+			"orderId": 10,
+			"orderListId": 1,
+			"clientOrderId": "44nZvqpemY7sVYgPYbvPih",
+			"transactionTime": 1710485608839,
+			"price": "1.00000000",
+			"origQty": "5.00000000",
+			"executedQty": "0.00000000",
+			"cummulativeQuoteQty": "0.00000000",
+			"status": "NEW",
+			"timeInForce": "GTC",
+			"type": "STOP_LOSS_LIMIT",
+			"side": "SELL",
+			"stopPrice": "1.00000000"
+		  },
+		  {
+			"symbol": "LTCBTC",
+			"orderId": 11,
+			"orderListId": 1,
+			"clientOrderId": "NuMp0nVYnciDiFmVqfpBqK",
+			"transactionTime": 1710485608839,
+			"price": "3.00000000",
+			"origQty": "5.00000000",
+			"executedQty": "0.00000000",
+			"cummulativeQuoteQty": "0.00000000",
+			"status": "NEW",
+			"timeInForce": "GTC",
+			"type": "LIMIT_MAKER",
+			"side": "SELL"
+		  }
+		]
+	}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+	symbol := "LTCBTC"
+	side := SideTypeSell
+	quantity := "5"
+	abovePrice := "3"
+	belowPrice := "1"
+	belowStopPrice := "1"
+	belowTimeInForce := TimeInForceTypeGTC
+	aboveClientOrderID := "myAbove1"
+	newOrderRespType := NewOrderRespTypeFULL
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setFormParams(params{
+			"symbol":             symbol,
+			"side":               side,
+			"quantity":           quantity,
+			"aboveType":          OrderTypeLimitMaker,
+			"aboveClientOrderId": aboveClientOrderID,
+			"abovePrice":         abovePrice,
+			"belowType":          OrderTypeStopLossLimit,
+			"belowPrice":         belowPrice,
+			"belowStopPrice":     belowStopPrice,
+			"belowTimeInForce":   belowTimeInForce,
+			"newOrderRespType":   newOrderRespType,
+		})
+		s.assertRequestEqual(e, r)
+	})
+	res, err := s.client.NewCreateOrderListOCOService().
+		Symbol(symbol).
+		Side(side).
+		Quantity(quantity).
+		AboveType(OrderTypeLimitMaker).
+		AboveClientOrderID(aboveClientOrderID).
+		AbovePrice(abovePrice).
+		BelowType(OrderTypeStopLossLimit).
+		BelowPrice(belowPrice).
+		BelowStopPrice(belowStopPrice).
+		BelowTimeInForce(belowTimeInForce).
+		NewOrderRespType(newOrderRespType).
+		Do(newContext())
+
+	s.r().NoError(err)
+	e := &CreateOCOResponse{
+		OrderListID:       1,
+		ContingencyType:   "OCO",
+		ListStatusType:    "EXEC_STARTED",
+		ListOrderStatus:   "EXECUTING",
+		ListClientOrderID: "lH1YDkuQKWiXVXHPSKYEIp",
+		TransactionTime:   1710485608839,
+		Symbol:            "LTCBTC",
+		Orders: []*OCOOrder{
+			{
+				Symbol:        "LTCBTC",
+				OrderID:       10,
+				ClientOrderID: "44nZvqpemY7sVYgPYbvPih",
+			},
+			{
+				Symbol:        "LTCBTC",
+				OrderID:       11,
+				ClientOrderID: "NuMp0nVYnciDiFmVqfpBqK",
+			},
+		},
+		OrderReports: []*OCOOrderReport{
+			{
+				Symbol:                   "LTCBTC",
+				OrderID:                  10,
+				OrderListID:              1,
+				ClientOrderID:            "44nZvqpemY7sVYgPYbvPih",
+				TransactionTime:          1710485608839,
+				Price:                    "1.00000000",
+				OrigQuantity:             "5.00000000",
+				ExecutedQuantity:         "0.00000000",
+				CummulativeQuoteQuantity: "0.00000000",
+				Status:                   OrderStatusTypeNew,
+				TimeInForce:              TimeInForceTypeGTC,
+				Type:                     OrderTypeStopLossLimit,
+				Side:                     SideTypeSell,
+				StopPrice:                "1.00000000",
+			},
+			{
+				Symbol:                   "LTCBTC",
+				OrderID:                  11,
+				OrderListID:              1,
+				ClientOrderID:            "NuMp0nVYnciDiFmVqfpBqK",
+				TransactionTime:          1710485608839,
+				Price:                    "3.00000000",
+				OrigQuantity:             "5.00000000",
+				ExecutedQuantity:         "0.00000000",
+				CummulativeQuoteQuantity: "0.00000000",
+				Status:                   OrderStatusTypeNew,
+				TimeInForce:              TimeInForceTypeGTC,
+				Type:                     OrderTypeLimitMaker,
+				Side:                     SideTypeSell,
+			},
+		},
+	}
+	s.assertCreateOCOResponseEqual(e, res)
+}
+
+func (s *orderServiceTestSuite) TestCreateOTOCO() {
+	data := []byte(`{
+		"orderListId": 2,
+		"contingencyType": "OTO",
+		"listStatusType": "EXEC_STARTED",
+		"listOrderStatus": "EXECUTING",
+		"listClientOrderId": "RumwQpBaDctlUu5jyG5rs0",
+		"transactionTime": 1712291372842,
+		"symbol": "LTCBTC",
+		"orders": [
+		  {
+			"symbol": "LTCBTC",
+			"orderId": 20,
+			"clientOrderId": "myWorking1"
+		  },
+		  {
+			"symbol": "LTCBTC",
+			"orderId": 21,
+			"clientOrderId": "mCLbDg4CwWcByPXJlLUdHm"
+		  },
+		  {
+			"symbol": "LTCBTC",
+			"orderId": 22,
+			"clientOrderId": "fHQZnSIeV3udOHuvPNAcbJ"
+		  }
+		],
+		"orderReports": [
+		  {
+			"symbol": "LTCBTC",
+			"orderId": 20,
+			"orderListId": 2,
+			"clientOrderId": "myWorking1",
+			"transactionTime": 1712291372842,
+			"price": "2.00000000",
+			"origQty": "1.00000000",
+			"executedQty": "0.00000000",
+			"cummulativeQuoteQty": "0.00000000",
+			"status": "NEW",
+			"timeInForce": "GTC",
+			"type": "LIMIT",
+			"side": "BUY"
+		  },
+		  {
+			"symbol": "LTCBTC",
+			"orderId": 21,
+			"orderListId": 2,
+			"clientOrderId": "mCLbDg4CwWcByPXJlLUdHm",
+			"transactionTime": 1712291372842,
+			"price": "3.00000000",
+			"origQty": "1.00000000",
+			"executedQty": "0.00000000",
+			"cummulativeQuoteQty": "0.00000000",
+			"status": "PENDING_NEW",
+			"timeInForce": "GTC",
+			"type": "LIMIT_MAKER",
+			"side": "SELL"
+		  },
+		  {
+			"symbol": "LTCBTC",
+			"orderId": 22,
+			"orderListId": 2,
+			"clientOrderId": "fHQZnSIeV3udOHuvPNAcbJ",
+			"transactionTime": 1712291372842,
+			"price": "1.50000000",
+			"origQty": "1.00000000",
+			"executedQty": "0.00000000",
+			"cummulativeQuoteQty": "0.00000000",
+			"status": "PENDING_NEW",
+			"timeInForce": "GTC",
+			"type": "STOP_LOSS_LIMIT",
+			"side": "SELL",
+			"stopPrice": "1.60000000"
+		  }
+		]
+	}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+	symbol := "LTCBTC"
+	workingClientOrderID := "myWorking1"
+	workingPrice := "2"
+	quantity := "1"
+	timeInForce := TimeInForceTypeGTC
+	pendingAbovePrice := "3"
+	pendingBelowPrice := "1.5"
+	pendingBelowStopPrice := "1.6"
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setFormParams(params{
+			"symbol":                  symbol,
+			"workingType":             OrderTypeLimit,
+			"workingSide":             SideTypeBuy,
+			"workingClientOrderId":    workingClientOrderID,
+			"workingPrice":            workingPrice,
+			"workingQuantity":         quantity,
+			"workingTimeInForce":      timeInForce,
+			"pendingSide":             SideTypeSell,
+			"pendingQuantity":         quantity,
+			"pendingAboveType":        OrderTypeLimitMaker,
+			"pendingAbovePrice":       pendingAbovePrice,
+			"pendingBelowType":        OrderTypeStopLossLimit,
+			"pendingBelowPrice":       pendingBelowPrice,
+			"pendingBelowStopPrice":   pendingBelowStopPrice,
+			"pendingBelowTimeInForce": timeInForce,
+		})
+		s.assertRequestEqual(e, r)
+	})
+	res, err := s.client.NewCreateOTOCOService().
+		Symbol(symbol).
+		WorkingType(OrderTypeLimit).
+		WorkingSide(SideTypeBuy).
+		WorkingClientOrderID(workingClientOrderID).
+		WorkingPrice(workingPrice).
+		WorkingQuantity(quantity).
+		WorkingTimeInForce(timeInForce).
+		PendingSide(SideTypeSell).
+		PendingQuantity(quantity).
+		PendingAboveType(OrderTypeLimitMaker).
+		PendingAbovePrice(pendingAbovePrice).
+		PendingBelowType(OrderTypeStopLossLimit).
+		PendingBelowPrice(pendingBelowPrice).
+		PendingBelowStopPrice(pendingBelowStopPrice).
+		PendingBelowTimeInForce(timeInForce).
+		Do(newContext())
+
+	s.r().NoError(err)
+	e := &CreateOTOCOResponse{
+		OrderListID:       2,
+		ContingencyType:   "OTO",
+		ListStatusType:    "EXEC_STARTED",
+		ListOrderStatus:   "EXECUTING",
+		ListClientOrderID: "RumwQpBaDctlUu5jyG5rs0",
+		TransactionTime:   1712291372842,
+		Symbol:            "LTCBTC",
+		Orders: []*OCOOrder{
+			{
+				Symbol:        "LTCBTC",
+				OrderID:       20,
+				ClientOrderID: "myWorking1",
+			},
+			{
+				Symbol:        "LTCBTC",
+				OrderID:       21,
+				ClientOrderID: "mCLbDg4CwWcByPXJlLUdHm",
+			},
+			{
+				Symbol:        "LTCBTC",
+				OrderID:       22,
+				ClientOrderID: "fHQZnSIeV3udOHuvPNAcbJ",
+			},
+		},
+		OrderReports: []*OCOOrderReport{
+			{
+				Symbol:                   "LTCBTC",
+				OrderID:                  20,
+				OrderListID:              2,
+				ClientOrderID:            "myWorking1",
+				TransactionTime:          1712291372842,
+				Price:                    "2.00000000",
+				OrigQuantity:             "1.00000000",
+				ExecutedQuantity:         "0.00000000",
+				CummulativeQuoteQuantity: "0.00000000",
+				Status:                   OrderStatusTypeNew,
+				TimeInForce:              TimeInForceTypeGTC,
+				Type:                     OrderTypeLimit,
+				Side:                     SideTypeBuy,
+			},
+			{
+				Symbol:                   "LTCBTC",
+				OrderID:                  21,
+				OrderListID:              2,
+				ClientOrderID:            "mCLbDg4CwWcByPXJlLUdHm",
+				TransactionTime:          1712291372842,
+				Price:                    "3.00000000",
+				OrigQuantity:             "1.00000000",
+				ExecutedQuantity:         "0.00000000",
+				CummulativeQuoteQuantity: "0.00000000",
+				Status:                   OrderStatusTypePendingNew,
+				TimeInForce:              TimeInForceTypeGTC,
+				Type:                     OrderTypeLimitMaker,
+				Side:                     SideTypeSell,
+			},
+			{
+				Symbol:                   "LTCBTC",
+				OrderID:                  22,
+				OrderListID:              2,
+				ClientOrderID:            "fHQZnSIeV3udOHuvPNAcbJ",
+				TransactionTime:          1712291372842,
+				Price:                    "1.50000000",
+				OrigQuantity:             "1.00000000",
+				ExecutedQuantity:         "0.00000000",
+				CummulativeQuoteQuantity: "0.00000000",
+				Status:                   OrderStatusTypePendingNew,
+				TimeInForce:              TimeInForceTypeGTC,
+				Type:                     OrderTypeStopLossLimit,
+				Side:                     SideTypeSell,
+				StopPrice:                "1.60000000",
+			},
+		},
+	}
+	s.assertCreateOCOResponseEqual(e, res)
+}
+
 func (s *baseOrderTestSuite) assertCreateOCOResponseEqual(e, a *CreateOCOResponse) {
 	r := s.r()
 	r.Equal(e.ContingencyType, a.ContingencyType, "ContingencyType")
@@ -464,6 +968,35 @@ func (s *baseOrderTestSuite) assertOcoEqual(e, a *Oco) {
 	r.Equal(e.Orders[1].ClientOrderID, a.Orders[1].ClientOrderID, "Orders[1].ClientOrderID")
 	r.Equal(e.TransactionTime, a.TransactionTime, "TransactionTime")
 }
+func (s *orderServiceTestSuite) TestListOpenOrdersAllSymbols() {
+	data := []byte(`[
+		{"symbol": "LTCBTC", "orderId": 1, "status": "NEW"},
+		{"symbol": "ETHBTC", "orderId": 2, "status": "PARTIALLY_FILLED"}
+	]`)
+	s.client.Client.do = s.client.do
+	s.client.On("do", anyHTTPRequest()).Return(newHTTPResponse(data, http.StatusOK), nil).Once()
+	s.client.On("do", anyHTTPRequest()).Return(newHTTPResponse(data, http.StatusOK), nil).Once()
+	defer s.assertDo()
+	s.assertReq(func(r *request) {
+		s.assertRequestEqual(newSignedRequest(), r)
+	})
+	buf := new(bytes.Buffer)
+	s.client.Logger = log.New(buf, "", 0)
+
+	r := s.r()
+	orders, err := s.client.NewListOpenOrdersService().Symbol("LTCBTC").AllSymbols().Do(newContext())
+	r.NoError(err)
+	r.Len(orders, 2)
+	r.Equal("ETHBTC", orders[1].Symbol)
+	r.Empty(buf.String())
+
+	// listing all symbols without opting in warns
+	orders, err = s.client.NewListOpenOrdersService().Do(newContext())
+	r.NoError(err)
+	r.Len(orders, 2)
+	r.Contains(buf.String(), "AllSymbols")
+}
+
 func (s *orderServiceTestSuite) TestListOpenOrders() {
 	data := []byte(`[
         {
@@ -536,10 +1069,12 @@ func (s *baseOrderTestSuite) assertOrderEqual(e, a *Order) {
 	r.Equal(e.Side, a.Side, "Side")
 	r.Equal(e.StopPrice, a.StopPrice, "StopPrice")
 	r.Equal(e.IcebergQuantity, a.IcebergQuantity, "IcebergQuantity")
-	r.Equal(e.Time, e.Time, "Time")
+	r.Equal(e.Time, a.Time, "Time")
 	r.Equal(e.UpdateTime, a.UpdateTime, "UpdateTime")
 	r.Equal(e.IsWorking, a.IsWorking, "IsWorking")
 	r.Equal(e.OrigQuoteOrderQuantity, a.OrigQuoteOrderQuantity, "OrigQuoteOrderQuantity")
+	r.Equal(e.WorkingTime, a.WorkingTime, "WorkingTime")
+	r.Equal(e.SelfTradePreventionMode, a.SelfTradePreventionMode, "SelfTradePreventionMode")
 }
 
 func (s *orderServiceTestSuite) TestGetOrder() {
@@ -600,6 +1135,73 @@ func (s *orderServiceTestSuite) TestGetOrder() {
 	s.assertOrderEqual(e, order)
 }
 
+func (s *orderServiceTestSuite) TestGetOrderPartiallyFilled() {
+	data := []byte(`{
+		"symbol": "BTCUSDT",
+		"orderId": 28,
+		"orderListId": -1,
+		"clientOrderId": "myOrder28",
+		"price": "25000.00000000",
+		"origQty": "0.01000000",
+		"executedQty": "0.00400000",
+		"cummulativeQuoteQty": "100.00000000",
+		"status": "PARTIALLY_FILLED",
+		"timeInForce": "GTC",
+		"type": "LIMIT",
+		"side": "BUY",
+		"stopPrice": "0.00000000",
+		"icebergQty": "0.00000000",
+		"time": 1499827319559,
+		"updateTime": 1499827329559,
+		"isWorking": true,
+		"workingTime": 1499827319559,
+		"origQuoteOrderQty": "0.00000000",
+		"selfTradePreventionMode": "EXPIRE_MAKER"
+	}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setParams(params{
+			"symbol":            "BTCUSDT",
+			"origClientOrderId": "myOrder28",
+		})
+		s.assertRequestEqual(e, r)
+	})
+	order, err := s.client.NewGetOrderService().Symbol("BTCUSDT").
+		OrigClientOrderID("myOrder28").Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	s.assertOrderEqual(&Order{
+		Symbol:                   "BTCUSDT",
+		OrderID:                  28,
+		OrderListId:              -1,
+		ClientOrderID:            "myOrder28",
+		Price:                    "25000.00000000",
+		OrigQuantity:             "0.01000000",
+		ExecutedQuantity:         "0.00400000",
+		CummulativeQuoteQuantity: "100.00000000",
+		Status:                   OrderStatusTypePartiallyFilled,
+		TimeInForce:              TimeInForceTypeGTC,
+		Type:                     OrderTypeLimit,
+		Side:                     SideTypeBuy,
+		StopPrice:                "0.00000000",
+		IcebergQuantity:          "0.00000000",
+		Time:                     1499827319559,
+		UpdateTime:               1499827329559,
+		IsWorking:                true,
+		WorkingTime:              1499827319559,
+		OrigQuoteOrderQuantity:   "0.00000000",
+		SelfTradePreventionMode:  SelfTradePreventionModeExpireMaker,
+	}, order)
+	r.False(order.IsFinal())
+}
+
+func (s *orderServiceTestSuite) TestGetOrderWithoutIdentifier() {
+	_, err := s.client.NewGetOrderService().Symbol("BTCUSDT").Do(newContext())
+	s.r().Equal(ErrOrderIdentifierRequired, err)
+	s.client.AssertNotCalled(s.T(), "do", anyHTTPRequest())
+}
+
 func (s *orderServiceTestSuite) TestListOrders() {
 	data := []byte(`[
         {
@@ -666,6 +1268,90 @@ func (s *orderServiceTestSuite) TestListOrders() {
 	s.assertOrderEqual(e, orders[0])
 }
 
+func (s *orderServiceTestSuite) TestIterateOrders() {
+	page1 := []byte(`[
+		{"symbol": "LTCBTC", "orderId": 10, "status": "FILLED", "time": 1499827319559},
+		{"symbol": "LTCBTC", "orderId": 11, "status": "CANCELED", "time": 1499827319560}
+	]`)
+	page2 := []byte(`[
+		{"symbol": "LTCBTC", "orderId": 12, "status": "NEW", "time": 1499827319561}
+	]`)
+	s.client.Client.do = s.client.do
+	s.client.On("do", anyHTTPRequest()).Return(newHTTPResponse(page1, 200), nil).Once()
+	s.client.On("do", anyHTTPRequest()).Return(newHTTPResponse(page2, 200), nil).Once()
+	defer s.assertDo()
+
+	symbol := "LTCBTC"
+	startTime := int64(1499827319000)
+	calls := 0
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setParams(params{
+			"symbol":    symbol,
+			"limit":     2,
+			"startTime": startTime,
+		})
+		if calls > 0 {
+			e = newSignedRequest().setParams(params{
+				"symbol":  symbol,
+				"limit":   2,
+				"orderId": 12,
+			})
+		}
+		calls++
+		s.assertRequestEqual(e, r)
+	})
+
+	var ids []int64
+	err := s.client.NewListOrdersService().Symbol(symbol).StartTime(startTime).Limit(2).
+		Iterate(newContext(), func(o *Order) error {
+			ids = append(ids, o.OrderID)
+			return nil
+		})
+	r := s.r()
+	r.NoError(err)
+	r.Equal(2, calls)
+	r.Equal([]int64{10, 11, 12}, ids)
+}
+
+func (s *orderServiceTestSuite) TestIterateOrdersInvalidLimit() {
+	calls := 0
+	err := s.client.NewListOrdersService().Symbol("LTCBTC").Limit(0).
+		Iterate(newContext(), func(o *Order) error {
+			calls++
+			return nil
+		})
+	r := s.r()
+	r.Error(err)
+	r.Equal(0, calls)
+}
+
+func (s *orderServiceTestSuite) TestIterateOrdersLimitCapped() {
+	page1 := []byte(`[
+		{"symbol": "LTCBTC", "orderId": 10, "status": "FILLED", "time": 1499827319559}
+	]`)
+	s.client.Client.do = s.client.do
+	s.client.On("do", anyHTTPRequest()).Return(newHTTPResponse(page1, 200), nil).Once()
+	defer s.assertDo()
+
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setParams(params{
+			"symbol": "LTCBTC",
+			"limit":  1000,
+		})
+		s.assertRequestEqual(e, r)
+	})
+
+	var ids []int64
+	err := s.client.NewListOrdersService().Symbol("LTCBTC").Limit(1500).
+		Iterate(newContext(), func(o *Order) error {
+			ids = append(ids, o.OrderID)
+			return nil
+		})
+	r := s.r()
+	r.NoError(err)
+	r.Equal([]int64{10}, ids)
+}
+
 func (s *orderServiceTestSuite) TestCancelOCO() {
 	data := []byte(`{
 		"orderListId":1000,
@@ -838,6 +1524,41 @@ func (s *orderServiceTestSuite) TestCancelOrder() {
 	s.assertCancelOrderResponseEqual(e, res)
 }
 
+func (s *orderServiceTestSuite) TestCancelAllAcrossSymbols() {
+	defer func(interval time.Duration) { cancelAcrossSymbolsInterval = interval }(cancelAcrossSymbolsInterval)
+	cancelAcrossSymbolsInterval = time.Millisecond
+	var symbols []string
+	s.client.Client.do = func(req *http.Request) (*http.Response, error) {
+		symbol := req.URL.Query().Get("symbol")
+		symbols = append(symbols, symbol)
+		switch symbol {
+		case "LTCBTC":
+			return newHTTPResponse([]byte(`[{
+				"symbol": "LTCBTC",
+				"origClientOrderId": "myOrder1",
+				"orderId": 1,
+				"orderListId": -1,
+				"status": "CANCELED"
+			}]`), http.StatusOK), nil
+		case "ETHBTC":
+			return newHTTPResponse([]byte(`{"code": -2011, "msg": "Unknown order sent."}`), http.StatusBadRequest), nil
+		}
+		return newHTTPResponse([]byte(`{"code": -1121, "msg": "Invalid symbol."}`), http.StatusBadRequest), nil
+	}
+
+	results := s.client.CancelAllAcrossSymbols(newContext(), []string{"LTCBTC", "ETHBTC", "XXXBTC"})
+	r := s.r()
+	r.Equal([]string{"LTCBTC", "ETHBTC", "XXXBTC"}, symbols)
+	r.Len(results, 3)
+	r.NoError(results["LTCBTC"].Err)
+	r.Len(results["LTCBTC"].Response.Orders, 1)
+	r.Equal(OrderStatusTypeCanceled, results["LTCBTC"].Response.Orders[0].Status)
+	r.NoError(results["ETHBTC"].Err)
+	r.Empty(results["ETHBTC"].Response.Orders)
+	r.True(common.IsAPIError(results["XXXBTC"].Err))
+	r.Equal(int64(-1121), results["XXXBTC"].Err.(*common.APIError).Code)
+}
+
 func (s *orderServiceTestSuite) TestCancelOpenOrders() {
 	data := []byte(`[
 		{
@@ -1004,6 +1725,43 @@ func (s *orderServiceTestSuite) TestCancelOpenOrders() {
 	s.assertCancelOCOResponseEqual(eoco, res.OCOOrders[0])
 }
 
+func (s *orderServiceTestSuite) TestCancelOpenOrdersWithoutOrderListID() {
+	data := []byte(`[
+		{
+			"symbol": "BTCUSDT",
+			"origClientOrderId": "E6APeyTJvkMvLMYMqu1KQ4",
+			"orderId": 11,
+			"clientOrderId": "pXLV6Hz6mprAcVYpVMTGgx",
+			"status": "CANCELED",
+			"type": "LIMIT",
+			"side": "BUY"
+		},
+		{
+			"orderListId": 1929,
+			"contingencyType": "OCO",
+			"listStatusType": "ALL_DONE",
+			"listOrderStatus": "ALL_DONE",
+			"listClientOrderId": "2inzWQdDvZLHbbAmAozX2N",
+			"symbol": "BTCUSDT",
+			"orders": [
+				{"symbol": "BTCUSDT", "orderId": 20, "clientOrderId": "CwOOIPHSmYywx6jZX77TdL"},
+				{"symbol": "BTCUSDT", "orderId": 21, "clientOrderId": "461cPg51vQjV3zIMOXNz39"}
+			]
+		}
+	]`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	res, err := s.client.NewCancelOpenOrdersService().Symbol("BTCUSDT").Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	r.Len(res.Orders, 1)
+	r.Equal(int64(11), res.Orders[0].OrderID)
+	r.Len(res.OCOOrders, 1)
+	r.Equal(int64(1929), res.OCOOrders[0].OrderListID)
+	r.Len(res.OCOOrders[0].Orders, 2)
+}
+
 func (s *baseOrderTestSuite) assertCancelOrderResponseEqual(e, a *CancelOrderResponse) {
 	r := s.r()
 	r.Equal(e.Symbol, a.Symbol, "Symbol")
@@ -1041,3 +1799,203 @@ func (s *baseOrderTestSuite) assertCancelOCOResponseEqual(e, a *CancelOCORespons
 		s.assertOCOOrderEqual(order, a.Orders[idx])
 	}
 }
+
+func (s *orderServiceTestSuite) TestGenerateClientOrderID() {
+	generate := func(prefix string) string {
+		id, err := GenerateClientOrderID(prefix)
+		s.r().NoError(err)
+		return id
+	}
+	valid := regexp.MustCompile(`^[\.A-Z\:/a-z0-9_-]{1,36}$`)
+	for _, prefix := range []string{"", "bot-1", "a b#c", strings.Repeat("x", 40)} {
+		id := generate(prefix)
+		s.Regexp(valid, id, prefix)
+		s.Len(id, 36, prefix)
+	}
+	s.True(strings.HasPrefix(generate("bot-1"), "bot-1"))
+	s.True(strings.HasPrefix(generate("a b#c"), "abc"))
+	s.Equal(strings.Repeat("x", 16), generate(strings.Repeat("x", 40))[:16])
+	s.NotEqual(generate("bot"), generate("bot"))
+}
+
+func (s *orderServiceTestSuite) TestCreateOrderClientOrderIDAutoRandError() {
+	defer func(f func([]byte) (int, error)) { randRead = f }(randRead)
+	randErr := errors.New("entropy source unavailable")
+	randRead = func([]byte) (int, error) {
+		return 0, randErr
+	}
+
+	_, err := GenerateClientOrderID("bot")
+	s.True(errors.Is(err, randErr))
+
+	service := s.client.NewCreateOrderService().Symbol("LTCBTC").Side(SideTypeBuy).
+		Type(OrderTypeMarket).Quantity("1").NewClientOrderIDAuto()
+	_, err = service.Do(newContext())
+	s.True(errors.Is(err, randErr))
+	_, err = s.client.PlaceOrderIdempotent(newContext(), s.client.NewCreateOrderService().
+		Symbol("LTCBTC").Side(SideTypeBuy).Type(OrderTypeMarket).Quantity("1"))
+	s.True(errors.Is(err, randErr))
+	s.client.AssertNotCalled(s.T(), "do", anyHTTPRequest())
+}
+
+func (s *orderServiceTestSuite) TestCreateOrderClientOrderIDAuto() {
+	var ids []string
+	s.client.Client.do = func(req *http.Request) (*http.Response, error) {
+		body, err := ioutil.ReadAll(req.Body)
+		s.r().NoError(err)
+		form, err := url.ParseQuery(string(body))
+		s.r().NoError(err)
+		ids = append(ids, form.Get("newClientOrderId"))
+		return newHTTPResponse([]byte(`{}`), http.StatusOK), nil
+	}
+
+	service := s.client.NewCreateOrderService().Symbol("LTCBTC").Side(SideTypeBuy).
+		Type(OrderTypeMarket).Quantity("1").NewClientOrderIDAuto()
+	for i := 0; i < 2; i++ {
+		_, err := service.Do(newContext())
+		s.r().NoError(err)
+	}
+	s.Len(ids, 2)
+	s.Len(ids[0], 36)
+	s.Equal(ids[0], ids[1])
+}
+
+func (s *orderServiceTestSuite) TestWaitForOrder() {
+	notFound := []byte(`{"code": -2013, "msg": "Order does not exist."}`)
+	newOrder := []byte(`{"symbol": "LTCBTC", "orderId": 1, "status": "NEW", "executedQty": "0.0"}`)
+	filled := []byte(`{"symbol": "LTCBTC", "orderId": 1, "status": "FILLED", "executedQty": "1.0"}`)
+	s.client.Client.do = s.client.do
+	s.client.On("do", anyHTTPRequest()).Return(newHTTPResponse(notFound, http.StatusBadRequest), nil).Once()
+	s.client.On("do", anyHTTPRequest()).Return(newHTTPResponse(newOrder, http.StatusOK), nil).Once()
+	s.client.On("do", anyHTTPRequest()).Return(newHTTPResponse(filled, http.StatusOK), nil).Once()
+	defer s.assertDo()
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setParams(params{
+			"symbol":  "LTCBTC",
+			"orderId": 1,
+		})
+		s.assertRequestEqual(e, r)
+	})
+
+	order, err := s.client.WaitForOrder(newContext(), "LTCBTC", 1, time.Millisecond)
+	r := s.r()
+	r.NoError(err)
+	r.Equal(OrderStatusTypeFilled, order.Status)
+	r.Equal("1.0", order.ExecutedQuantity)
+	s.client.AssertNumberOfCalls(s.T(), "do", 3)
+}
+
+func (s *orderServiceTestSuite) TestWaitForOrderTimeout() {
+	s.client.Client.do = func(req *http.Request) (*http.Response, error) {
+		return newHTTPResponse([]byte(`{"symbol": "LTCBTC", "orderId": 1, "status": "NEW"}`), http.StatusOK), nil
+	}
+
+	ctx, cancel := context.WithTimeout(newContext(), 20*time.Millisecond)
+	defer cancel()
+	order, err := s.client.WaitForOrder(ctx, "LTCBTC", 1, time.Millisecond)
+	r := s.r()
+	r.Equal(context.DeadlineExceeded, err)
+	r.Equal(OrderStatusTypeNew, order.Status)
+}
+
+func (s *orderServiceTestSuite) TestWaitForOrderInvalidPollInterval() {
+	s.client.Client.do = func(req *http.Request) (*http.Response, error) {
+		s.T().Error("unexpected request")
+		return nil, nil
+	}
+
+	r := s.r()
+	for _, interval := range []time.Duration{0, -time.Second} {
+		order, err := s.client.WaitForOrder(newContext(), "LTCBTC", 1, interval)
+		r.Error(err)
+		r.Nil(order)
+	}
+}
+
+type timeoutError struct{}
+
+func (timeoutError) Error() string   { return "i/o timeout" }
+func (timeoutError) Timeout() bool   { return true }
+func (timeoutError) Temporary() bool { return true }
+
+func (s *orderServiceTestSuite) TestPlaceOrderIdempotentTimedOutButPlaced() {
+	var posts int
+	var queried url.Values
+	s.client.Client.do = func(req *http.Request) (*http.Response, error) {
+		if req.Method == http.MethodPost {
+			posts++
+			// the order reached the server but the response was lost
+			return nil, timeoutError{}
+		}
+		queried = req.URL.Query()
+		return newHTTPResponse([]byte(`{
+			"symbol": "LTCBTC",
+			"orderId": 1,
+			"clientOrderId": "`+req.URL.Query().Get("origClientOrderId")+`",
+			"price": "0.10000000",
+			"origQty": "1.00000000",
+			"executedQty": "0.00000000",
+			"cummulativeQuoteQty": "0.00000000",
+			"status": "NEW",
+			"timeInForce": "GTC",
+			"type": "LIMIT",
+			"side": "BUY",
+			"time": 1499827319559
+		}`), http.StatusOK), nil
+	}
+
+	service := s.client.NewCreateOrderService().Symbol("LTCBTC").Side(SideTypeBuy).
+		Type(OrderTypeLimit).TimeInForce(TimeInForceTypeGTC).Quantity("1").Price("0.1")
+	res, err := s.client.PlaceOrderIdempotent(newContext(), service)
+	r := s.r()
+	r.NoError(err)
+	r.Equal(1, posts)
+	r.Equal("LTCBTC", queried.Get("symbol"))
+	r.NotEmpty(queried.Get("origClientOrderId"))
+	r.Equal(int64(1), res.OrderID)
+	r.Equal(queried.Get("origClientOrderId"), res.ClientOrderID)
+	r.Equal(OrderStatusTypeNew, res.Status)
+	r.Equal(int64(1499827319559), res.TransactTime)
+}
+
+func (s *orderServiceTestSuite) TestPlaceOrderIdempotentRetriesNotPlaced() {
+	var clientOrderIDs []string
+	s.client.Client.do = func(req *http.Request) (*http.Response, error) {
+		if req.Method == http.MethodGet {
+			return newHTTPResponse([]byte(`{"code": -2013, "msg": "Order does not exist."}`), http.StatusBadRequest), nil
+		}
+		body, _ := ioutil.ReadAll(req.Body)
+		form, _ := url.ParseQuery(string(body))
+		clientOrderIDs = append(clientOrderIDs, form.Get("newClientOrderId"))
+		if len(clientOrderIDs) == 1 {
+			return nil, timeoutError{}
+		}
+		return newHTTPResponse([]byte(`{
+			"symbol": "LTCBTC",
+			"orderId": 2,
+			"clientOrderId": "myOrder2",
+			"status": "NEW"
+		}`), http.StatusOK), nil
+	}
+
+	service := s.client.NewCreateOrderService().Symbol("LTCBTC").Side(SideTypeBuy).
+		Type(OrderTypeLimit).TimeInForce(TimeInForceTypeGTC).Quantity("1").Price("0.1").
+		NewClientOrderID("myOrder2")
+	res, err := s.client.PlaceOrderIdempotent(newContext(), service)
+	r := s.r()
+	r.NoError(err)
+	r.Equal([]string{"myOrder2", "myOrder2"}, clientOrderIDs)
+	r.Equal(int64(2), res.OrderID)
+}
+
+func (s *orderServiceTestSuite) TestPlaceOrderIdempotentAPIError() {
+	s.mockDo([]byte(`{"code": -2010, "msg": "Account has insufficient balance for requested action."}`), nil, http.StatusBadRequest)
+	defer s.assertDo()
+
+	service := s.client.NewCreateOrderService().Symbol("LTCBTC").Side(SideTypeBuy).
+		Type(OrderTypeLimit).TimeInForce(TimeInForceTypeGTC).Quantity("1").Price("0.1")
+	_, err := s.client.PlaceOrderIdempotent(newContext(), service)
+	r := s.r()
+	r.True(common.IsAPIError(err))
+	s.client.AssertNumberOfCalls(s.T(), "do", 1)
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"net/http"
+	"time"
 )
 
 type SpotRebateHistoryService struct {
@@ -19,12 +20,22 @@ func (s *SpotRebateHistoryService) StartTime(startTime int64) *SpotRebateHistory
 	return s
 }
 
+// StartTimeFrom set startTime from t, converted to epoch milliseconds
+func (s *SpotRebateHistoryService) StartTimeFrom(t time.Time) *SpotRebateHistoryService {
+	return s.StartTime(FormatTimestamp(t))
+}
+
 // EndTime set endTime
 func (s *SpotRebateHistoryService) EndTime(endTime int64) *SpotRebateHistoryService {
 	s.endTime = &endTime
 	return s
 }
 
+// EndTimeFrom set endTime from t, converted to epoch milliseconds
+func (s *SpotRebateHistoryService) EndTimeFrom(t time.Time) *SpotRebateHistoryService {
+	return s.EndTime(FormatTimestamp(t))
+}
+
 // Page set page
 func (s *SpotRebateHistoryService) Page(page int32) *SpotRebateHistoryService {
 	s.page = &page
@@ -81,3 +92,184 @@ type SpotRebateHistoryDataItem struct {
 	Amount     string `json:"amount"`
 	UpdateTime int64  `json:"updateTime"`
 }
+
+// GetBrokerRebateRecentRecordService get the recent rebate records of the
+// customers referred with an API referral link
+type GetBrokerRebateRecentRecordService struct {
+	c          *Client
+	customerID *string
+	startTime  *int64
+	endTime    *int64
+	limit      *int
+}
+
+// CustomerID set customerId
+func (s *GetBrokerRebateRecentRecordService) CustomerID(customerID string) *GetBrokerRebateRecentRecordService {
+	s.customerID = &customerID
+	return s
+}
+
+// StartTime set startTime
+func (s *GetBrokerRebateRecentRecordService) StartTime(startTime int64) *GetBrokerRebateRecentRecordService {
+	s.startTime = &startTime
+	return s
+}
+
+// StartTimeFrom set startTime from t, converted to epoch milliseconds
+func (s *GetBrokerRebateRecentRecordService) StartTimeFrom(t time.Time) *GetBrokerRebateRecentRecordService {
+	return s.StartTime(FormatTimestamp(t))
+}
+
+// EndTime set endTime
+func (s *GetBrokerRebateRecentRecordService) EndTime(endTime int64) *GetBrokerRebateRecentRecordService {
+	s.endTime = &endTime
+	return s
+}
+
+// EndTimeFrom set endTime from t, converted to epoch milliseconds
+func (s *GetBrokerRebateRecentRecordService) EndTimeFrom(t time.Time) *GetBrokerRebateRecentRecordService {
+	return s.EndTime(FormatTimestamp(t))
+}
+
+// Limit set limit, max 500
+func (s *GetBrokerRebateRecentRecordService) Limit(limit int) *GetBrokerRebateRecentRecordService {
+	s.limit = &limit
+	return s
+}
+
+// Do send request
+func (s *GetBrokerRebateRecentRecordService) Do(ctx context.Context, opts ...RequestOption) ([]*BrokerRebateRecord, error) {
+	r := &request{
+		method:   http.MethodGet,
+		endpoint: "/sapi/v1/apiReferral/rebate/recentRecord",
+		secType:  secTypeSigned,
+	}
+	if s.customerID != nil {
+		r.setParam("customerId", *s.customerID)
+	}
+	if s.startTime != nil {
+		r.setParam("startTime", *s.startTime)
+	}
+	if s.endTime != nil {
+		r.setParam("endTime", *s.endTime)
+	}
+	if s.limit != nil {
+		r.setParam("limit", *s.limit)
+	}
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res := make([]*BrokerRebateRecord, 0)
+	if err = json.Unmarshal(data, &res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// BrokerRebateRecord define a rebate earned on a trade of a referred customer
+type BrokerRebateRecord struct {
+	CustomerID string `json:"customerId"`
+	Email      string `json:"email"`
+	Income     string `json:"income"`
+	Asset      string `json:"asset"`
+	Symbol     string `json:"symbol"`
+	Time       int64  `json:"time"`
+	OrderID    int64  `json:"orderId"`
+	TradeID    int64  `json:"tradeId"`
+}
+
+// GetBrokerSubAccountCommissionService get the recent spot commission records
+// of the sub accounts of a broker account
+type GetBrokerSubAccountCommissionService struct {
+	c            *Client
+	subAccountID *string
+	startTime    *int64
+	endTime      *int64
+	page         *int
+	size         *int
+}
+
+// SubAccountID set subAccountId
+func (s *GetBrokerSubAccountCommissionService) SubAccountID(subAccountID string) *GetBrokerSubAccountCommissionService {
+	s.subAccountID = &subAccountID
+	return s
+}
+
+// StartTime set startTime
+func (s *GetBrokerSubAccountCommissionService) StartTime(startTime int64) *GetBrokerSubAccountCommissionService {
+	s.startTime = &startTime
+	return s
+}
+
+// StartTimeFrom set startTime from t, converted to epoch milliseconds
+func (s *GetBrokerSubAccountCommissionService) StartTimeFrom(t time.Time) *GetBrokerSubAccountCommissionService {
+	return s.StartTime(FormatTimestamp(t))
+}
+
+// EndTime set endTime
+func (s *GetBrokerSubAccountCommissionService) EndTime(endTime int64) *GetBrokerSubAccountCommissionService {
+	s.endTime = &endTime
+	return s
+}
+
+// EndTimeFrom set endTime from t, converted to epoch milliseconds
+func (s *GetBrokerSubAccountCommissionService) EndTimeFrom(t time.Time) *GetBrokerSubAccountCommissionService {
+	return s.EndTime(FormatTimestamp(t))
+}
+
+// Page set page
+func (s *GetBrokerSubAccountCommissionService) Page(page int) *GetBrokerSubAccountCommissionService {
+	s.page = &page
+	return s
+}
+
+// Size set size, max 500
+func (s *GetBrokerSubAccountCommissionService) Size(size int) *GetBrokerSubAccountCommissionService {
+	s.size = &size
+	return s
+}
+
+// Do send request
+func (s *GetBrokerSubAccountCommissionService) Do(ctx context.Context, opts ...RequestOption) ([]*BrokerSubAccountCommission, error) {
+	r := &request{
+		method:   http.MethodGet,
+		endpoint: "/sapi/v1/broker/rebate/recentRecord",
+		secType:  secTypeSigned,
+	}
+	if s.subAccountID != nil {
+		r.setParam("subAccountId", *s.subAccountID)
+	}
+	if s.startTime != nil {
+		r.setParam("startTime", *s.startTime)
+	}
+	if s.endTime != nil {
+		r.setParam("endTime", *s.endTime)
+	}
+	if s.page != nil {
+		r.setParam("page", *s.page)
+	}
+	if s.size != nil {
+		r.setParam("size", *s.size)
+	}
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res := make([]*BrokerSubAccountCommission, 0)
+	if err = json.Unmarshal(data, &res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// BrokerSubAccountCommission define a commission earned on a trade of a sub account
+type BrokerSubAccountCommission struct {
+	SubAccountID string `json:"subaccountId"`
+	Income       string `json:"income"`
+	Asset        string `json:"asset"`
+	Symbol       string `json:"symbol"`
+	TradeID      int64  `json:"tradeId"`
+	Time         int64  `json:"time"`
+	Status       int    `json:"status"`
+}
@@ -103,3 +103,115 @@ func (s *rebateServiceTestSuite) assertSpotRebateHistoryDataItemEqual(e, a *Spot
 	r.Equal(e.Amount, a.Amount, "Amount")
 	r.Equal(e.UpdateTime, a.UpdateTime, "UpdateTime")
 }
+
+func (s *rebateServiceTestSuite) TestGetBrokerRebateRecentRecord() {
+	data := []byte(`[
+		{
+			"customerId": "123",
+			"email": "123@test.com",
+			"income": "0.02063898",
+			"asset": "BTC",
+			"symbol": "ETHBTC",
+			"time": 1544433328000,
+			"orderId": 1,
+			"tradeId": 2
+		},
+		{
+			"customerId": "321",
+			"email": "321@test.com",
+			"income": "1.2",
+			"asset": "USDT",
+			"symbol": "BNBUSDT",
+			"time": 1581580800000,
+			"orderId": 3,
+			"tradeId": 4
+		}
+	]`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	startTime := int64(1544433328000)
+	limit := 100
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setParams(params{
+			"customerId": "123",
+			"startTime":  startTime,
+			"limit":      limit,
+		})
+		s.assertRequestEqual(e, r)
+	})
+
+	res, err := s.client.NewGetBrokerRebateRecentRecordService().
+		CustomerID("123").
+		StartTime(startTime).
+		Limit(limit).
+		Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	r.Equal([]*BrokerRebateRecord{
+		{
+			CustomerID: "123",
+			Email:      "123@test.com",
+			Income:     "0.02063898",
+			Asset:      "BTC",
+			Symbol:     "ETHBTC",
+			Time:       1544433328000,
+			OrderID:    1,
+			TradeID:    2,
+		},
+		{
+			CustomerID: "321",
+			Email:      "321@test.com",
+			Income:     "1.2",
+			Asset:      "USDT",
+			Symbol:     "BNBUSDT",
+			Time:       1581580800000,
+			OrderID:    3,
+			TradeID:    4,
+		},
+	}, res)
+}
+
+func (s *rebateServiceTestSuite) TestGetBrokerSubAccountCommission() {
+	data := []byte(`[
+		{
+			"subaccountId": "1",
+			"income": "0.02063898",
+			"asset": "BTC",
+			"symbol": "ETHBTC",
+			"tradeId": 123456,
+			"time": 1544433328000,
+			"status": 1
+		}
+	]`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setParams(params{
+			"subAccountId": "1",
+			"page":         1,
+			"size":         500,
+		})
+		s.assertRequestEqual(e, r)
+	})
+
+	res, err := s.client.NewGetBrokerSubAccountCommissionService().
+		SubAccountID("1").
+		Page(1).
+		Size(500).
+		Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	r.Equal([]*BrokerSubAccountCommission{
+		{
+			SubAccountID: "1",
+			Income:       "0.02063898",
+			Asset:        "BTC",
+			Symbol:       "ETHBTC",
+			TradeID:      123456,
+			Time:         1544433328000,
+			Status:       1,
+		},
+	}, res)
+}
@@ -5,6 +5,7 @@ import (
 	"io"
 	"net/http"
 	"net/url"
+	"time"
 )
 
 type secType int
@@ -19,15 +20,20 @@ type params map[string]interface{}
 
 // request define an API request
 type request struct {
-	method     string
-	endpoint   string
-	query      url.Values
-	form       url.Values
-	recvWindow int64
-	secType    secType
-	header     http.Header
-	body       io.Reader
-	fullURL    string
+	method      string
+	endpoint    string
+	query       url.Values
+	form        url.Values
+	recvWindow  int64
+	secType     secType
+	header      http.Header
+	body        io.Reader
+	fullURL     string
+	signDebug   func(payload string)
+	rawResponse *[]byte
+	apiKey      string
+	secretKey   string
+	signedAt    time.Time
 }
 
 // addParam add param with key/value to query string
@@ -93,6 +99,32 @@ func WithRecvWindow(recvWindow int64) RequestOption {
 	}
 }
 
+// WithCredentials use the given API key and secret key for the request instead of the client's
+func WithCredentials(apiKey, secretKey string) RequestOption {
+	return func(r *request) {
+		r.apiKey = apiKey
+		r.secretKey = secretKey
+	}
+}
+
+// WithSignDebug call f with the exact payload right before it is signed.
+// The payload is the query string followed by the form body, both encoded
+// with keys sorted in ascending order
+func WithSignDebug(f func(payload string)) RequestOption {
+	return func(r *request) {
+		r.signDebug = f
+	}
+}
+
+// WithRawResponse store the exact body of the response in raw, also when
+// the request failed with an API error. It lets read fields not modeled by the
+// typed response of Do yet
+func WithRawResponse(raw *[]byte) RequestOption {
+	return func(r *request) {
+		r.rawResponse = raw
+	}
+}
+
 // WithHeader set or add a header value to the request
 func WithHeader(key, value string, replace bool) RequestOption {
 	return func(r *request) {
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"net/http"
+	"strconv"
 )
 
 // ListSavingsFlexibleProductsService https://binance-docs.github.io/apidocs/spot/en/#get-flexible-product-list-user_data
@@ -40,34 +41,40 @@ func (s *ListSavingsFlexibleProductsService) Size(size int64) *ListSavingsFlexib
 }
 
 // Do send request
+//
+// Deprecated: the lending endpoints are retired, the products are listed by
+// ListSimpleEarnFlexibleProductsService. Status and Featured are applied to
+// the listed products, fields without a Simple Earn equivalent are left empty.
 func (s *ListSavingsFlexibleProductsService) Do(ctx context.Context, opts ...RequestOption) ([]*SavingsFlexibleProduct, error) {
-	r := &request{
-		method:   http.MethodGet,
-		endpoint: "/sapi/v1/lending/daily/product/list",
-		secType:  secTypeSigned,
-	}
-	m := params{}
-	if s.status != "" {
-		m["status"] = s.status
-	}
-	if s.featured != "" {
-		m["featured"] = s.featured
-	}
+	s.c.logDeprecated("ListSavingsFlexibleProductsService", "ListSimpleEarnFlexibleProductsService")
+	service := s.c.NewListSimpleEarnFlexibleProductsService()
 	if s.current != 0 {
-		m["current"] = s.current
+		service.Current(s.current)
 	}
 	if s.size != 0 {
-		m["size"] = s.size
+		service.Size(s.size)
 	}
-	r.setParams(m)
-	data, err := s.c.callAPI(ctx, r, opts...)
+	list, err := service.Do(ctx, opts...)
 	if err != nil {
 		return nil, err
 	}
-	var res []*SavingsFlexibleProduct
-	err = json.Unmarshal(data, &res)
-	if err != nil {
-		return nil, err
+	res := make([]*SavingsFlexibleProduct, 0, len(list.Rows))
+	for _, product := range list.Rows {
+		if (s.status == "SUBSCRIBABLE" && !product.CanPurchase) ||
+			(s.status == "UNSUBSCRIBABLE" && product.CanPurchase) ||
+			(s.featured == "TRUE" && !product.Hot) {
+			continue
+		}
+		res = append(res, &SavingsFlexibleProduct{
+			Asset:                 product.Asset,
+			AvgAnnualInterestRate: product.LatestAnnualPercentageRate,
+			CanPurchase:           product.CanPurchase,
+			CanRedeem:             product.CanRedeem,
+			Featured:              product.Hot,
+			MinPurchaseAmount:     product.MinPurchaseAmount,
+			ProductId:             product.ProductId,
+			Status:                product.Status,
+		})
 	}
 	return res, nil
 }
@@ -108,27 +115,18 @@ func (s *PurchaseSavingsFlexibleProductService) Amount(amount float64) *Purchase
 }
 
 // Do send request
+//
+// Deprecated: the lending endpoints are retired, the product is subscribed by
+// SubscribeSimpleEarnFlexibleProductService.
 func (s *PurchaseSavingsFlexibleProductService) Do(ctx context.Context, opts ...RequestOption) (uint64, error) {
-	r := &request{
-		method:   http.MethodPost,
-		endpoint: "/sapi/v1/lending/daily/purchase",
-		secType:  secTypeSigned,
-	}
-	m := params{
-		"productId": s.productId,
-		"amount":    s.amount,
-	}
-	r.setParams(m)
-	data, err := s.c.callAPI(ctx, r, opts...)
+	s.c.logDeprecated("PurchaseSavingsFlexibleProductService", "SubscribeSimpleEarnFlexibleProductService")
+	res, err := s.c.NewSubscribeSimpleEarnFlexibleProductService().
+		ProductId(s.productId).
+		Amount(strconv.FormatFloat(s.amount, 'f', -1, 64)).
+		Do(ctx, opts...)
 	if err != nil {
 		return 0, err
 	}
-
-	var res *PurchaseSavingsFlexibleProductResponse
-	if err = json.Unmarshal(data, &res); err != nil {
-		return 0, err
-	}
-
 	return res.PurchaseId, nil
 }
 
@@ -163,22 +161,16 @@ func (s *RedeemSavingsFlexibleProductService) Type(redeemType string) *RedeemSav
 }
 
 // Do send request
+//
+// Deprecated: the lending endpoints are retired, the product is redeemed by
+// RedeemSimpleEarnFlexibleProductService. Simple Earn has no redeem type, so
+// Type is ignored.
 func (s *RedeemSavingsFlexibleProductService) Do(ctx context.Context, opts ...RequestOption) error {
-	r := &request{
-		method:   http.MethodPost,
-		endpoint: "/sapi/v1/lending/daily/redeem",
-		secType:  secTypeSigned,
-	}
-	m := params{
-		"productId": s.productId,
-		"amount":    s.amount,
-	}
-	if s.redeemType != "" {
-		m["type"] = s.redeemType
-	}
-	r.setParams(m)
-	_, err := s.c.callAPI(ctx, r, opts...)
-
+	s.c.logDeprecated("RedeemSavingsFlexibleProductService", "RedeemSimpleEarnFlexibleProductService")
+	_, err := s.c.NewRedeemSimpleEarnFlexibleProductService().
+		ProductId(s.productId).
+		Amount(strconv.FormatFloat(s.amount, 'f', -1, 64)).
+		Do(ctx, opts...)
 	return err
 }
 
@@ -1,6 +1,9 @@
 package binance
 
 import (
+	"bytes"
+	"log"
+	"net/http"
 	"testing"
 
 	"github.com/stretchr/testify/suite"
@@ -15,44 +18,42 @@ func TestSavingsService(t *testing.T) {
 }
 
 func (s *savingsServiceTestSuite) TestListSavingsFlexibleProducts() {
-	data := []byte(`[
-    {
-        "asset": "BTC",
-        "avgAnnualInterestRate": "0.00250025",
-        "canPurchase": true,
-        "canRedeem": true,
-        "dailyInterestPerThousand": "0.00685000",
-        "featured": true,
-        "minPurchaseAmount": "0.01000000",
-        "productId": "BTC001",
-        "purchasedAmount": "16.32467016",
-        "status": "PURCHASING",
-        "upLimit": "200.00000000",
-        "upLimitPerUser": "5.00000000"
-    },
-    {
-        "asset": "BUSD",
-        "avgAnnualInterestRate": "0.01228590",
-        "canPurchase": true,
-        "canRedeem": true,
-        "dailyInterestPerThousand": "0.03836000",
-        "featured": true,
-        "minPurchaseAmount": "0.10000000",
-        "productId": "BUSD001",
-        "purchasedAmount": "10.38932339",
-        "status": "PURCHASING",
-        "upLimit": "100000.00000000",
-        "upLimitPerUser": "50000.00000000"
-    }
-]`)
+	data := []byte(`{
+    "rows": [
+        {
+            "asset": "BTC",
+            "latestAnnualPercentageRate": "0.00250025",
+            "tierAnnualPercentageRate": {"0-5BTC": 0.05, "5-10BTC": 0.03},
+            "airDropPercentageRate": "0.05",
+            "canPurchase": true,
+            "canRedeem": true,
+            "isSoldOut": true,
+            "hot": true,
+            "minPurchaseAmount": "0.01000000",
+            "productId": "BTC001",
+            "subscriptionStartTime": 1646182276000,
+            "status": "PURCHASING"
+        },
+        {
+            "asset": "BUSD",
+            "latestAnnualPercentageRate": "0.01228590",
+            "canPurchase": false,
+            "canRedeem": true,
+            "hot": false,
+            "minPurchaseAmount": "0.10000000",
+            "productId": "BUSD001",
+            "status": "PURCHASING"
+        }
+    ],
+    "total": 2
+}`)
 	s.mockDo(data, nil)
 	defer s.assertDo()
 	s.assertReq(func(r *request) {
+		s.r().Equal("/sapi/v1/simple-earn/flexible/list", r.endpoint)
 		e := newSignedRequest().setParams(params{
-			"status":   "ALL",
-			"featured": "ALL",
-			"current":  1,
-			"size":     50,
+			"current": 1,
+			"size":    50,
 		})
 		s.assertRequestEqual(e, r)
 	})
@@ -68,35 +69,65 @@ func (s *savingsServiceTestSuite) TestListSavingsFlexibleProducts() {
 
 	r.Len(flexibleProductList, 2)
 	s.assertSavingsFlexibleProductEqual(&SavingsFlexibleProduct{
-		Asset:                    "BTC",
-		AvgAnnualInterestRate:    "0.00250025",
-		CanPurchase:              true,
-		CanRedeem:                true,
-		DailyInterestPerThousand: "0.00685000",
-		Featured:                 true,
-		MinPurchaseAmount:        "0.01000000",
-		ProductId:                "BTC001",
-		PurchasedAmount:          "16.32467016",
-		Status:                   "PURCHASING",
-		UpLimit:                  "200.00000000",
-		UpLimitPerUser:           "5.00000000",
+		Asset:                 "BTC",
+		AvgAnnualInterestRate: "0.00250025",
+		CanPurchase:           true,
+		CanRedeem:             true,
+		Featured:              true,
+		MinPurchaseAmount:     "0.01000000",
+		ProductId:             "BTC001",
+		Status:                "PURCHASING",
 	}, flexibleProductList[0])
 	s.assertSavingsFlexibleProductEqual(&SavingsFlexibleProduct{
-		Asset:                    "BUSD",
-		AvgAnnualInterestRate:    "0.01228590",
-		CanPurchase:              true,
-		CanRedeem:                true,
-		DailyInterestPerThousand: "0.03836000",
-		Featured:                 true,
-		MinPurchaseAmount:        "0.10000000",
-		ProductId:                "BUSD001",
-		PurchasedAmount:          "10.38932339",
-		Status:                   "PURCHASING",
-		UpLimit:                  "100000.00000000",
-		UpLimitPerUser:           "50000.00000000",
+		Asset:                 "BUSD",
+		AvgAnnualInterestRate: "0.01228590",
+		CanPurchase:           false,
+		CanRedeem:             true,
+		Featured:              false,
+		MinPurchaseAmount:     "0.10000000",
+		ProductId:             "BUSD001",
+		Status:                "PURCHASING",
 	}, flexibleProductList[1])
 }
 
+func (s *savingsServiceTestSuite) TestListSavingsFlexibleProductsFilters() {
+	data := []byte(`{
+    "rows": [
+        {"asset": "BTC", "canPurchase": true, "hot": false, "productId": "BTC001"},
+        {"asset": "ETH", "canPurchase": true, "hot": true, "productId": "ETH001"},
+        {"asset": "BUSD", "canPurchase": false, "hot": true, "productId": "BUSD001"}
+    ],
+    "total": 3
+}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	flexibleProductList, err := s.client.NewListSavingsFlexibleProductsService().
+		Status("SUBSCRIBABLE").
+		Featured("TRUE").
+		Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	r.Len(flexibleProductList, 1)
+	r.Equal("ETH001", flexibleProductList[0].ProductId)
+}
+
+func (s *savingsServiceTestSuite) TestLegacyServicesLogDeprecation() {
+	var buf bytes.Buffer
+	s.client.Logger = log.New(&buf, "", 0)
+	s.client.Client.do = func(req *http.Request) (*http.Response, error) {
+		return newHTTPResponse([]byte(`{"purchaseId": 1, "success": true}`), http.StatusOK), nil
+	}
+
+	r := s.r()
+	for i := 0; i < 2; i++ {
+		_, err := s.client.NewPurchaseSavingsFlexibleProductService().ProductId("BTC001").Amount(1).Do(newContext())
+		r.NoError(err)
+	}
+	r.Equal("deprecated: PurchaseSavingsFlexibleProductService calls the Simple Earn API, "+
+		"use SubscribeSimpleEarnFlexibleProductService instead\n", buf.String())
+}
+
 func (s *savingsServiceTestSuite) assertSavingsFlexibleProductEqual(e, a *SavingsFlexibleProduct) {
 	r := s.r()
 	r.Equal(e.Asset, a.Asset, "Asset")
@@ -114,10 +145,11 @@ func (s *savingsServiceTestSuite) assertSavingsFlexibleProductEqual(e, a *Saving
 }
 
 func (s *savingsServiceTestSuite) TestPurchaseSavingsFlexibleProduct() {
-	data := []byte(`{ "purchaseId": 40607 }`)
+	data := []byte(`{ "purchaseId": 40607, "success": true }`)
 	s.mockDo(data, nil)
 	defer s.assertDo()
 	s.assertReq(func(r *request) {
+		s.r().Equal("/sapi/v1/simple-earn/flexible/subscribe", r.endpoint)
 		e := newSignedRequest().setParams(params{
 			"productId": "BTC001",
 			"amount":    0.52,
@@ -136,14 +168,14 @@ func (s *savingsServiceTestSuite) TestPurchaseSavingsFlexibleProduct() {
 }
 
 func (s *savingsServiceTestSuite) TestReedemSavingsFlexibleProduct() {
-	data := []byte(`{}`)
+	data := []byte(`{ "redeemId": 40607, "success": true }`)
 	s.mockDo(data, nil)
 	defer s.assertDo()
 	s.assertReq(func(r *request) {
+		s.r().Equal("/sapi/v1/simple-earn/flexible/redeem", r.endpoint)
 		e := newSignedRequest().setParams(params{
 			"productId": "BTC001",
 			"amount":    0.52,
-			"type":      "FAST",
 		})
 		s.assertRequestEqual(e, r)
 	})
@@ -0,0 +1,69 @@
+package binance
+
+import (
+	"container/list"
+	"sync"
+)
+
+// SignatureCache cache the signatures of request payloads, it must be safe
+// for concurrent use
+type SignatureCache interface {
+	Get(key string) (signature string, ok bool)
+	Add(key string, signature string)
+}
+
+// LRUSignatureCache is a SignatureCache keeping the most recently used
+// signatures
+type LRUSignatureCache struct {
+	mu      sync.Mutex
+	size    int
+	order   *list.List
+	entries map[string]*list.Element
+}
+
+type signatureCacheEntry struct {
+	key       string
+	signature string
+}
+
+// NewLRUSignatureCache init a signature cache holding at most size signatures
+func NewLRUSignatureCache(size int) *LRUSignatureCache {
+	return &LRUSignatureCache{
+		size:    size,
+		order:   list.New(),
+		entries: make(map[string]*list.Element, size),
+	}
+}
+
+// Get return the signature cached for key
+func (c *LRUSignatureCache) Get(key string) (string, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	e, ok := c.entries[key]
+	if !ok {
+		return "", false
+	}
+	c.order.MoveToFront(e)
+	return e.Value.(*signatureCacheEntry).signature, true
+}
+
+// Add cache the signature of key, evicting the least recently used one when
+// the cache is full
+func (c *LRUSignatureCache) Add(key string, signature string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if e, ok := c.entries[key]; ok {
+		e.Value.(*signatureCacheEntry).signature = signature
+		c.order.MoveToFront(e)
+		return
+	}
+	if c.size <= 0 {
+		return
+	}
+	if c.order.Len() >= c.size {
+		oldest := c.order.Back()
+		c.order.Remove(oldest)
+		delete(c.entries, oldest.Value.(*signatureCacheEntry).key)
+	}
+	c.entries[key] = c.order.PushFront(&signatureCacheEntry{key: key, signature: signature})
+}
@@ -0,0 +1,145 @@
+package binance
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLRUSignatureCache(t *testing.T) {
+	cache := NewLRUSignatureCache(2)
+	cache.Add("a", "sigA")
+	cache.Add("b", "sigB")
+	r := require.New(t)
+	sig, ok := cache.Get("a")
+	r.True(ok)
+	r.Equal("sigA", sig)
+
+	// b is the least recently used
+	cache.Add("c", "sigC")
+	_, ok = cache.Get("b")
+	r.False(ok)
+	sig, ok = cache.Get("a")
+	r.True(ok)
+	r.Equal("sigA", sig)
+	sig, ok = cache.Get("c")
+	r.True(ok)
+	r.Equal("sigC", sig)
+}
+
+// countingSignatureCache count the signatures missing from the cache, each
+// one being computed
+type countingSignatureCache struct {
+	SignatureCache
+	misses int
+}
+
+func (c *countingSignatureCache) Get(key string) (string, bool) {
+	signature, ok := c.SignatureCache.Get(key)
+	if !ok {
+		c.misses++
+	}
+	return signature, ok
+}
+
+func newSignatureCacheTestClient() (*Client, *[]string) {
+	c := NewClient("dummyAPIKey", "dummySecretKey")
+	c.SetClock(func() time.Time {
+		return time.Date(2021, 3, 4, 5, 6, 7, 0, time.UTC)
+	})
+	var signatures []string
+	c.do = func(req *http.Request) (*http.Response, error) {
+		signatures = append(signatures, req.URL.Query().Get(signatureKey))
+		return newHTTPResponse([]byte(`[]`), http.StatusOK), nil
+	}
+	return c, &signatures
+}
+
+func TestSetSignatureCache(t *testing.T) {
+	c, signatures := newSignatureCacheTestClient()
+	cache := &countingSignatureCache{SignatureCache: NewLRUSignatureCache(8)}
+	c.SetSignatureCache(cache)
+	r := require.New(t)
+	for i := 0; i < 3; i++ {
+		_, err := c.NewListTradesService().Symbol("BNBBTC").Do(newContext())
+		r.NoError(err)
+	}
+	_, err := c.NewListTradesService().Symbol("ETHBTC").Do(newContext())
+	r.NoError(err)
+	r.Equal(2, cache.misses)
+
+	// cached signatures are the ones computed without cache
+	c.SetSignatureCache(nil)
+	_, err = c.NewListTradesService().Symbol("BNBBTC").Do(newContext())
+	r.NoError(err)
+	r.Len(*signatures, 5)
+	r.Equal((*signatures)[4], (*signatures)[0])
+	r.Equal((*signatures)[0], (*signatures)[2])
+	r.NotEqual((*signatures)[0], (*signatures)[3])
+}
+
+func TestSignatureCacheSecretChange(t *testing.T) {
+	c, signatures := newSignatureCacheTestClient()
+	c.SetSignatureCache(NewLRUSignatureCache(8))
+	r := require.New(t)
+	_, err := c.NewListTradesService().Symbol("BNBBTC").Do(newContext())
+	r.NoError(err)
+
+	// same API key and payload signed with another secret
+	_, err = c.NewListTradesService().Symbol("BNBBTC").
+		Do(newContext(), WithCredentials("dummyAPIKey", "otherSecretKey"))
+	r.NoError(err)
+	c.SecretKey = "rotatedSecretKey"
+	_, err = c.NewListTradesService().Symbol("BNBBTC").Do(newContext())
+	r.NoError(err)
+
+	payload := "symbol=BNBBTC&timestamp=1614834367000"
+	r.Len(*signatures, 3)
+	for i, secret := range []string{"dummySecretKey", "otherSecretKey", "rotatedSecretKey"} {
+		mac := hmac.New(sha256.New, []byte(secret))
+		mac.Write([]byte(payload))
+		r.Equal(fmt.Sprintf("%x", mac.Sum(nil)), (*signatures)[i], secret)
+	}
+}
+
+func TestSignWithoutCache(t *testing.T) {
+	c, signatures := newSignatureCacheTestClient()
+	r := require.New(t)
+	_, err := c.NewListTradesService().Symbol("BNBBTC").Do(newContext())
+	r.NoError(err)
+	r.Len(*signatures, 1)
+	// no cache key, and so no secret digest, is computed without a cache
+	digests := 0
+	c.secretDigests.Range(func(key, value interface{}) bool {
+		digests++
+		return true
+	})
+	r.Equal(0, digests)
+}
+
+func BenchmarkSignatureCache(b *testing.B) {
+	bench := func(b *testing.B, cache SignatureCache) {
+		c, _ := newSignatureCacheTestClient()
+		counting := &countingSignatureCache{SignatureCache: cache}
+		c.SetSignatureCache(counting)
+		b.ReportAllocs()
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			if _, err := c.NewListTradesService().Symbol("BNBBTC").Do(newContext()); err != nil {
+				b.Fatal(err)
+			}
+		}
+		b.ReportMetric(float64(counting.misses)/float64(b.N), "hmacs/op")
+	}
+	b.Run("NoCache", func(b *testing.B) {
+		bench(b, NewLRUSignatureCache(0))
+	})
+	b.Run("LRU", func(b *testing.B) {
+		bench(b, NewLRUSignatureCache(64))
+	})
+}
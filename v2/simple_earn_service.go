@@ -0,0 +1,245 @@
+package binance
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+)
+
+// ListSimpleEarnFlexibleProductsService https://binance-docs.github.io/apidocs/spot/en/#get-simple-earn-flexible-product-list-user_data
+type ListSimpleEarnFlexibleProductsService struct {
+	c       *Client
+	asset   *string
+	current *int64
+	size    *int64
+}
+
+// Asset set asset
+func (s *ListSimpleEarnFlexibleProductsService) Asset(asset string) *ListSimpleEarnFlexibleProductsService {
+	s.asset = &asset
+	return s
+}
+
+// Current query page. Default: 1, Min: 1
+func (s *ListSimpleEarnFlexibleProductsService) Current(current int64) *ListSimpleEarnFlexibleProductsService {
+	s.current = &current
+	return s
+}
+
+// Size Default: 10, Max: 100
+func (s *ListSimpleEarnFlexibleProductsService) Size(size int64) *ListSimpleEarnFlexibleProductsService {
+	s.size = &size
+	return s
+}
+
+// Do send request
+func (s *ListSimpleEarnFlexibleProductsService) Do(ctx context.Context, opts ...RequestOption) (*SimpleEarnFlexibleProductList, error) {
+	r := &request{
+		method:   http.MethodGet,
+		endpoint: "/sapi/v1/simple-earn/flexible/list",
+		secType:  secTypeSigned,
+	}
+	if s.asset != nil {
+		r.setParam("asset", *s.asset)
+	}
+	if s.current != nil {
+		r.setParam("current", *s.current)
+	}
+	if s.size != nil {
+		r.setParam("size", *s.size)
+	}
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res := new(SimpleEarnFlexibleProductList)
+	if err = json.Unmarshal(data, res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// SimpleEarnFlexibleProductList define a page of flexible products (Simple Earn)
+type SimpleEarnFlexibleProductList struct {
+	Rows  []*SimpleEarnFlexibleProduct `json:"rows"`
+	Total int64                        `json:"total"`
+}
+
+// SimpleEarnFlexibleProduct define a flexible product (Simple Earn)
+type SimpleEarnFlexibleProduct struct {
+	Asset                      string `json:"asset"`
+	LatestAnnualPercentageRate string `json:"latestAnnualPercentageRate"`
+	AirDropPercentageRate      string `json:"airDropPercentageRate"`
+	CanPurchase                bool   `json:"canPurchase"`
+	CanRedeem                  bool   `json:"canRedeem"`
+	IsSoldOut                  bool   `json:"isSoldOut"`
+	Hot                        bool   `json:"hot"`
+	MinPurchaseAmount          string `json:"minPurchaseAmount"`
+	ProductId                  string `json:"productId"`
+	SubscriptionStartTime      int64  `json:"subscriptionStartTime"`
+	Status                     string `json:"status"`
+}
+
+// SubscribeSimpleEarnFlexibleProductService https://binance-docs.github.io/apidocs/spot/en/#subscribe-flexible-product-trade
+type SubscribeSimpleEarnFlexibleProductService struct {
+	c             *Client
+	productId     string
+	amount        string
+	autoSubscribe *bool
+}
+
+// ProductId represent the id of the flexible product to subscribe
+func (s *SubscribeSimpleEarnFlexibleProductService) ProductId(productId string) *SubscribeSimpleEarnFlexibleProductService {
+	s.productId = productId
+	return s
+}
+
+// Amount is the quantity of the product to subscribe
+func (s *SubscribeSimpleEarnFlexibleProductService) Amount(amount string) *SubscribeSimpleEarnFlexibleProductService {
+	s.amount = amount
+	return s
+}
+
+// AutoSubscribe set auto subscribe - Default: true
+func (s *SubscribeSimpleEarnFlexibleProductService) AutoSubscribe(autoSubscribe bool) *SubscribeSimpleEarnFlexibleProductService {
+	s.autoSubscribe = &autoSubscribe
+	return s
+}
+
+// Do send request
+func (s *SubscribeSimpleEarnFlexibleProductService) Do(ctx context.Context, opts ...RequestOption) (*SimpleEarnSubscribeResponse, error) {
+	r := &request{
+		method:   http.MethodPost,
+		endpoint: "/sapi/v1/simple-earn/flexible/subscribe",
+		secType:  secTypeSigned,
+	}
+	m := params{
+		"productId": s.productId,
+		"amount":    s.amount,
+	}
+	if s.autoSubscribe != nil {
+		m["autoSubscribe"] = *s.autoSubscribe
+	}
+	r.setParams(m)
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res := new(SimpleEarnSubscribeResponse)
+	if err = json.Unmarshal(data, res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// SimpleEarnSubscribeResponse define subscribe flexible product response
+type SimpleEarnSubscribeResponse struct {
+	PurchaseId uint64 `json:"purchaseId"`
+	Success    bool   `json:"success"`
+}
+
+// RedeemSimpleEarnFlexibleProductService https://binance-docs.github.io/apidocs/spot/en/#redeem-flexible-product-trade
+type RedeemSimpleEarnFlexibleProductService struct {
+	c           *Client
+	productId   string
+	redeemAll   *bool
+	amount      *string
+	destAccount *string
+}
+
+// ProductId represent the id of the flexible product to redeem
+func (s *RedeemSimpleEarnFlexibleProductService) ProductId(productId string) *RedeemSimpleEarnFlexibleProductService {
+	s.productId = productId
+	return s
+}
+
+// RedeemAll redeem the whole position, amount is then ignored
+func (s *RedeemSimpleEarnFlexibleProductService) RedeemAll(redeemAll bool) *RedeemSimpleEarnFlexibleProductService {
+	s.redeemAll = &redeemAll
+	return s
+}
+
+// Amount is the quantity of the product to redeem
+func (s *RedeemSimpleEarnFlexibleProductService) Amount(amount string) *RedeemSimpleEarnFlexibleProductService {
+	s.amount = &amount
+	return s
+}
+
+// DestAccount ("SPOT", "FUND") - Default: "SPOT"
+func (s *RedeemSimpleEarnFlexibleProductService) DestAccount(destAccount string) *RedeemSimpleEarnFlexibleProductService {
+	s.destAccount = &destAccount
+	return s
+}
+
+// Do send request
+func (s *RedeemSimpleEarnFlexibleProductService) Do(ctx context.Context, opts ...RequestOption) (*SimpleEarnRedeemResponse, error) {
+	r := &request{
+		method:   http.MethodPost,
+		endpoint: "/sapi/v1/simple-earn/flexible/redeem",
+		secType:  secTypeSigned,
+	}
+	m := params{
+		"productId": s.productId,
+	}
+	if s.redeemAll != nil {
+		m["redeemAll"] = *s.redeemAll
+	}
+	if s.amount != nil {
+		m["amount"] = *s.amount
+	}
+	if s.destAccount != nil {
+		m["destAccount"] = *s.destAccount
+	}
+	r.setParams(m)
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res := new(SimpleEarnRedeemResponse)
+	if err = json.Unmarshal(data, res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// SimpleEarnRedeemResponse define redeem flexible product response
+type SimpleEarnRedeemResponse struct {
+	RedeemId uint64 `json:"redeemId"`
+	Success  bool   `json:"success"`
+}
+
+// GetSimpleEarnFlexiblePersonalLeftQuotaService https://binance-docs.github.io/apidocs/spot/en/#get-flexible-personal-left-quota-user_data
+type GetSimpleEarnFlexiblePersonalLeftQuotaService struct {
+	c         *Client
+	productId string
+}
+
+// ProductId to resolve quota for product
+func (s *GetSimpleEarnFlexiblePersonalLeftQuotaService) ProductId(productId string) *GetSimpleEarnFlexiblePersonalLeftQuotaService {
+	s.productId = productId
+	return s
+}
+
+// Do send request
+func (s *GetSimpleEarnFlexiblePersonalLeftQuotaService) Do(ctx context.Context, opts ...RequestOption) (*SimpleEarnPersonalLeftQuota, error) {
+	r := &request{
+		method:   http.MethodGet,
+		endpoint: "/sapi/v1/simple-earn/flexible/personalLeftQuota",
+		secType:  secTypeSigned,
+	}
+	r.setParam("productId", s.productId)
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res := new(SimpleEarnPersonalLeftQuota)
+	if err = json.Unmarshal(data, res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// SimpleEarnPersonalLeftQuota define the personal left quota of a flexible product
+type SimpleEarnPersonalLeftQuota struct {
+	LeftPersonalQuota string `json:"leftPersonalQuota"`
+}
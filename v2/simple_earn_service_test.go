@@ -0,0 +1,105 @@
+package binance
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type simpleEarnServiceTestSuite struct {
+	baseTestSuite
+}
+
+func TestSimpleEarnService(t *testing.T) {
+	suite.Run(t, new(simpleEarnServiceTestSuite))
+}
+
+func (s *simpleEarnServiceTestSuite) TestListFlexibleProducts() {
+	data := []byte(`{
+		"rows": [
+			{
+				"asset": "BTC",
+				"latestAnnualPercentageRate": "0.05000000",
+				"airDropPercentageRate": "0.05000000",
+				"canPurchase": true,
+				"canRedeem": true,
+				"isSoldOut": false,
+				"hot": true,
+				"minPurchaseAmount": "0.01000000",
+				"productId": "BTC001",
+				"subscriptionStartTime": 1646182276000,
+				"status": "PURCHASING"
+			}
+		],
+		"total": 1
+	}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setParams(params{
+			"asset":   "BTC",
+			"current": 1,
+			"size":    10,
+		})
+		s.assertRequestEqual(e, r)
+	})
+
+	res, err := s.client.NewListSimpleEarnFlexibleProductsService().Asset("BTC").Current(1).Size(10).Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	r.Equal(&SimpleEarnFlexibleProductList{
+		Rows: []*SimpleEarnFlexibleProduct{
+			{
+				Asset:                      "BTC",
+				LatestAnnualPercentageRate: "0.05000000",
+				AirDropPercentageRate:      "0.05000000",
+				CanPurchase:                true,
+				CanRedeem:                  true,
+				Hot:                        true,
+				MinPurchaseAmount:          "0.01000000",
+				ProductId:                  "BTC001",
+				SubscriptionStartTime:      1646182276000,
+				Status:                     "PURCHASING",
+			},
+		},
+		Total: 1,
+	}, res)
+}
+
+func (s *simpleEarnServiceTestSuite) TestSubscribeFlexibleProduct() {
+	s.mockDo([]byte(`{"purchaseId": 40607, "success": true}`), nil)
+	defer s.assertDo()
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setParams(params{
+			"productId":     "BTC001",
+			"amount":        "0.5",
+			"autoSubscribe": false,
+		})
+		s.assertRequestEqual(e, r)
+	})
+
+	res, err := s.client.NewSubscribeSimpleEarnFlexibleProductService().
+		ProductId("BTC001").Amount("0.5").AutoSubscribe(false).Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	r.Equal(&SimpleEarnSubscribeResponse{PurchaseId: 40607, Success: true}, res)
+}
+
+func (s *simpleEarnServiceTestSuite) TestRedeemFlexibleProduct() {
+	s.mockDo([]byte(`{"redeemId": 40607, "success": true}`), nil)
+	defer s.assertDo()
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setParams(params{
+			"productId":   "BTC001",
+			"redeemAll":   true,
+			"destAccount": "FUND",
+		})
+		s.assertRequestEqual(e, r)
+	})
+
+	res, err := s.client.NewRedeemSimpleEarnFlexibleProductService().
+		ProductId("BTC001").RedeemAll(true).DestAccount("FUND").Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	r.Equal(&SimpleEarnRedeemResponse{RedeemId: 40607, Success: true}, res)
+}
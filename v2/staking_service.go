@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"net/http"
+	"time"
 )
 
 // ListStakingProductsService https://binance-docs.github.io/apidocs/spot/en/#get-staking-product-list-user_data
@@ -72,6 +73,38 @@ func (s *ListStakingProductsService) Do(ctx context.Context, opts ...RequestOpti
 	return res, nil
 }
 
+// Iterate pages through all the staking products matching the filters, calling
+// fn for every product. Pages are requested from Current (default 1) with Size
+// (default 10) products until a short page is returned. Returning an error
+// from fn, or cancelling ctx, stops the iteration and that error is returned.
+func (s *ListStakingProductsService) Iterate(ctx context.Context, fn func(*StakingProduct) error, opts ...RequestOption) error {
+	page := *s
+	if page.current == 0 {
+		page.current = 1
+	}
+	if page.size == 0 {
+		page.size = 10
+	}
+	for {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		products, err := page.Do(ctx, opts...)
+		if err != nil {
+			return err
+		}
+		for _, p := range products {
+			if err = fn(p); err != nil {
+				return err
+			}
+		}
+		if int64(len(products)) < page.size {
+			return nil
+		}
+		page.current++
+	}
+}
+
 // StakingProduct define a staking product
 type StakingProduct struct {
 	ProjectId string `json:"projectId"`
@@ -326,12 +359,22 @@ func (s *GetStakingHistory) StartTime(startTime int64) *GetStakingHistory {
 	return s
 }
 
+// StartTimeFrom set startTime from t, converted to epoch milliseconds
+func (s *GetStakingHistory) StartTimeFrom(t time.Time) *GetStakingHistory {
+	return s.StartTime(FormatTimestamp(t))
+}
+
 // EndTime set endtime
 func (s *GetStakingHistory) EndTime(endTime int64) *GetStakingHistory {
 	s.endTime = &endTime
 	return s
 }
 
+// EndTimeFrom set endTime from t, converted to epoch milliseconds
+func (s *GetStakingHistory) EndTimeFrom(t time.Time) *GetStakingHistory {
+	return s.EndTime(FormatTimestamp(t))
+}
+
 // Current query page. Default: 1, Min: 1
 func (s *GetStakingHistory) Current(current int64) *GetStakingHistory {
 	s.current = current
@@ -409,28 +452,14 @@ func (s *GetStakingLeftDailyPurchaseQuota) Product(productId string) *GetStaking
 }
 
 // Do send request
+//
+// Deprecated: the lending endpoints are retired, the quota is read by
+// GetSimpleEarnFlexiblePersonalLeftQuotaService.
 func (s *GetStakingLeftDailyPurchaseQuota) Do(ctx context.Context, opts ...RequestOption) (string, error) {
-	r := &request{
-		method:   http.MethodGet,
-		endpoint: "/sapi/v1/lending/daily/userLeftQuota",
-		secType:  secTypeSigned,
-	}
-	m := params{
-		"productId": s.productId,
-	}
-	r.setParams(m)
-	data, err := s.c.callAPI(ctx, r, opts...)
+	s.c.logDeprecated("GetStakingLeftDailyPurchaseQuota", "GetSimpleEarnFlexiblePersonalLeftQuotaService")
+	res, err := s.c.NewGetSimpleEarnFlexiblePersonalLeftQuotaService().ProductId(s.productId).Do(ctx, opts...)
 	if err != nil {
 		return "", err
 	}
-	res := struct {
-		LeftQuota string
-	}{}
-
-	err = json.Unmarshal(data, &res)
-	if err != nil {
-		return "", err
-	}
-
-	return res.LeftQuota, nil
+	return res.LeftPersonalQuota, nil
 }
@@ -0,0 +1,69 @@
+package binance
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type stakingServiceTestSuite struct {
+	baseTestSuite
+}
+
+func TestStakingService(t *testing.T) {
+	suite.Run(t, new(stakingServiceTestSuite))
+}
+
+func (s *stakingServiceTestSuite) TestIterateProducts() {
+	page1 := []byte(`[
+		{"projectId": "Axs*90", "detail": {"asset": "AXS", "rewardAsset": "AXS", "duration": 90, "renewable": true, "apy": "1.2069"}, "quota": {"totalPersonalQuota": "2", "minimum": "0.001"}},
+		{"projectId": "Axs*60", "detail": {"asset": "AXS", "rewardAsset": "AXS", "duration": 60, "renewable": true, "apy": "1.0512"}, "quota": {"totalPersonalQuota": "2", "minimum": "0.001"}}
+	]`)
+	page2 := []byte(`[
+		{"projectId": "Axs*30", "detail": {"asset": "AXS", "rewardAsset": "AXS", "duration": 30, "renewable": true, "apy": "0.8543"}, "quota": {"totalPersonalQuota": "2", "minimum": "0.001"}}
+	]`)
+	s.client.Client.do = s.client.do
+	s.client.On("do", anyHTTPRequest()).Return(newHTTPResponse(page1, 200), nil).Once()
+	s.client.On("do", anyHTTPRequest()).Return(newHTTPResponse(page2, 200), nil).Once()
+	defer s.assertDo()
+
+	calls := 0
+	s.assertReq(func(r *request) {
+		calls++
+		e := newSignedRequest().setParams(params{
+			"product": "STAKING",
+			"asset":   "AXS",
+			"current": calls,
+			"size":    2,
+		})
+		s.assertRequestEqual(e, r)
+	})
+
+	var ids []string
+	err := s.client.NewListStakingProductsService().Product("STAKING").Asset("AXS").Size(2).
+		Iterate(newContext(), func(p *StakingProduct) error {
+			ids = append(ids, p.ProjectId)
+			return nil
+		})
+	r := s.r()
+	r.NoError(err)
+	r.Equal(2, calls)
+	r.Equal([]string{"Axs*90", "Axs*60", "Axs*30"}, ids)
+}
+
+func (s *stakingServiceTestSuite) TestGetStakingLeftQuota() {
+	s.mockDo([]byte(`{"leftPersonalQuota": "1000"}`), nil)
+	defer s.assertDo()
+	s.assertReq(func(r *request) {
+		s.r().Equal("/sapi/v1/simple-earn/flexible/personalLeftQuota", r.endpoint)
+		e := newSignedRequest().setParams(params{
+			"productId": "BUSD001",
+		})
+		s.assertRequestEqual(e, r)
+	})
+
+	quota, err := s.client.NewGetStakingLeftQuota().Product("BUSD001").Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	r.Equal("1000", quota)
+}
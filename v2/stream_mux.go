@@ -0,0 +1,211 @@
+package binance
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/adshao/go-binance/v2/common"
+	"github.com/gorilla/websocket"
+)
+
+// streamMuxReconnectDelay is the delay before a StreamMux reconnects
+var streamMuxReconnectDelay = time.Second
+
+// StreamMux serve many streams on a single combined stream connection and route
+// the messages of every stream to the handler added for it. After connection
+// errors it reconnects and subscribes the streams again.
+type StreamMux struct {
+	endpoint   string
+	errHandler ErrHandler
+	opts       []WsOption
+
+	mu       sync.Mutex
+	handlers map[string]WsHandler
+	conn     *websocket.Conn
+	cfg      *WsConfig
+	id       int64
+
+	closeOnce sync.Once
+	stopC     chan struct{}
+	doneC     chan struct{}
+}
+
+// streamMuxRequest define a subscription request of a combined stream
+type streamMuxRequest struct {
+	Method string   `json:"method"`
+	Params []string `json:"params"`
+	ID     int64    `json:"id"`
+}
+
+// streamMuxMessage define a message of a combined stream, either the data of a
+// stream or the response to a subscription request
+type streamMuxMessage struct {
+	Stream string          `json:"stream"`
+	Data   json.RawMessage `json:"data"`
+	ID     int64           `json:"id"`
+	Error  *struct {
+		Code int64  `json:"code"`
+		Msg  string `json:"msg"`
+	} `json:"error"`
+}
+
+// NewStreamMux serve a combined stream until Close is called, errHandler is
+// called for connection errors and rejected subscriptions. opts apply to every
+// connection, including the ones after a reconnect.
+func NewStreamMux(errHandler ErrHandler, opts ...WsOption) *StreamMux {
+	return newStreamMux(strings.TrimSuffix(getCombinedEndpoint(), "?streams="), errHandler, opts...)
+}
+
+func newStreamMux(endpoint string, errHandler ErrHandler, opts ...WsOption) *StreamMux {
+	m := &StreamMux{
+		endpoint:   endpoint,
+		errHandler: errHandler,
+		opts:       opts,
+		handlers:   make(map[string]WsHandler),
+		stopC:      make(chan struct{}),
+		doneC:      make(chan struct{}),
+	}
+	go m.run()
+	return m
+}
+
+// Add route the messages of stream, e.g. "btcusdt@kline_1m", to handler which
+// receives the data of each message. Adding a stream again replaces its handler.
+func (m *StreamMux) Add(stream string, handler WsHandler) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	_, exists := m.handlers[stream]
+	m.handlers[stream] = handler
+	if exists || m.conn == nil {
+		// subscribed when connected
+		return nil
+	}
+	return m.send("SUBSCRIBE", []string{stream})
+}
+
+// Remove unsubscribe stream and drop its messages
+func (m *StreamMux) Remove(stream string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if _, exists := m.handlers[stream]; !exists {
+		return nil
+	}
+	delete(m.handlers, stream)
+	if m.conn == nil {
+		return nil
+	}
+	return m.send("UNSUBSCRIBE", []string{stream})
+}
+
+// Close the connection and stop reconnecting, it returns once the running
+// handler, if any, returned, so it must not be called from a handler
+func (m *StreamMux) Close() {
+	m.closeOnce.Do(func() {
+		close(m.stopC)
+	})
+	m.mu.Lock()
+	if m.conn != nil {
+		m.conn.Close()
+	}
+	m.mu.Unlock()
+	<-m.doneC
+}
+
+func (m *StreamMux) run() {
+	defer close(m.doneC)
+	for {
+		err := m.serve()
+		select {
+		case <-m.stopC:
+			return
+		default:
+		}
+		m.errHandler(err)
+		select {
+		case <-m.stopC:
+			return
+		case <-time.After(streamMuxReconnectDelay):
+		}
+	}
+}
+
+// serve connect, subscribe the added streams and dispatch messages until the
+// connection fails
+func (m *StreamMux) serve() error {
+	cfg := newWsConfig(m.endpoint, m.opts...)
+	c, err := common.DialWs(cfg)
+	if err != nil {
+		return err
+	}
+	defer c.Close()
+	if cfg.Keepalive {
+		common.KeepAlive(c, cfg)
+	}
+
+	m.mu.Lock()
+	select {
+	case <-m.stopC:
+		m.mu.Unlock()
+		return nil
+	default:
+	}
+	m.conn = c
+	m.cfg = cfg
+	streams := make([]string, 0, len(m.handlers))
+	for stream := range m.handlers {
+		streams = append(streams, stream)
+	}
+	if len(streams) > 0 {
+		sort.Strings(streams)
+		err = m.send("SUBSCRIBE", streams)
+	}
+	m.mu.Unlock()
+	defer func() {
+		m.mu.Lock()
+		m.conn = nil
+		m.mu.Unlock()
+	}()
+	if err != nil {
+		return err
+	}
+
+	for {
+		common.ExtendReadDeadline(c, cfg)
+		_, message, err := c.ReadMessage()
+		if err != nil {
+			return err
+		}
+		m.dispatch(message)
+	}
+}
+
+func (m *StreamMux) dispatch(message []byte) {
+	msg := new(streamMuxMessage)
+	if err := json.Unmarshal(message, msg); err != nil {
+		m.errHandler(err)
+		return
+	}
+	if msg.Stream == "" {
+		if msg.Error != nil {
+			m.errHandler(fmt.Errorf("stream request %d failed: code=%d, msg=%s", msg.ID, msg.Error.Code, msg.Error.Msg))
+		}
+		return
+	}
+	m.mu.Lock()
+	handler, ok := m.handlers[msg.Stream]
+	m.mu.Unlock()
+	if ok {
+		handler(msg.Data)
+	}
+}
+
+// send must be called with mu held and a connection
+func (m *StreamMux) send(method string, streams []string) error {
+	m.id++
+	m.conn.SetWriteDeadline(time.Now().Add(m.cfg.WriteTimeout))
+	return m.conn.WriteJSON(streamMuxRequest{Method: method, Params: streams, ID: m.id})
+}
@@ -0,0 +1,144 @@
+package binance
+
+import (
+	"encoding/json"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/require"
+)
+
+// serveCombinedStreams answer every SUBSCRIBE with one message per stream, the
+// data of which is the stream name, and record the requests received
+func serveCombinedStreams(requestC chan<- streamMuxRequest) func(c *websocket.Conn) {
+	return func(c *websocket.Conn) {
+		for {
+			var req streamMuxRequest
+			if err := c.ReadJSON(&req); err != nil {
+				return
+			}
+			requestC <- req
+			c.WriteJSON(map[string]interface{}{"result": nil, "id": req.ID})
+			if req.Method != "SUBSCRIBE" {
+				continue
+			}
+			for _, stream := range req.Params {
+				c.WriteMessage(websocket.TextMessage, []byte(fmt.Sprintf(`{"stream":%q,"data":{"s":%q}}`, stream, stream)))
+			}
+		}
+	}
+}
+
+// recordStream collect the data of stream
+type recordStream struct {
+	mu   sync.Mutex
+	data []string
+	msgC chan struct{}
+}
+
+func newRecordStream() *recordStream {
+	return &recordStream{msgC: make(chan struct{}, 10)}
+}
+
+func (r *recordStream) handle(message []byte) {
+	var data struct {
+		Stream string `json:"s"`
+	}
+	json.Unmarshal(message, &data)
+	r.mu.Lock()
+	r.data = append(r.data, data.Stream)
+	r.mu.Unlock()
+	r.msgC <- struct{}{}
+}
+
+func (r *recordStream) wait(t *testing.T) []string {
+	select {
+	case <-r.msgC:
+	case <-time.After(time.Second):
+		require.FailNow(t, "no message routed")
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]string(nil), r.data...)
+}
+
+func waitRequest(t *testing.T, requestC <-chan streamMuxRequest) streamMuxRequest {
+	select {
+	case req := <-requestC:
+		return req
+	case <-time.After(time.Second):
+		require.FailNow(t, "no request received")
+	}
+	return streamMuxRequest{}
+}
+
+func TestStreamMuxRouting(t *testing.T) {
+	requestC := make(chan streamMuxRequest, 10)
+	srv, endpoint := newWsTestServer(serveCombinedStreams(requestC))
+	defer srv.Close()
+
+	r := require.New(t)
+	mux := newStreamMux(endpoint, func(err error) {})
+	defer mux.Close()
+	btc, eth := newRecordStream(), newRecordStream()
+	r.NoError(mux.Add("btcusdt@kline_1m", btc.handle))
+	r.NoError(mux.Add("ethusdt@kline_1m", eth.handle))
+
+	r.Equal([]string{"btcusdt@kline_1m"}, btc.wait(t))
+	r.Equal([]string{"ethusdt@kline_1m"}, eth.wait(t))
+
+	// drain the subscriptions, either one request or one per stream
+	subscribed := map[string]bool{}
+	for len(subscribed) < 2 {
+		req := waitRequest(t, requestC)
+		r.Equal("SUBSCRIBE", req.Method)
+		for _, stream := range req.Params {
+			subscribed[stream] = true
+		}
+	}
+
+	r.NoError(mux.Remove("ethusdt@kline_1m"))
+	req := waitRequest(t, requestC)
+	r.Equal("UNSUBSCRIBE", req.Method)
+	r.Equal([]string{"ethusdt@kline_1m"}, req.Params)
+}
+
+func TestStreamMuxResubscribe(t *testing.T) {
+	requestC := make(chan streamMuxRequest, 10)
+	conns := 0
+	var mu sync.Mutex
+	srv, endpoint := newWsTestServer(func(c *websocket.Conn) {
+		mu.Lock()
+		conns++
+		first := conns == 1
+		mu.Unlock()
+		if first {
+			// drop the first connection once the stream is subscribed
+			var req streamMuxRequest
+			c.ReadJSON(&req)
+			return
+		}
+		serveCombinedStreams(requestC)(c)
+	})
+	defer srv.Close()
+
+	delay := streamMuxReconnectDelay
+	streamMuxReconnectDelay = 10 * time.Millisecond
+	defer func() { streamMuxReconnectDelay = delay }()
+
+	errC := make(chan error, 10)
+	r := require.New(t)
+	btc := newRecordStream()
+	mux := newStreamMux(endpoint, func(err error) { errC <- err })
+	defer mux.Close()
+	r.NoError(mux.Add("btcusdt@trade", btc.handle))
+
+	r.Equal([]string{"btcusdt@trade"}, btc.wait(t))
+	req := waitRequest(t, requestC)
+	r.Equal("SUBSCRIBE", req.Method)
+	r.Equal([]string{"btcusdt@trade"}, req.Params)
+	r.NotEmpty(errC, "connection error reported")
+}
@@ -3,6 +3,7 @@ package binance
 import (
 	"context"
 	"encoding/json"
+	"net/http"
 )
 
 // TransferToSubAccountService transfer to subaccount
@@ -195,3 +196,182 @@ type SpotSubUserAssetBtcVoList struct {
 	Email      string `json:"email"`
 	TotalAsset string `json:"totalAsset"`
 }
+
+// SubAccountEnableFuturesService enable futures for a sub-account
+type SubAccountEnableFuturesService struct {
+	c     *Client
+	email string
+}
+
+// Email set sub-account email
+func (s *SubAccountEnableFuturesService) Email(email string) *SubAccountEnableFuturesService {
+	s.email = email
+	return s
+}
+
+// Do send request
+func (s *SubAccountEnableFuturesService) Do(ctx context.Context, opts ...RequestOption) (res *SubAccountFuturesEnabled, err error) {
+	r := &request{
+		method:   http.MethodPost,
+		endpoint: "/sapi/v1/sub-account/futures/enable",
+		secType:  secTypeSigned,
+	}
+	r.setFormParam("email", s.email)
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(SubAccountFuturesEnabled)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// SubAccountFuturesEnabled define enable sub-account futures response
+type SubAccountFuturesEnabled struct {
+	Email            string `json:"email"`
+	IsFuturesEnabled bool   `json:"isFuturesEnabled"`
+}
+
+// SubAccountEnableMarginService enable margin for a sub-account
+type SubAccountEnableMarginService struct {
+	c     *Client
+	email string
+}
+
+// Email set sub-account email
+func (s *SubAccountEnableMarginService) Email(email string) *SubAccountEnableMarginService {
+	s.email = email
+	return s
+}
+
+// Do send request
+func (s *SubAccountEnableMarginService) Do(ctx context.Context, opts ...RequestOption) (res *SubAccountMarginEnabled, err error) {
+	r := &request{
+		method:   http.MethodPost,
+		endpoint: "/sapi/v1/sub-account/margin/enable",
+		secType:  secTypeSigned,
+	}
+	r.setFormParam("email", s.email)
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(SubAccountMarginEnabled)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// SubAccountMarginEnabled define enable sub-account margin response
+type SubAccountMarginEnabled struct {
+	Email           string `json:"email"`
+	IsMarginEnabled bool   `json:"isMarginEnabled"`
+}
+
+// GetSubAccountFuturesSummaryService get futures account summary of the sub-accounts
+type GetSubAccountFuturesSummaryService struct {
+	c           *Client
+	futuresType int
+	page        *int
+	limit       *int
+}
+
+// FuturesType set futures type, 1: USDT-M, 2: COIN-M
+func (s *GetSubAccountFuturesSummaryService) FuturesType(futuresType int) *GetSubAccountFuturesSummaryService {
+	s.futuresType = futuresType
+	return s
+}
+
+// Page set page, default 1
+func (s *GetSubAccountFuturesSummaryService) Page(page int) *GetSubAccountFuturesSummaryService {
+	s.page = &page
+	return s
+}
+
+// Limit set limit, default 10 max 20
+func (s *GetSubAccountFuturesSummaryService) Limit(limit int) *GetSubAccountFuturesSummaryService {
+	s.limit = &limit
+	return s
+}
+
+// Do send request
+func (s *GetSubAccountFuturesSummaryService) Do(ctx context.Context, opts ...RequestOption) (res *SubAccountFuturesSummary, err error) {
+	r := &request{
+		method:   http.MethodGet,
+		endpoint: "/sapi/v2/sub-account/futures/accountSummary",
+		secType:  secTypeSigned,
+	}
+	r.setParam("futuresType", s.futuresType)
+	if s.page != nil {
+		r.setParam("page", *s.page)
+	}
+	if s.limit != nil {
+		r.setParam("limit", *s.limit)
+	}
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(SubAccountFuturesSummary)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// SubAccountFuturesSummary define futures account summary of the sub-accounts,
+// only the summary of the requested futures type is set
+type SubAccountFuturesSummary struct {
+	FutureAccountSummary   *SubAccountUSDTFuturesSummary `json:"futureAccountSummaryResp"`
+	DeliveryAccountSummary *SubAccountCoinFuturesSummary `json:"deliveryAccountSummaryResp"`
+}
+
+// SubAccountUSDTFuturesSummary define USDT-M futures account summary
+type SubAccountUSDTFuturesSummary struct {
+	TotalInitialMargin          string                       `json:"totalInitialMargin"`
+	TotalMaintenanceMargin      string                       `json:"totalMaintenanceMargin"`
+	TotalMarginBalance          string                       `json:"totalMarginBalance"`
+	TotalOpenOrderInitialMargin string                       `json:"totalOpenOrderInitialMargin"`
+	TotalPositionInitialMargin  string                       `json:"totalPositionInitialMargin"`
+	TotalUnrealizedProfit       string                       `json:"totalUnrealizedProfit"`
+	TotalWalletBalance          string                       `json:"totalWalletBalance"`
+	Asset                       string                       `json:"asset"`
+	SubAccountList              []SubAccountUSDTFuturesAsset `json:"subAccountList"`
+}
+
+// SubAccountUSDTFuturesAsset define USDT-M futures asset summary of a sub-account
+type SubAccountUSDTFuturesAsset struct {
+	Email                       string `json:"email"`
+	TotalInitialMargin          string `json:"totalInitialMargin"`
+	TotalMaintenanceMargin      string `json:"totalMaintenanceMargin"`
+	TotalMarginBalance          string `json:"totalMarginBalance"`
+	TotalOpenOrderInitialMargin string `json:"totalOpenOrderInitialMargin"`
+	TotalPositionInitialMargin  string `json:"totalPositionInitialMargin"`
+	TotalUnrealizedProfit       string `json:"totalUnrealizedProfit"`
+	TotalWalletBalance          string `json:"totalWalletBalance"`
+	Asset                       string `json:"asset"`
+}
+
+// SubAccountCoinFuturesSummary define COIN-M futures account summary
+type SubAccountCoinFuturesSummary struct {
+	TotalMarginBalanceOfBTC    string                       `json:"totalMarginBalanceOfBTC"`
+	TotalUnrealizedProfitOfBTC string                       `json:"totalUnrealizedProfitOfBTC"`
+	TotalWalletBalanceOfBTC    string                       `json:"totalWalletBalanceOfBTC"`
+	Asset                      string                       `json:"asset"`
+	SubAccountList             []SubAccountCoinFuturesAsset `json:"subAccountList"`
+}
+
+// SubAccountCoinFuturesAsset define COIN-M futures asset summary of a sub-account
+type SubAccountCoinFuturesAsset struct {
+	Email                 string `json:"email"`
+	TotalMarginBalance    string `json:"totalMarginBalance"`
+	TotalUnrealizedProfit string `json:"totalUnrealizedProfit"`
+	TotalWalletBalance    string `json:"totalWalletBalance"`
+	Asset                 string `json:"asset"`
+}
@@ -0,0 +1,120 @@
+package binance
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type subAccountServiceTestSuite struct {
+	baseTestSuite
+}
+
+func TestSubAccountService(t *testing.T) {
+	suite.Run(t, new(subAccountServiceTestSuite))
+}
+
+func (s *subAccountServiceTestSuite) TestEnableFutures() {
+	data := []byte(`{
+		"email": "sub@example.com",
+		"isFuturesEnabled": true
+	}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+	email := "sub@example.com"
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setFormParams(params{
+			"email": email,
+		})
+		s.assertRequestEqual(e, r)
+	})
+	res, err := s.client.NewSubAccountEnableFuturesService().Email(email).Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	r.Equal(&SubAccountFuturesEnabled{
+		Email:            email,
+		IsFuturesEnabled: true,
+	}, res)
+}
+
+func (s *subAccountServiceTestSuite) TestEnableMargin() {
+	data := []byte(`{
+		"email": "sub@example.com",
+		"isMarginEnabled": true
+	}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+	email := "sub@example.com"
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setFormParams(params{
+			"email": email,
+		})
+		s.assertRequestEqual(e, r)
+	})
+	res, err := s.client.NewSubAccountEnableMarginService().Email(email).Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	r.Equal(&SubAccountMarginEnabled{
+		Email:           email,
+		IsMarginEnabled: true,
+	}, res)
+}
+
+func (s *subAccountServiceTestSuite) TestGetFuturesSummary() {
+	data := []byte(`{
+		"futureAccountSummaryResp": {
+			"totalInitialMargin": "9.83137400",
+			"totalMaintenanceMargin": "0.41568700",
+			"totalMarginBalance": "23.03235621",
+			"totalOpenOrderInitialMargin": "9.00000000",
+			"totalPositionInitialMargin": "0.83137400",
+			"totalUnrealizedProfit": "0.03219710",
+			"totalWalletBalance": "22.15879444",
+			"asset": "USD",
+			"subAccountList": [
+				{
+					"email": "sub@example.com",
+					"totalInitialMargin": "9.00000000",
+					"totalMaintenanceMargin": "0.00000000",
+					"totalMarginBalance": "22.12659734",
+					"totalOpenOrderInitialMargin": "9.00000000",
+					"totalPositionInitialMargin": "0.00000000",
+					"totalUnrealizedProfit": "0.00000000",
+					"totalWalletBalance": "22.12659734",
+					"asset": "USD"
+				}
+			]
+		}
+	}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setParams(params{
+			"futuresType": 1,
+			"page":        1,
+			"limit":       10,
+		})
+		s.assertRequestEqual(e, r)
+	})
+	res, err := s.client.NewGetSubAccountFuturesSummaryService().FuturesType(1).
+		Page(1).Limit(10).Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	r.Nil(res.DeliveryAccountSummary)
+	r.NotNil(res.FutureAccountSummary)
+	r.Equal("23.03235621", res.FutureAccountSummary.TotalMarginBalance)
+	r.Equal("USD", res.FutureAccountSummary.Asset)
+	r.Equal([]SubAccountUSDTFuturesAsset{
+		{
+			Email:                       "sub@example.com",
+			TotalInitialMargin:          "9.00000000",
+			TotalMaintenanceMargin:      "0.00000000",
+			TotalMarginBalance:          "22.12659734",
+			TotalOpenOrderInitialMargin: "9.00000000",
+			TotalPositionInitialMargin:  "0.00000000",
+			TotalUnrealizedProfit:       "0.00000000",
+			TotalWalletBalance:          "22.12659734",
+			Asset:                       "USD",
+		},
+	}, res.FutureAccountSummary.SubAccountList)
+}
@@ -0,0 +1,153 @@
+package binance
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// DefaultSymbolInfoTTL is the TTL of the SymbolInfo cache of a client
+const DefaultSymbolInfoTTL = 10 * time.Minute
+
+// SymbolInfoCache cache the symbols of the exchange info for a TTL, it is safe
+// for concurrent use. Expired symbols are refreshed by the next Get, or in the
+// background by RefreshEvery. The symbols are still served while a refresh
+// runs and after a failed one.
+type SymbolInfoCache struct {
+	c       *Client
+	mu      sync.RWMutex
+	ttl     time.Duration
+	symbols map[string]*Symbol
+	expires time.Time
+	// fetch is the running exchange info fetch, if any
+	fetch *symbolInfoFetch
+}
+
+// symbolInfoFetch define an exchange info fetch, done is closed once err is set
+type symbolInfoFetch struct {
+	done chan struct{}
+	err  error
+}
+
+// NewSymbolInfoCache init a cache fetching exchange info with c
+func NewSymbolInfoCache(c *Client, ttl time.Duration) *SymbolInfoCache {
+	return &SymbolInfoCache{c: c, ttl: ttl}
+}
+
+// SymbolInfo return the symbol info cache shared by the rounding helpers of
+// the client, its TTL is DefaultSymbolInfoTTL unless changed by SetTTL
+func (c *Client) SymbolInfo() *SymbolInfoCache {
+	c.symbolInfoOnce.Do(func() {
+		c.symbolInfo = NewSymbolInfoCache(c, DefaultSymbolInfoTTL)
+	})
+	return c.symbolInfo
+}
+
+// SetTTL set how long the exchange info is cached, it applies from the next refresh
+func (s *SymbolInfoCache) SetTTL(ttl time.Duration) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.ttl = ttl
+}
+
+// Get return the info of symbol, exchange info is fetched when the cache is
+// empty or expired. Concurrent calls wait for a single fetch. When the fetch
+// fails the expired symbols are returned, an error is only returned when
+// nothing was cached yet.
+func (s *SymbolInfoCache) Get(ctx context.Context, symbol string) (*Symbol, error) {
+	s.mu.RLock()
+	symbols, fresh := s.symbols, s.symbols != nil && s.c.now().Before(s.expires)
+	s.mu.RUnlock()
+	if !fresh {
+		if err := s.refresh(ctx); err != nil {
+			if symbols == nil {
+				return nil, err
+			}
+			s.c.debug("refresh symbol info, serving expired symbols: %v", err)
+		}
+		s.mu.RLock()
+		symbols = s.symbols
+		s.mu.RUnlock()
+	}
+	info, ok := symbols[symbol]
+	return info, s.checkFound(symbol, ok)
+}
+
+// Refresh fetch exchange info now, or wait for the fetch already running. The
+// cached symbols are kept when it fails.
+func (s *SymbolInfoCache) Refresh(ctx context.Context) error {
+	return s.refresh(ctx)
+}
+
+// RefreshEvery refresh the cache every interval in the background until stop
+// is called, failed refreshes keep the cached symbols. interval must be
+// positive.
+func (s *SymbolInfoCache) RefreshEvery(interval time.Duration) (stop func(), err error) {
+	if interval <= 0 {
+		return nil, errors.New("interval must be positive")
+	}
+	stopC := make(chan struct{})
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-stopC:
+				return
+			case <-ticker.C:
+				if err := s.Refresh(context.Background()); err != nil {
+					s.c.debug("refresh symbol info: %v", err)
+				}
+			}
+		}
+	}()
+	var once sync.Once
+	return func() {
+		once.Do(func() { close(stopC) })
+	}, nil
+}
+
+// refresh fetch exchange info and store its symbols, a call while a fetch is
+// running waits for it and returns its error. The lock is not held during the
+// request so readers are not blocked.
+func (s *SymbolInfoCache) refresh(ctx context.Context) error {
+	s.mu.Lock()
+	if f := s.fetch; f != nil {
+		s.mu.Unlock()
+		select {
+		case <-f.done:
+			return f.err
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+	f := &symbolInfoFetch{done: make(chan struct{})}
+	s.fetch = f
+	s.mu.Unlock()
+
+	info, err := s.c.NewExchangeInfoService().Do(ctx)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err == nil {
+		symbols := make(map[string]*Symbol, len(info.Symbols))
+		for i := range info.Symbols {
+			symbols[info.Symbols[i].Symbol] = &info.Symbols[i]
+		}
+		s.symbols = symbols
+		s.expires = s.c.now().Add(s.ttl)
+	}
+	s.fetch = nil
+	f.err = err
+	close(f.done)
+	return err
+}
+
+func (s *SymbolInfoCache) checkFound(symbol string, ok bool) error {
+	if !ok {
+		return fmt.Errorf("symbol %s not found in exchange info", symbol)
+	}
+	return nil
+}
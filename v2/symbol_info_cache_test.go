@@ -0,0 +1,146 @@
+package binance
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type symbolInfoCacheTestSuite struct {
+	baseTestSuite
+}
+
+func TestSymbolInfoCache(t *testing.T) {
+	suite.Run(t, new(symbolInfoCacheTestSuite))
+}
+
+func (s *symbolInfoCacheTestSuite) TestGetConcurrent() {
+	s.mockDo(normalizerExchangeInfo, nil)
+	defer s.assertDo()
+
+	cache := s.client.SymbolInfo()
+	var wg sync.WaitGroup
+	errC := make(chan error, 20)
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			info, err := cache.Get(newContext(), "ETHBTC")
+			if err == nil && info.Symbol != "ETHBTC" {
+				s.Failf("unexpected symbol", "got %s", info.Symbol)
+			}
+			errC <- err
+		}()
+	}
+	wg.Wait()
+	close(errC)
+	for err := range errC {
+		s.r().NoError(err)
+	}
+	s.client.AssertNumberOfCalls(s.T(), "do", 1)
+
+	_, err := cache.Get(newContext(), "BNBBTC")
+	s.r().Error(err)
+	s.client.AssertNumberOfCalls(s.T(), "do", 1)
+}
+
+func (s *symbolInfoCacheTestSuite) TestGetExpired() {
+	s.client.Client.do = s.client.do
+	s.client.On("do", anyHTTPRequest()).Return(newHTTPResponse(normalizerExchangeInfo, 200), nil).Once()
+	s.client.On("do", anyHTTPRequest()).Return(newHTTPResponse(normalizerExchangeInfo, 200), nil).Once()
+	defer s.assertDo()
+
+	cache := s.client.SymbolInfo()
+	cache.SetTTL(time.Millisecond)
+	r := s.r()
+	_, err := cache.Get(newContext(), "ETHBTC")
+	r.NoError(err)
+	time.Sleep(5 * time.Millisecond)
+	_, err = cache.Get(newContext(), "ETHBTC")
+	r.NoError(err)
+	s.client.AssertNumberOfCalls(s.T(), "do", 2)
+}
+
+func (s *symbolInfoCacheTestSuite) TestRefreshEvery() {
+	fetched := make(chan struct{}, 10)
+	s.client.Client.do = s.client.do
+	s.client.On("do", anyHTTPRequest()).Return(newHTTPResponse(normalizerExchangeInfo, 200), nil).Run(func(mock.Arguments) {
+		fetched <- struct{}{}
+	}).Once()
+	defer s.assertDo()
+
+	cache := NewSymbolInfoCache(s.client.Client, time.Hour)
+	stop, err := cache.RefreshEvery(10 * time.Millisecond)
+	s.r().NoError(err)
+	defer stop()
+	select {
+	case <-fetched:
+	case <-time.After(time.Second):
+		s.r().FailNow("exchange info not refreshed")
+	}
+	stop()
+	info, err := cache.Get(newContext(), "ETHBTC")
+	r := s.r()
+	r.NoError(err)
+	r.Equal("ETHBTC", info.Symbol)
+}
+
+func (s *symbolInfoCacheTestSuite) TestRefreshEveryInvalidInterval() {
+	cache := NewSymbolInfoCache(s.client.Client, time.Hour)
+	for _, interval := range []time.Duration{0, -time.Second} {
+		stop, err := cache.RefreshEvery(interval)
+		s.r().Error(err)
+		s.r().Nil(stop)
+	}
+}
+
+func (s *symbolInfoCacheTestSuite) TestGetExpiredRefreshFails() {
+	s.client.Client.do = s.client.do
+	s.client.On("do", anyHTTPRequest()).Return(newHTTPResponse(normalizerExchangeInfo, 200), nil).Once()
+	s.client.On("do", anyHTTPRequest()).Return(newHTTPResponse([]byte(`{"code":-1001,"msg":"Internal error."}`), 500), nil).Once()
+	defer s.assertDo()
+
+	cache := s.client.SymbolInfo()
+	cache.SetTTL(time.Millisecond)
+	r := s.r()
+	_, err := cache.Get(newContext(), "ETHBTC")
+	r.NoError(err)
+	time.Sleep(5 * time.Millisecond)
+	// the expired symbols are served when the refresh fails
+	info, err := cache.Get(newContext(), "ETHBTC")
+	r.NoError(err)
+	r.Equal("ETHBTC", info.Symbol)
+	s.client.AssertNumberOfCalls(s.T(), "do", 2)
+}
+
+func (s *symbolInfoCacheTestSuite) TestGetDuringRefresh() {
+	release := make(chan struct{})
+	fetching := make(chan struct{}, 1)
+	s.client.Client.do = s.client.do
+	s.client.On("do", anyHTTPRequest()).Return(newHTTPResponse(normalizerExchangeInfo, 200), nil).Once()
+	s.client.On("do", anyHTTPRequest()).Return(newHTTPResponse(normalizerExchangeInfo, 200), nil).Run(func(mock.Arguments) {
+		fetching <- struct{}{}
+		<-release
+	}).Once()
+	defer s.assertDo()
+
+	cache := s.client.SymbolInfo()
+	r := s.r()
+	_, err := cache.Get(newContext(), "ETHBTC")
+	r.NoError(err)
+
+	refreshed := make(chan error, 1)
+	go func() {
+		refreshed <- cache.Refresh(newContext())
+	}()
+	<-fetching
+	// readers are not blocked by the running refresh
+	info, err := cache.Get(newContext(), "ETHBTC")
+	r.NoError(err)
+	r.Equal("ETHBTC", info.Symbol)
+	close(release)
+	r.NoError(<-refreshed)
+}
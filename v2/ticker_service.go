@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"net/http"
+	"strings"
 
 	"github.com/adshao/go-binance/v2/common"
 )
@@ -91,10 +92,13 @@ type SymbolPrice struct {
 	Price  string `json:"price"`
 }
 
-// ListPriceChangeStatsService show stats of price change in last 24 hours for all symbols
+// ListPriceChangeStatsService show stats of price change in last 24 hours for
+// a symbol, some symbols or all symbols
 type ListPriceChangeStatsService struct {
-	c      *Client
-	symbol *string
+	c          *Client
+	symbol     *string
+	symbols    []string
+	tickerType *TickerType
 }
 
 // Symbol set symbol
@@ -103,6 +107,19 @@ func (s *ListPriceChangeStatsService) Symbol(symbol string) *ListPriceChangeStat
 	return s
 }
 
+// Symbols set symbols, used when no symbol is set
+func (s *ListPriceChangeStatsService) Symbols(symbols ...string) *ListPriceChangeStatsService {
+	s.symbols = symbols
+	return s
+}
+
+// Type set ticker type, TickerTypeMini omits the bid, ask and price change
+// fields for a lighter response. Default TickerTypeFull
+func (s *ListPriceChangeStatsService) Type(tickerType TickerType) *ListPriceChangeStatsService {
+	s.tickerType = &tickerType
+	return s
+}
+
 // Do send request
 func (s *ListPriceChangeStatsService) Do(ctx context.Context, opts ...RequestOption) (res []*PriceChangeStats, err error) {
 	r := &request{
@@ -111,6 +128,11 @@ func (s *ListPriceChangeStatsService) Do(ctx context.Context, opts ...RequestOpt
 	}
 	if s.symbol != nil {
 		r.setParam("symbol", *s.symbol)
+	} else if len(s.symbols) > 0 {
+		r.setParam("symbols", "[\""+strings.Join(s.symbols, "\",\"")+"\"]")
+	}
+	if s.tickerType != nil {
+		r.setParam("type", *s.tickerType)
 	}
 	data, err := s.c.callAPI(ctx, r, opts...)
 	if err != nil {
@@ -135,7 +157,9 @@ type PriceChangeStats struct {
 	LastPrice          string `json:"lastPrice"`
 	LastQty            string `json:"lastQty"`
 	BidPrice           string `json:"bidPrice"`
+	BidQty             string `json:"bidQty"`
 	AskPrice           string `json:"askPrice"`
+	AskQty             string `json:"askQty"`
 	OpenPrice          string `json:"openPrice"`
 	HighPrice          string `json:"highPrice"`
 	LowPrice           string `json:"lowPrice"`
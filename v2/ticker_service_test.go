@@ -234,11 +234,14 @@ func (s *tickerServiceTestSuite) assertPriceChangeStatsEqual(e, a *PriceChangeSt
 	r.Equal(e.LastPrice, a.LastPrice, "LastPrice")
 	r.Equal(e.LastQty, a.LastQty, "LastQty")
 	r.Equal(e.BidPrice, a.BidPrice, "BidPrice")
+	r.Equal(e.BidQty, a.BidQty, "BidQty")
 	r.Equal(e.AskPrice, a.AskPrice, "AskPrice")
+	r.Equal(e.AskQty, a.AskQty, "AskQty")
 	r.Equal(e.OpenPrice, a.OpenPrice, "OpenPrice")
 	r.Equal(e.HighPrice, a.HighPrice, "HighPrice")
 	r.Equal(e.LowPrice, a.LowPrice, "LowPrice")
 	r.Equal(e.Volume, a.Volume, "Volume")
+	r.Equal(e.QuoteVolume, a.QuoteVolume, "QuoteVolume")
 	r.Equal(e.OpenTime, a.OpenTime, "OpenTime")
 	r.Equal(e.CloseTime, a.CloseTime, "CloseTime")
 	r.Equal(e.FristID, a.FristID, "FristID")
@@ -246,6 +249,116 @@ func (s *tickerServiceTestSuite) assertPriceChangeStatsEqual(e, a *PriceChangeSt
 	r.Equal(e.Count, a.Count, "Count")
 }
 
+func (s *tickerServiceTestSuite) TestPriceChangeStatsFull() {
+	data := []byte(`[{
+		"symbol": "BNBBTC",
+		"priceChange": "-94.99999800",
+		"priceChangePercent": "-95.960",
+		"weightedAvgPrice": "0.29628482",
+		"prevClosePrice": "0.10002000",
+		"lastPrice": "4.00000200",
+		"lastQty": "200.00000000",
+		"bidPrice": "4.00000000",
+		"bidQty": "100.00000000",
+		"askPrice": "4.00000200",
+		"askQty": "100.00000000",
+		"openPrice": "99.00000000",
+		"highPrice": "100.00000000",
+		"lowPrice": "0.10000000",
+		"volume": "8913.30000000",
+		"quoteVolume": "15.30000000",
+		"openTime": 1499783499040,
+		"closeTime": 1499869899040,
+		"firstId": 28385,
+		"lastId": 28460,
+		"count": 76
+	}]`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	s.assertReq(func(r *request) {
+		e := newRequest().setParams(params{
+			"symbols": `["BNBBTC","ETHBTC"]`,
+			"type":    TickerTypeFull,
+		})
+		s.assertRequestEqual(e, r)
+	})
+	stats, err := s.client.NewListPriceChangeStatsService().Symbols("BNBBTC", "ETHBTC").
+		Type(TickerTypeFull).Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	r.Len(stats, 1)
+	s.assertPriceChangeStatsEqual(&PriceChangeStats{
+		Symbol:             "BNBBTC",
+		PriceChange:        "-94.99999800",
+		PriceChangePercent: "-95.960",
+		WeightedAvgPrice:   "0.29628482",
+		PrevClosePrice:     "0.10002000",
+		LastPrice:          "4.00000200",
+		LastQty:            "200.00000000",
+		BidPrice:           "4.00000000",
+		BidQty:             "100.00000000",
+		AskPrice:           "4.00000200",
+		AskQty:             "100.00000000",
+		OpenPrice:          "99.00000000",
+		HighPrice:          "100.00000000",
+		LowPrice:           "0.10000000",
+		Volume:             "8913.30000000",
+		QuoteVolume:        "15.30000000",
+		OpenTime:           1499783499040,
+		CloseTime:          1499869899040,
+		FristID:            28385,
+		LastID:             28460,
+		Count:              76,
+	}, stats[0])
+}
+
+func (s *tickerServiceTestSuite) TestPriceChangeStatsMini() {
+	data := []byte(`{
+		"symbol": "BNBBTC",
+		"openPrice": "99.00000000",
+		"highPrice": "100.00000000",
+		"lowPrice": "0.10000000",
+		"lastPrice": "4.00000200",
+		"volume": "8913.30000000",
+		"quoteVolume": "15.30000000",
+		"openTime": 1499783499040,
+		"closeTime": 1499869899040,
+		"firstId": 28385,
+		"lastId": 28460,
+		"count": 76
+	}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	s.assertReq(func(r *request) {
+		e := newRequest().setParams(params{
+			"symbol": "BNBBTC",
+			"type":   TickerTypeMini,
+		})
+		s.assertRequestEqual(e, r)
+	})
+	stats, err := s.client.NewListPriceChangeStatsService().Symbol("BNBBTC").
+		Type(TickerTypeMini).Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	r.Len(stats, 1)
+	s.assertPriceChangeStatsEqual(&PriceChangeStats{
+		Symbol:      "BNBBTC",
+		LastPrice:   "4.00000200",
+		OpenPrice:   "99.00000000",
+		HighPrice:   "100.00000000",
+		LowPrice:    "0.10000000",
+		Volume:      "8913.30000000",
+		QuoteVolume: "15.30000000",
+		OpenTime:    1499783499040,
+		CloseTime:   1499869899040,
+		FristID:     28385,
+		LastID:      28460,
+		Count:       76,
+	}, stats[0])
+}
+
 func (s *tickerServiceTestSuite) TestListPriceChangeStats() {
 	data := []byte(`[{
     	"symbol": "BNBBTC",
@@ -4,6 +4,9 @@ import (
 	"context"
 	"encoding/json"
 	"net/http"
+	"time"
+
+	"github.com/adshao/go-binance/v2/common"
 )
 
 // ListTradesService list trades
@@ -29,12 +32,22 @@ func (s *ListTradesService) StartTime(startTime int64) *ListTradesService {
 	return s
 }
 
+// StartTimeFrom set startTime from t, converted to epoch milliseconds
+func (s *ListTradesService) StartTimeFrom(t time.Time) *ListTradesService {
+	return s.StartTime(FormatTimestamp(t))
+}
+
 // EndTime set endtime
 func (s *ListTradesService) EndTime(endTime int64) *ListTradesService {
 	s.endTime = &endTime
 	return s
 }
 
+// EndTimeFrom set endTime from t, converted to epoch milliseconds
+func (s *ListTradesService) EndTimeFrom(t time.Time) *ListTradesService {
+	return s.EndTime(FormatTimestamp(t))
+}
+
 // Limit set limit
 func (s *ListTradesService) Limit(limit int) *ListTradesService {
 	s.limit = &limit
@@ -88,6 +101,41 @@ func (s *ListTradesService) Do(ctx context.Context, opts ...RequestOption) (res
 	return res, nil
 }
 
+// Iterate pages forward through the account trades of the symbol, calling fn for
+// every trade in ascending id order. Each following page is requested with
+// fromId = last trade id + 1 until a page shorter than the limit is returned.
+// The limit defaults to and is capped at 1000, a limit <= 0 is an error.
+// The optional startTime and endTime define the window of the first page, and
+// trades after endTime stop the iteration. Returning an error from fn stops the
+// iteration and that error is returned.
+func (s *ListTradesService) Iterate(ctx context.Context, fn func(*TradeV3) error, opts ...RequestOption) error {
+	limit, err := common.PageLimit(s.limit, 1000)
+	if err != nil {
+		return err
+	}
+	page := *s
+	page.limit = &limit
+	return common.PageForward(limit, func(fromID *int64) (int, int64, bool, error) {
+		if fromID != nil {
+			// fromId can not be combined with a time window
+			page.fromID, page.startTime, page.endTime = fromID, nil, nil
+		}
+		trades, err := page.Do(ctx, opts...)
+		if err != nil || len(trades) == 0 {
+			return 0, 0, false, err
+		}
+		for _, t := range trades {
+			if s.endTime != nil && t.Time > *s.endTime {
+				return 0, 0, true, nil
+			}
+			if err = fn(t); err != nil {
+				return 0, 0, false, err
+			}
+		}
+		return len(trades), trades[len(trades)-1].ID, false, nil
+	})
+}
+
 // HistoricalTradesService trades
 type HistoricalTradesService struct {
 	c      *Client
@@ -143,13 +191,14 @@ func (s *HistoricalTradesService) Do(ctx context.Context, opts ...RequestOption)
 
 // Trade define trade info
 type Trade struct {
-	ID           int64  `json:"id"`
-	Price        string `json:"price"`
-	Quantity     string `json:"qty"`
-	Time         int64  `json:"time"`
-	IsBuyerMaker bool   `json:"isBuyerMaker"`
-	IsBestMatch  bool   `json:"isBestMatch"`
-	IsIsolated   bool   `json:"isIsolated"`
+	ID            int64  `json:"id"`
+	Price         string `json:"price"`
+	Quantity      string `json:"qty"`
+	QuoteQuantity string `json:"quoteQty"`
+	Time          int64  `json:"time"`
+	IsBuyerMaker  bool   `json:"isBuyerMaker"`
+	IsBestMatch   bool   `json:"isBestMatch"`
+	IsIsolated    bool   `json:"isIsolated"`
 }
 
 // TradeV3 define v3 trade info
@@ -198,12 +247,22 @@ func (s *AggTradesService) StartTime(startTime int64) *AggTradesService {
 	return s
 }
 
+// StartTimeFrom set startTime from t, converted to epoch milliseconds
+func (s *AggTradesService) StartTimeFrom(t time.Time) *AggTradesService {
+	return s.StartTime(FormatTimestamp(t))
+}
+
 // EndTime set endTime
 func (s *AggTradesService) EndTime(endTime int64) *AggTradesService {
 	s.endTime = &endTime
 	return s
 }
 
+// EndTimeFrom set endTime from t, converted to epoch milliseconds
+func (s *AggTradesService) EndTimeFrom(t time.Time) *AggTradesService {
+	return s.EndTime(FormatTimestamp(t))
+}
+
 // Limit set limit
 func (s *AggTradesService) Limit(limit int) *AggTradesService {
 	s.limit = &limit
@@ -276,7 +335,7 @@ func (s *RecentTradesService) Limit(limit int) *RecentTradesService {
 func (s *RecentTradesService) Do(ctx context.Context, opts ...RequestOption) (res []*Trade, err error) {
 	r := &request{
 		method:   http.MethodGet,
-		endpoint: "/api/v1/trades",
+		endpoint: "/api/v3/trades",
 	}
 	r.setParam("symbol", s.symbol)
 	if s.limit != nil {
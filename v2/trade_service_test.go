@@ -1,6 +1,7 @@
 package binance
 
 import (
+	"net/http"
 	"testing"
 
 	"github.com/stretchr/testify/suite"
@@ -75,6 +76,90 @@ func (s *tradeServiceTestSuite) TestListTrades() {
 	s.assertTradeV3Equal(e, trades[0])
 }
 
+func (s *tradeServiceTestSuite) TestIterateTrades() {
+	page1 := []byte(`[
+        {"symbol": "BNBBTC", "id": 100, "orderId": 1, "price": "4.00000100", "qty": "1.00000000", "time": 1499865549590},
+        {"symbol": "BNBBTC", "id": 101, "orderId": 1, "price": "4.00000200", "qty": "2.00000000", "time": 1499865549591}
+    ]`)
+	page2 := []byte(`[
+        {"symbol": "BNBBTC", "id": 102, "orderId": 2, "price": "4.00000300", "qty": "3.00000000", "time": 1499865549592}
+    ]`)
+	s.client.Client.do = s.client.do
+	s.client.On("do", anyHTTPRequest()).Return(newHTTPResponse(page1, 200), nil).Once()
+	s.client.On("do", anyHTTPRequest()).Return(newHTTPResponse(page2, 200), nil).Once()
+	defer s.assertDo()
+
+	symbol := "BNBBTC"
+	startTime := int64(1499865549000)
+	calls := 0
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setParams(params{
+			"symbol":    symbol,
+			"limit":     2,
+			"startTime": startTime,
+		})
+		if calls > 0 {
+			e = newSignedRequest().setParams(params{
+				"symbol": symbol,
+				"limit":  2,
+				"fromId": 102,
+			})
+		}
+		calls++
+		s.assertRequestEqual(e, r)
+	})
+
+	var ids []int64
+	err := s.client.NewListTradesService().Symbol(symbol).StartTime(startTime).Limit(2).
+		Iterate(newContext(), func(t *TradeV3) error {
+			ids = append(ids, t.ID)
+			return nil
+		})
+	r := s.r()
+	r.NoError(err)
+	r.Equal(2, calls)
+	r.Equal([]int64{100, 101, 102}, ids)
+}
+
+func (s *tradeServiceTestSuite) TestIterateTradesInvalidLimit() {
+	calls := 0
+	err := s.client.NewListTradesService().Symbol("BNBBTC").Limit(0).
+		Iterate(newContext(), func(t *TradeV3) error {
+			calls++
+			return nil
+		})
+	r := s.r()
+	r.Error(err)
+	r.Equal(0, calls)
+}
+
+func (s *tradeServiceTestSuite) TestIterateTradesLimitCapped() {
+	page1 := []byte(`[
+        {"symbol": "BNBBTC", "id": 100, "orderId": 1, "price": "4.00000100", "qty": "1.00000000", "time": 1499865549590}
+    ]`)
+	s.client.Client.do = s.client.do
+	s.client.On("do", anyHTTPRequest()).Return(newHTTPResponse(page1, 200), nil).Once()
+	defer s.assertDo()
+
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setParams(params{
+			"symbol": "BNBBTC",
+			"limit":  1000,
+		})
+		s.assertRequestEqual(e, r)
+	})
+
+	var ids []int64
+	err := s.client.NewListTradesService().Symbol("BNBBTC").Limit(5000).
+		Iterate(newContext(), func(t *TradeV3) error {
+			ids = append(ids, t.ID)
+			return nil
+		})
+	r := s.r()
+	r.NoError(err)
+	r.Equal([]int64{100}, ids)
+}
+
 func (s *tradeServiceTestSuite) TestAggregateTrades() {
 	data := []byte(`[
         {
@@ -180,19 +265,54 @@ func (s *tradeServiceTestSuite) TestHistoricalTrades() {
 	s.assertTradeEqual(e, trades[0])
 }
 
+func (s *tradeServiceTestSuite) TestHistoricalTradesAPIKeyOnly() {
+	s.mockDo([]byte(`[]`), nil)
+	defer s.assertDo()
+	var req *http.Request
+	do := s.client.Client.do
+	s.client.Client.do = func(r *http.Request) (*http.Response, error) {
+		req = r
+		return do(r)
+	}
+
+	_, err := s.client.NewHistoricalTradesService().Symbol("LTCBTC").FromID(1).Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	r.Equal(s.apiKey, req.Header.Get("X-MBX-APIKEY"))
+	r.Empty(req.URL.Query().Get(signatureKey), "signature")
+	r.Empty(req.URL.Query().Get(timestampKey), "timestamp")
+	r.Equal("/api/v3/historicalTrades", req.URL.Path)
+}
+
 func (s *tradeServiceTestSuite) TestRecentTrades() {
 	data := []byte(`[
         {
             "id": 28457,
             "price": "4.00000100",
             "qty": "12.00000000",
+            "quoteQty": "48.000012",
             "time": 1499865549590,
             "isBuyerMaker": true,
             "isBestMatch": true
+        },
+        {
+            "id": 28458,
+            "price": "4.00000200",
+            "qty": "1.50000000",
+            "quoteQty": "6.000003",
+            "time": 1499865549591,
+            "isBuyerMaker": false,
+            "isBestMatch": true
         }
     ]`)
 	s.mockDo(data, nil)
 	defer s.assertDo()
+	var path string
+	do := s.client.Client.do
+	s.client.Client.do = func(req *http.Request) (*http.Response, error) {
+		path = req.URL.Path
+		return do(req)
+	}
 
 	symbol := "LTCBTC"
 	limit := 3
@@ -207,16 +327,26 @@ func (s *tradeServiceTestSuite) TestRecentTrades() {
 	trades, err := s.client.NewRecentTradesService().Symbol(symbol).Limit(limit).Do(newContext())
 	r := s.r()
 	r.NoError(err)
-	r.Len(trades, 1)
-	e := &Trade{
-		ID:           28457,
-		Price:        "4.00000100",
-		Quantity:     "12.00000000",
-		Time:         1499865549590,
-		IsBuyerMaker: true,
-		IsBestMatch:  true,
-	}
-	s.assertTradeEqual(e, trades[0])
+	r.Equal("/api/v3/trades", path)
+	r.Len(trades, 2)
+	s.assertTradeEqual(&Trade{
+		ID:            28457,
+		Price:         "4.00000100",
+		Quantity:      "12.00000000",
+		QuoteQuantity: "48.000012",
+		Time:          1499865549590,
+		IsBuyerMaker:  true,
+		IsBestMatch:   true,
+	}, trades[0])
+	s.assertTradeEqual(&Trade{
+		ID:            28458,
+		Price:         "4.00000200",
+		Quantity:      "1.50000000",
+		QuoteQuantity: "6.000003",
+		Time:          1499865549591,
+		IsBuyerMaker:  false,
+		IsBestMatch:   true,
+	}, trades[1])
 }
 
 func (s *tradeServiceTestSuite) assertTradeEqual(e, a *Trade) {
@@ -224,6 +354,7 @@ func (s *tradeServiceTestSuite) assertTradeEqual(e, a *Trade) {
 	r.Equal(e.ID, a.ID, "ID")
 	r.Equal(e.Price, a.Price, "Price")
 	r.Equal(e.Quantity, a.Quantity, "Quantity")
+	r.Equal(e.QuoteQuantity, a.QuoteQuantity, "QuoteQuantity")
 	r.Equal(e.Time, a.Time, "Time")
 	r.Equal(e.IsBuyerMaker, a.IsBuyerMaker, "IsBuyerMaker")
 	r.Equal(e.IsBestMatch, a.IsBestMatch, "IsBestMatch")
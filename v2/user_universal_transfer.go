@@ -12,6 +12,8 @@ package binance
 import (
 	"context"
 	"encoding/json"
+	"fmt"
+	"strings"
 )
 
 // CreateUserUniversalTransferService submits a transfer request.
@@ -26,7 +28,7 @@ type CreateUserUniversalTransferService struct {
 	toSymbol   *string
 }
 
-// Coin sets the coin parameter (MANDATORY).
+// Type sets the type parameter (MANDATORY), one of the UserUniversalTransferType values.
 func (s *CreateUserUniversalTransferService) Type(v string) *CreateUserUniversalTransferService {
 	s.types = v
 	return s
@@ -63,6 +65,9 @@ func (s *CreateUserUniversalTransferService) Do(ctx context.Context) (*CreateUse
 		endpoint: "/sapi/v1/asset/transfer",
 		secType:  secTypeSigned,
 	}
+	if err := validateUserUniversalTransferType(s.types); err != nil {
+		return nil, err
+	}
 
 	r.setParam("type", s.types)
 	r.setParam("asset", s.asset)
@@ -92,6 +97,100 @@ type CreateUserUniversalTransferResponse struct {
 	ID int64 `json:"tranId"`
 }
 
+// userUniversalTransferTypes lists the types accepted by the transfer endpoint
+var userUniversalTransferTypes = []UserUniversalTransferType{
+	UserUniversalTransferTypeMainUMFuture,
+	UserUniversalTransferTypeMainCMFuture,
+	UserUniversalTransferTypeMainMargin,
+	UserUniversalTransferTypeUMFutureMain,
+	UserUniversalTransferTypeUMFutureMargin,
+	UserUniversalTransferTypeCMFutureMain,
+	UserUniversalTransferTypeCMFutureMargin,
+	UserUniversalTransferTypeMarginMain,
+	UserUniversalTransferTypeMarginUMFuture,
+	UserUniversalTransferTypeMarginCMFuture,
+	UserUniversalTransferTypeIsolatedMarginMargin,
+	UserUniversalTransferTypeMarginIsolatedMargin,
+	UserUniversalTransferTypeIsolatedMarginIsolatedMargin,
+	UserUniversalTransferTypeMainFunding,
+	UserUniversalTransferTypeFundingMain,
+	UserUniversalTransferTypeFundingUMFuture,
+	UserUniversalTransferTypeUMFutureFunding,
+	UserUniversalTransferTypeMarginFunding,
+	UserUniversalTransferTypeFundingMargin,
+	UserUniversalTransferTypeFundingCMFuture,
+	UserUniversalTransferTypeCMFutureFunding,
+	UserUniversalTransferTypeMainOption,
+	UserUniversalTransferTypeOptionMain,
+	UserUniversalTransferTypeUMFutureOption,
+	UserUniversalTransferTypeOptionUMFuture,
+	UserUniversalTransferTypeMarginOption,
+	UserUniversalTransferTypeOptionMargin,
+	UserUniversalTransferTypeFundingOption,
+	UserUniversalTransferTypeOptionFunding,
+	UserUniversalTransferTypeMainPortfolioMargin,
+	UserUniversalTransferTypePortfolioMarginMain,
+	UserUniversalTransferTypeMainC2C,
+	UserUniversalTransferTypeC2CMain,
+	UserUniversalTransferTypeC2CUMFuture,
+	UserUniversalTransferTypeUMFutureC2C,
+	UserUniversalTransferTypeC2CMargin,
+	UserUniversalTransferTypeMarginC2C,
+	UserUniversalTransferTypeC2CMining,
+	UserUniversalTransferTypeMiningC2C,
+	UserUniversalTransferTypeMainMining,
+	UserUniversalTransferTypeMiningMain,
+	UserUniversalTransferTypeMiningUMFuture,
+	UserUniversalTransferTypeUMFutureMining,
+	UserUniversalTransferTypeMarginMining,
+	UserUniversalTransferTypeMiningMargin,
+	UserUniversalTransferTypeMainPay,
+	UserUniversalTransferTypePayMain,
+}
+
+// validateUserUniversalTransferType return an error naming the closest valid
+// type when t is not a known UserUniversalTransferType
+func validateUserUniversalTransferType(t string) error {
+	closest, distance := "", -1
+	for _, v := range userUniversalTransferTypes {
+		if string(v) == t {
+			return nil
+		}
+		if d := levenshtein(strings.ToUpper(t), string(v)); distance < 0 || d < distance {
+			closest, distance = string(v), d
+		}
+	}
+	return fmt.Errorf("invalid universal transfer type %q, did you mean %q?", t, closest)
+}
+
+// levenshtein return the edit distance between a and b
+func levenshtein(a, b string) int {
+	prev := make([]int, len(b)+1)
+	cur := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		cur[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			cur[j] = minInt(prev[j]+1, minInt(cur[j-1]+1, prev[j-1]+cost))
+		}
+		prev, cur = cur, prev
+	}
+	return prev[len(b)]
+}
+
+func minInt(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
+
 // ListUserUniversalTransfer fetches transfer history.
 //
 // See https://binance-docs.github.io/apidocs/spot/en/#query-user-universal-transfer-history-user_data
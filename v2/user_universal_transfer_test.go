@@ -52,3 +52,34 @@ func (s *userUniversalTransferTestSuite) TestUserUniversalTransfer() {
 	r.NoError(err)
 	r.Equal(int64(13526853623), res.ID)
 }
+
+func (s *userUniversalTransferTestSuite) TestUserUniversalTransferType() {
+	s.mockDo([]byte(`{"tranId": 13526853624}`), nil)
+	defer s.assertDo()
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setParams(params{
+			"type":   UserUniversalTransferTypeMainUMFuture,
+			"asset":  "USDT",
+			"amount": 10.0,
+		})
+		s.assertRequestEqual(e, r)
+	})
+
+	r := s.r()
+	res, err := s.client.NewUserUniversalTransferService().
+		Type(string(UserUniversalTransferTypeMainUMFuture)).Asset("USDT").Amount(10).Do(newContext())
+	r.NoError(err)
+	r.Equal(int64(13526853624), res.ID)
+}
+
+func (s *userUniversalTransferTestSuite) TestUserUniversalTransferInvalidType() {
+	r := s.r()
+	_, err := s.client.NewUserUniversalTransferService().
+		Type("MAIN_UMFUTRUE").Asset("USDT").Amount(10).Do(newContext())
+	r.EqualError(err, `invalid universal transfer type "MAIN_UMFUTRUE", did you mean "MAIN_UMFUTURE"?`)
+	_, err = s.client.NewUserUniversalTransferService().
+		Type("funding_main").Asset("USDT").Amount(10).Do(newContext())
+	r.Error(err)
+	r.Contains(err.Error(), `"FUNDING_MAIN"`)
+	s.client.AssertNotCalled(s.T(), "do", anyHTTPRequest())
+}
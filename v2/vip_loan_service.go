@@ -0,0 +1,264 @@
+package binance
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+)
+
+// VipLoanOngoingOrdersService https://binance-docs.github.io/apidocs/spot/en/#get-vip-loan-ongoing-orders-user_data
+type VipLoanOngoingOrdersService struct {
+	c                   *Client
+	orderID             *int64
+	collateralAccountID *int64
+	loanCoin            string
+	collateralCoin      string
+	current             *int64
+	limit               *int64
+}
+
+// OrderID set orderId
+func (s *VipLoanOngoingOrdersService) OrderID(orderID int64) *VipLoanOngoingOrdersService {
+	s.orderID = &orderID
+	return s
+}
+
+// CollateralAccountID set collateralAccountId
+func (s *VipLoanOngoingOrdersService) CollateralAccountID(collateralAccountID int64) *VipLoanOngoingOrdersService {
+	s.collateralAccountID = &collateralAccountID
+	return s
+}
+
+// LoanCoin set loanCoin
+func (s *VipLoanOngoingOrdersService) LoanCoin(loanCoin string) *VipLoanOngoingOrdersService {
+	s.loanCoin = loanCoin
+	return s
+}
+
+// CollateralCoin set collateralCoin
+func (s *VipLoanOngoingOrdersService) CollateralCoin(collateralCoin string) *VipLoanOngoingOrdersService {
+	s.collateralCoin = collateralCoin
+	return s
+}
+
+// Current query page. Default: 1, Min: 1
+func (s *VipLoanOngoingOrdersService) Current(current int64) *VipLoanOngoingOrdersService {
+	s.current = &current
+	return s
+}
+
+// Limit Default: 10, Max: 100
+func (s *VipLoanOngoingOrdersService) Limit(limit int64) *VipLoanOngoingOrdersService {
+	s.limit = &limit
+	return s
+}
+
+// Do send request
+func (s *VipLoanOngoingOrdersService) Do(ctx context.Context, opts ...RequestOption) (*VipLoanOngoingOrders, error) {
+	r := &request{
+		method:   http.MethodGet,
+		endpoint: "/sapi/v1/loan/vip/ongoing/orders",
+		secType:  secTypeSigned,
+	}
+	if s.orderID != nil {
+		r.setParam("orderId", *s.orderID)
+	}
+	if s.collateralAccountID != nil {
+		r.setParam("collateralAccountId", *s.collateralAccountID)
+	}
+	if s.loanCoin != "" {
+		r.setParam("loanCoin", s.loanCoin)
+	}
+	if s.collateralCoin != "" {
+		r.setParam("collateralCoin", s.collateralCoin)
+	}
+	if s.current != nil {
+		r.setParam("current", *s.current)
+	}
+	if s.limit != nil {
+		r.setParam("limit", *s.limit)
+	}
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res := new(VipLoanOngoingOrders)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// VipLoanOngoingOrders define a page of vip loan ongoing orders
+type VipLoanOngoingOrders struct {
+	Rows  []*VipLoanOngoingOrder `json:"rows"`
+	Total int64                  `json:"total"`
+}
+
+// VipLoanOngoingOrder define a vip loan ongoing order, CollateralAccountID and
+// CollateralCoin are comma separated lists
+type VipLoanOngoingOrder struct {
+	OrderID                          int64  `json:"orderId"`
+	LoanCoin                         string `json:"loanCoin"`
+	TotalDebt                        string `json:"totalDebt"`
+	ResidualInterest                 string `json:"residualInterest"`
+	CollateralAccountID              string `json:"collateralAccountId"`
+	CollateralCoin                   string `json:"collateralCoin"`
+	TotalCollateralValueAfterHaircut string `json:"totalCollateralValueAfterHaircut"`
+	LockedCollateralValue            string `json:"lockedCollateralValue"`
+	CurrentLTV                       string `json:"currentLTV"`
+	ExpirationTime                   int64  `json:"expirationTime"`
+	LoanDate                         string `json:"loanDate"`
+	LoanTerm                         string `json:"loanTerm"`
+}
+
+// VipLoanBorrowService https://binance-docs.github.io/apidocs/spot/en/#vip-loan-borrow-trade
+type VipLoanBorrowService struct {
+	c                   *Client
+	loanAccountID       int64
+	loanCoin            string
+	loanAmount          string
+	collateralAccountID string
+	collateralCoin      string
+	isFlexibleRate      bool
+	loanTerm            *int
+}
+
+// LoanAccountID set loanAccountId
+func (s *VipLoanBorrowService) LoanAccountID(loanAccountID int64) *VipLoanBorrowService {
+	s.loanAccountID = loanAccountID
+	return s
+}
+
+// LoanCoin set loanCoin
+func (s *VipLoanBorrowService) LoanCoin(loanCoin string) *VipLoanBorrowService {
+	s.loanCoin = loanCoin
+	return s
+}
+
+// LoanAmount set loanAmount
+func (s *VipLoanBorrowService) LoanAmount(loanAmount string) *VipLoanBorrowService {
+	s.loanAmount = loanAmount
+	return s
+}
+
+// CollateralAccountID set collateralAccountId, multiple accounts are separated by commas
+func (s *VipLoanBorrowService) CollateralAccountID(collateralAccountID string) *VipLoanBorrowService {
+	s.collateralAccountID = collateralAccountID
+	return s
+}
+
+// CollateralCoin set collateralCoin, multiple coins are separated by commas
+func (s *VipLoanBorrowService) CollateralCoin(collateralCoin string) *VipLoanBorrowService {
+	s.collateralCoin = collateralCoin
+	return s
+}
+
+// IsFlexibleRate set isFlexibleRate
+func (s *VipLoanBorrowService) IsFlexibleRate(isFlexibleRate bool) *VipLoanBorrowService {
+	s.isFlexibleRate = isFlexibleRate
+	return s
+}
+
+// LoanTerm set loanTerm in days, mandatory for fixed rate
+func (s *VipLoanBorrowService) LoanTerm(loanTerm int) *VipLoanBorrowService {
+	s.loanTerm = &loanTerm
+	return s
+}
+
+// Do send request
+func (s *VipLoanBorrowService) Do(ctx context.Context, opts ...RequestOption) (*VipLoanBorrowResponse, error) {
+	r := &request{
+		method:   http.MethodPost,
+		endpoint: "/sapi/v1/loan/vip/borrow",
+		secType:  secTypeSigned,
+	}
+	m := params{
+		"loanAccountId":       s.loanAccountID,
+		"loanCoin":            s.loanCoin,
+		"loanAmount":          s.loanAmount,
+		"collateralAccountId": s.collateralAccountID,
+		"collateralCoin":      s.collateralCoin,
+		"isFlexibleRate":      s.isFlexibleRate,
+	}
+	if s.loanTerm != nil {
+		m["loanTerm"] = *s.loanTerm
+	}
+	r.setFormParams(m)
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res := new(VipLoanBorrowResponse)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// VipLoanBorrowResponse define the response of VipLoanBorrowService
+type VipLoanBorrowResponse struct {
+	LoanAccountID       string `json:"loanAccountId"`
+	RequestID           string `json:"requestId"`
+	LoanCoin            string `json:"loanCoin"`
+	IsFlexibleRate      string `json:"isFlexibleRate"`
+	LoanAmount          string `json:"loanAmount"`
+	CollateralAccountID string `json:"collateralAccountId"`
+	CollateralCoin      string `json:"collateralCoin"`
+	LoanTerm            string `json:"loanTerm"`
+}
+
+// VipLoanRepayService https://binance-docs.github.io/apidocs/spot/en/#vip-loan-repay-trade
+type VipLoanRepayService struct {
+	c       *Client
+	orderID int64
+	amount  string
+}
+
+// OrderID set orderId
+func (s *VipLoanRepayService) OrderID(orderID int64) *VipLoanRepayService {
+	s.orderID = orderID
+	return s
+}
+
+// Amount set amount
+func (s *VipLoanRepayService) Amount(amount string) *VipLoanRepayService {
+	s.amount = amount
+	return s
+}
+
+// Do send request
+func (s *VipLoanRepayService) Do(ctx context.Context, opts ...RequestOption) (*VipLoanRepayResponse, error) {
+	r := &request{
+		method:   http.MethodPost,
+		endpoint: "/sapi/v1/loan/vip/repay",
+		secType:  secTypeSigned,
+	}
+	r.setFormParams(params{
+		"orderId": s.orderID,
+		"amount":  s.amount,
+	})
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res := new(VipLoanRepayResponse)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// VipLoanRepayResponse define the response of VipLoanRepayService
+type VipLoanRepayResponse struct {
+	LoanCoin           string `json:"loanCoin"`
+	RepayAmount        string `json:"repayAmount"`
+	RemainingPrincipal string `json:"remainingPrincipal"`
+	RemainingInterest  string `json:"remainingInterest"`
+	CollateralCoin     string `json:"collateralCoin"`
+	CurrentLTV         string `json:"currentLTV"`
+	RepayStatus        string `json:"repayStatus"`
+}
@@ -0,0 +1,153 @@
+package binance
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type vipLoanServiceTestSuite struct {
+	baseTestSuite
+}
+
+func TestVipLoanService(t *testing.T) {
+	suite.Run(t, new(vipLoanServiceTestSuite))
+}
+
+func (s *vipLoanServiceTestSuite) TestOngoingOrders() {
+	data := []byte(`{
+		"rows": [
+			{
+				"orderId": 100000001,
+				"loanCoin": "BUSD",
+				"totalDebt": "10000",
+				"residualInterest": "0.0",
+				"collateralAccountId": "12345678,23456789",
+				"collateralCoin": "BNB,BTC,ETH",
+				"totalCollateralValueAfterHaircut": "25000.27565492",
+				"lockedCollateralValue": "25000.27565492",
+				"currentLTV": "0.4",
+				"expirationTime": 1676851200000,
+				"loanDate": "1676851200000",
+				"loanTerm": "30days"
+			}
+		],
+		"total": 1
+	}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setParams(params{
+			"loanCoin": "BUSD",
+			"current":  1,
+			"limit":    10,
+		})
+		s.assertRequestEqual(e, r)
+	})
+
+	res, err := s.client.NewVipLoanOngoingOrdersService().LoanCoin("BUSD").Current(1).Limit(10).Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	e := &VipLoanOngoingOrders{
+		Rows: []*VipLoanOngoingOrder{
+			{
+				OrderID:                          100000001,
+				LoanCoin:                         "BUSD",
+				TotalDebt:                        "10000",
+				ResidualInterest:                 "0.0",
+				CollateralAccountID:              "12345678,23456789",
+				CollateralCoin:                   "BNB,BTC,ETH",
+				TotalCollateralValueAfterHaircut: "25000.27565492",
+				LockedCollateralValue:            "25000.27565492",
+				CurrentLTV:                       "0.4",
+				ExpirationTime:                   1676851200000,
+				LoanDate:                         "1676851200000",
+				LoanTerm:                         "30days",
+			},
+		},
+		Total: 1,
+	}
+	r.Equal(e, res)
+}
+
+func (s *vipLoanServiceTestSuite) TestBorrow() {
+	data := []byte(`{
+		"loanAccountId": "12345678",
+		"requestId": "12345678",
+		"loanCoin": "BTC",
+		"isFlexibleRate": "No",
+		"loanAmount": "100.55",
+		"collateralAccountId": "12345678,12345679",
+		"collateralCoin": "BUSD,USDT",
+		"loanTerm": "30"
+	}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setFormParams(params{
+			"loanAccountId":       12345678,
+			"loanCoin":            "BTC",
+			"loanAmount":          "100.55",
+			"collateralAccountId": "12345678,12345679",
+			"collateralCoin":      "BUSD,USDT",
+			"isFlexibleRate":      false,
+			"loanTerm":            30,
+		})
+		s.assertRequestEqual(e, r)
+	})
+
+	res, err := s.client.NewVipLoanBorrowService().LoanAccountID(12345678).LoanCoin("BTC").
+		LoanAmount("100.55").CollateralAccountID("12345678,12345679").CollateralCoin("BUSD,USDT").
+		IsFlexibleRate(false).LoanTerm(30).Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	e := &VipLoanBorrowResponse{
+		LoanAccountID:       "12345678",
+		RequestID:           "12345678",
+		LoanCoin:            "BTC",
+		IsFlexibleRate:      "No",
+		LoanAmount:          "100.55",
+		CollateralAccountID: "12345678,12345679",
+		CollateralCoin:      "BUSD,USDT",
+		LoanTerm:            "30",
+	}
+	r.Equal(e, res)
+}
+
+func (s *vipLoanServiceTestSuite) TestRepay() {
+	data := []byte(`{
+		"loanCoin": "BUSD",
+		"repayAmount": "200.5",
+		"remainingPrincipal": "100.5",
+		"remainingInterest": "0",
+		"collateralCoin": "BNB,BTC,ETH",
+		"currentLTV": "0.25",
+		"repayStatus": "Repaid"
+	}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setFormParams(params{
+			"orderId": 100000001,
+			"amount":  "200.5",
+		})
+		s.assertRequestEqual(e, r)
+	})
+
+	res, err := s.client.NewVipLoanRepayService().OrderID(100000001).Amount("200.5").Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	e := &VipLoanRepayResponse{
+		LoanCoin:           "BUSD",
+		RepayAmount:        "200.5",
+		RemainingPrincipal: "100.5",
+		RemainingInterest:  "0",
+		CollateralCoin:     "BNB,BTC,ETH",
+		CurrentLTV:         "0.25",
+		RepayStatus:        "Repaid",
+	}
+	r.Equal(e, res)
+}
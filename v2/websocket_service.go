@@ -50,20 +50,20 @@ type WsPartialDepthEvent struct {
 type WsPartialDepthHandler func(event *WsPartialDepthEvent)
 
 // WsPartialDepthServe serve websocket partial depth handler with a symbol, using 1sec updates
-func WsPartialDepthServe(symbol string, levels string, handler WsPartialDepthHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+func WsPartialDepthServe(symbol string, levels string, handler WsPartialDepthHandler, errHandler ErrHandler, opts ...WsOption) (doneC, stopC chan struct{}, err error) {
 	endpoint := fmt.Sprintf("%s/%s@depth%s", getWsEndpoint(), strings.ToLower(symbol), levels)
-	return wsPartialDepthServe(endpoint, symbol, handler, errHandler)
+	return wsPartialDepthServe(endpoint, symbol, handler, errHandler, opts...)
 }
 
 // WsPartialDepthServe100Ms serve websocket partial depth handler with a symbol, using 100msec updates
-func WsPartialDepthServe100Ms(symbol string, levels string, handler WsPartialDepthHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+func WsPartialDepthServe100Ms(symbol string, levels string, handler WsPartialDepthHandler, errHandler ErrHandler, opts ...WsOption) (doneC, stopC chan struct{}, err error) {
 	endpoint := fmt.Sprintf("%s/%s@depth%s@100ms", getWsEndpoint(), strings.ToLower(symbol), levels)
-	return wsPartialDepthServe(endpoint, symbol, handler, errHandler)
+	return wsPartialDepthServe(endpoint, symbol, handler, errHandler, opts...)
 }
 
 // WsPartialDepthServe serve websocket partial depth handler with a symbol
-func wsPartialDepthServe(endpoint string, symbol string, handler WsPartialDepthHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
-	cfg := newWsConfig(endpoint)
+func wsPartialDepthServe(endpoint string, symbol string, handler WsPartialDepthHandler, errHandler ErrHandler, opts ...WsOption) (doneC, stopC chan struct{}, err error) {
+	cfg := newWsConfig(endpoint, opts...)
 	wsHandler := func(message []byte) {
 		j, err := newJSON(message)
 		if err != nil {
@@ -92,18 +92,19 @@ func wsPartialDepthServe(endpoint string, symbol string, handler WsPartialDepthH
 			}
 		}
 		handler(event)
+		cfg.HandleEvent(message, event)
 	}
 	return wsServe(cfg, wsHandler, errHandler)
 }
 
 // WsCombinedPartialDepthServe is similar to WsPartialDepthServe, but it for multiple symbols
-func WsCombinedPartialDepthServe(symbolLevels map[string]string, handler WsPartialDepthHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+func WsCombinedPartialDepthServe(symbolLevels map[string]string, handler WsPartialDepthHandler, errHandler ErrHandler, opts ...WsOption) (doneC, stopC chan struct{}, err error) {
 	endpoint := getCombinedEndpoint()
 	for s, l := range symbolLevels {
 		endpoint += fmt.Sprintf("%s@depth%s", strings.ToLower(s), l) + "/"
 	}
 	endpoint = endpoint[:len(endpoint)-1]
-	cfg := newWsConfig(endpoint)
+	cfg := newWsConfig(endpoint, opts...)
 	wsHandler := func(message []byte) {
 		j, err := newJSON(message)
 		if err != nil {
@@ -136,6 +137,7 @@ func WsCombinedPartialDepthServe(symbolLevels map[string]string, handler WsParti
 			}
 		}
 		handler(event)
+		cfg.HandleEvent(message, event)
 	}
 	return wsServe(cfg, wsHandler, errHandler)
 }
@@ -144,20 +146,42 @@ func WsCombinedPartialDepthServe(symbolLevels map[string]string, handler WsParti
 type WsDepthHandler func(event *WsDepthEvent)
 
 // WsDepthServe serve websocket depth handler with a symbol, using 1sec updates
-func WsDepthServe(symbol string, handler WsDepthHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+func WsDepthServe(symbol string, handler WsDepthHandler, errHandler ErrHandler, opts ...WsOption) (doneC, stopC chan struct{}, err error) {
 	endpoint := fmt.Sprintf("%s/%s@depth", getWsEndpoint(), strings.ToLower(symbol))
-	return wsDepthServe(endpoint, handler, errHandler)
+	return wsDepthServe(endpoint, handler, errHandler, opts...)
 }
 
 // WsDepthServe100Ms serve websocket depth handler with a symbol, using 100msec updates
-func WsDepthServe100Ms(symbol string, handler WsDepthHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+func WsDepthServe100Ms(symbol string, handler WsDepthHandler, errHandler ErrHandler, opts ...WsOption) (doneC, stopC chan struct{}, err error) {
 	endpoint := fmt.Sprintf("%s/%s@depth@100ms", getWsEndpoint(), strings.ToLower(symbol))
-	return wsDepthServe(endpoint, handler, errHandler)
+	return wsDepthServe(endpoint, handler, errHandler, opts...)
+}
+
+// Depth update speeds of WsDepthServeUpdateSpeed
+const (
+	DepthUpdateSpeed1000Ms = "1000ms"
+	DepthUpdateSpeed100Ms  = "100ms"
+)
+
+// WsDepthServeUpdateSpeed serve websocket depth handler with a symbol, using
+// updates every updateSpeed, DepthUpdateSpeed1000Ms or DepthUpdateSpeed100Ms
+func WsDepthServeUpdateSpeed(symbol string, updateSpeed string, handler WsDepthHandler, errHandler ErrHandler, opts ...WsOption) (doneC, stopC chan struct{}, err error) {
+	var suffix string
+	switch updateSpeed {
+	case DepthUpdateSpeed1000Ms:
+	case DepthUpdateSpeed100Ms:
+		suffix = "@" + updateSpeed
+	default:
+		return nil, nil, fmt.Errorf("invalid depth update speed %q, want %s or %s",
+			updateSpeed, DepthUpdateSpeed1000Ms, DepthUpdateSpeed100Ms)
+	}
+	endpoint := fmt.Sprintf("%s/%s@depth%s", getWsEndpoint(), strings.ToLower(symbol), suffix)
+	return wsDepthServe(endpoint, handler, errHandler, opts...)
 }
 
 // WsDepthServe serve websocket depth handler with an arbitrary endpoint address
-func wsDepthServe(endpoint string, handler WsDepthHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
-	cfg := newWsConfig(endpoint)
+func wsDepthServe(endpoint string, handler WsDepthHandler, errHandler ErrHandler, opts ...WsOption) (doneC, stopC chan struct{}, err error) {
+	cfg := newWsConfig(endpoint, opts...)
 	wsHandler := func(message []byte) {
 		j, err := newJSON(message)
 		if err != nil {
@@ -189,6 +213,7 @@ func wsDepthServe(endpoint string, handler WsDepthHandler, errHandler ErrHandler
 			}
 		}
 		handler(event)
+		cfg.HandleEvent(message, event)
 	}
 	return wsServe(cfg, wsHandler, errHandler)
 }
@@ -205,26 +230,26 @@ type WsDepthEvent struct {
 }
 
 // WsCombinedDepthServe is similar to WsDepthServe, but it for multiple symbols
-func WsCombinedDepthServe(symbols []string, handler WsDepthHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+func WsCombinedDepthServe(symbols []string, handler WsDepthHandler, errHandler ErrHandler, opts ...WsOption) (doneC, stopC chan struct{}, err error) {
 	endpoint := getCombinedEndpoint()
 	for _, s := range symbols {
 		endpoint += fmt.Sprintf("%s@depth", strings.ToLower(s)) + "/"
 	}
 	endpoint = endpoint[:len(endpoint)-1]
-	return wsCombinedDepthServe(endpoint, handler, errHandler)
+	return wsCombinedDepthServe(endpoint, handler, errHandler, opts...)
 }
 
-func WsCombinedDepthServe100Ms(symbols []string, handler WsDepthHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+func WsCombinedDepthServe100Ms(symbols []string, handler WsDepthHandler, errHandler ErrHandler, opts ...WsOption) (doneC, stopC chan struct{}, err error) {
 	endpoint := getCombinedEndpoint()
 	for _, s := range symbols {
 		endpoint += fmt.Sprintf("%s@depth@100ms", strings.ToLower(s)) + "/"
 	}
 	endpoint = endpoint[:len(endpoint)-1]
-	return wsCombinedDepthServe(endpoint, handler, errHandler)
+	return wsCombinedDepthServe(endpoint, handler, errHandler, opts...)
 }
 
-func wsCombinedDepthServe(endpoint string, handler WsDepthHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
-	cfg := newWsConfig(endpoint)
+func wsCombinedDepthServe(endpoint string, handler WsDepthHandler, errHandler ErrHandler, opts ...WsOption) (doneC, stopC chan struct{}, err error) {
+	cfg := newWsConfig(endpoint, opts...)
 	wsHandler := func(message []byte) {
 		j, err := newJSON(message)
 		if err != nil {
@@ -259,6 +284,7 @@ func wsCombinedDepthServe(endpoint string, handler WsDepthHandler, errHandler Er
 			}
 		}
 		handler(event)
+		cfg.HandleEvent(message, event)
 	}
 	return wsServe(cfg, wsHandler, errHandler)
 }
@@ -267,13 +293,13 @@ func wsCombinedDepthServe(endpoint string, handler WsDepthHandler, errHandler Er
 type WsKlineHandler func(event *WsKlineEvent)
 
 // WsCombinedKlineServe is similar to WsKlineServe, but it handles multiple symbols with it interval
-func WsCombinedKlineServe(symbolIntervalPair map[string]string, handler WsKlineHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+func WsCombinedKlineServe(symbolIntervalPair map[string]string, handler WsKlineHandler, errHandler ErrHandler, opts ...WsOption) (doneC, stopC chan struct{}, err error) {
 	endpoint := getCombinedEndpoint()
 	for symbol, interval := range symbolIntervalPair {
 		endpoint += fmt.Sprintf("%s@kline_%s", strings.ToLower(symbol), interval) + "/"
 	}
 	endpoint = endpoint[:len(endpoint)-1]
-	cfg := newWsConfig(endpoint)
+	cfg := newWsConfig(endpoint, opts...)
 	wsHandler := func(message []byte) {
 		j, err := newJSON(message)
 		if err != nil {
@@ -297,14 +323,15 @@ func WsCombinedKlineServe(symbolIntervalPair map[string]string, handler WsKlineH
 		event.Symbol = strings.ToUpper(symbol)
 
 		handler(event)
+		cfg.HandleEvent(message, event)
 	}
 	return wsServe(cfg, wsHandler, errHandler)
 }
 
 // WsKlineServe serve websocket kline handler with a symbol and interval like 15m, 30s
-func WsKlineServe(symbol string, interval string, handler WsKlineHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+func WsKlineServe(symbol string, interval string, handler WsKlineHandler, errHandler ErrHandler, opts ...WsOption) (doneC, stopC chan struct{}, err error) {
 	endpoint := fmt.Sprintf("%s/%s@kline_%s", getWsEndpoint(), strings.ToLower(symbol), interval)
-	cfg := newWsConfig(endpoint)
+	cfg := newWsConfig(endpoint, opts...)
 	wsHandler := func(message []byte) {
 		event := new(WsKlineEvent)
 		err := json.Unmarshal(message, event)
@@ -313,6 +340,7 @@ func WsKlineServe(symbol string, interval string, handler WsKlineHandler, errHan
 			return
 		}
 		handler(event)
+		cfg.HandleEvent(message, event)
 	}
 	return wsServe(cfg, wsHandler, errHandler)
 }
@@ -349,9 +377,9 @@ type WsKline struct {
 type WsAggTradeHandler func(event *WsAggTradeEvent)
 
 // WsAggTradeServe serve websocket aggregate handler with a symbol
-func WsAggTradeServe(symbol string, handler WsAggTradeHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+func WsAggTradeServe(symbol string, handler WsAggTradeHandler, errHandler ErrHandler, opts ...WsOption) (doneC, stopC chan struct{}, err error) {
 	endpoint := fmt.Sprintf("%s/%s@aggTrade", getWsEndpoint(), strings.ToLower(symbol))
-	cfg := newWsConfig(endpoint)
+	cfg := newWsConfig(endpoint, opts...)
 	wsHandler := func(message []byte) {
 		event := new(WsAggTradeEvent)
 		err := json.Unmarshal(message, event)
@@ -360,18 +388,19 @@ func WsAggTradeServe(symbol string, handler WsAggTradeHandler, errHandler ErrHan
 			return
 		}
 		handler(event)
+		cfg.HandleEvent(message, event)
 	}
 	return wsServe(cfg, wsHandler, errHandler)
 }
 
 // WsCombinedAggTradeServe is similar to WsAggTradeServe, but it handles multiple symbolx
-func WsCombinedAggTradeServe(symbols []string, handler WsAggTradeHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+func WsCombinedAggTradeServe(symbols []string, handler WsAggTradeHandler, errHandler ErrHandler, opts ...WsOption) (doneC, stopC chan struct{}, err error) {
 	endpoint := getCombinedEndpoint()
 	for s := range symbols {
 		endpoint += fmt.Sprintf("%s@aggTrade", strings.ToLower(symbols[s])) + "/"
 	}
 	endpoint = endpoint[:len(endpoint)-1]
-	cfg := newWsConfig(endpoint)
+	cfg := newWsConfig(endpoint, opts...)
 	wsHandler := func(message []byte) {
 		j, err := newJSON(message)
 		if err != nil {
@@ -396,6 +425,7 @@ func WsCombinedAggTradeServe(symbols []string, handler WsAggTradeHandler, errHan
 		event.Symbol = strings.ToUpper(symbol)
 
 		handler(event)
+		cfg.HandleEvent(message, event)
 	}
 	return wsServe(cfg, wsHandler, errHandler)
 }
@@ -419,9 +449,9 @@ type WsAggTradeEvent struct {
 type WsTradeHandler func(event *WsTradeEvent)
 
 // WsTradeServe serve websocket handler with a symbol
-func WsTradeServe(symbol string, handler WsTradeHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+func WsTradeServe(symbol string, handler WsTradeHandler, errHandler ErrHandler, opts ...WsOption) (doneC, stopC chan struct{}, err error) {
 	endpoint := fmt.Sprintf("%s/%s@trade", getWsEndpoint(), strings.ToLower(symbol))
-	cfg := newWsConfig(endpoint)
+	cfg := newWsConfig(endpoint, opts...)
 	wsHandler := func(message []byte) {
 		event := new(WsTradeEvent)
 		err := json.Unmarshal(message, event)
@@ -430,6 +460,7 @@ func WsTradeServe(symbol string, handler WsTradeHandler, errHandler ErrHandler)
 			return
 		}
 		handler(event)
+		cfg.HandleEvent(message, event)
 	}
 	return wsServe(cfg, wsHandler, errHandler)
 }
@@ -524,10 +555,38 @@ type WsOCOOrder struct {
 // WsUserDataHandler handle WsUserDataEvent
 type WsUserDataHandler func(event *WsUserDataEvent)
 
+// WsUserDataOption define option of user data stream
+type WsUserDataOption func(*wsUserDataOptions)
+
+type wsUserDataOptions struct {
+	onExpired func()
+	wsOptions []WsOption
+}
+
+// WithOnExpired set the callback fired when a listenKeyExpired event is
+// received. The stream is dead after that event, so the callback is the place
+// to create a new listen key and serve a new stream.
+func WithOnExpired(f func()) WsUserDataOption {
+	return func(o *wsUserDataOptions) {
+		o.onExpired = f
+	}
+}
+
+// WithUserDataWsOptions set the options of the user data stream connection
+func WithUserDataWsOptions(opts ...WsOption) WsUserDataOption {
+	return func(o *wsUserDataOptions) {
+		o.wsOptions = append(o.wsOptions, opts...)
+	}
+}
+
 // WsUserDataServe serve user data handler with listen key
-func WsUserDataServe(listenKey string, handler WsUserDataHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+func WsUserDataServe(listenKey string, handler WsUserDataHandler, errHandler ErrHandler, opts ...WsUserDataOption) (doneC, stopC chan struct{}, err error) {
+	options := new(wsUserDataOptions)
+	for _, opt := range opts {
+		opt(options)
+	}
 	endpoint := fmt.Sprintf("%s/%s", getWsEndpoint(), listenKey)
-	cfg := newWsConfig(endpoint)
+	cfg := newWsConfig(endpoint, options.wsOptions...)
 	wsHandler := func(message []byte) {
 		j, err := newJSON(message)
 		if err != nil {
@@ -573,6 +632,10 @@ func WsUserDataServe(listenKey string, handler WsUserDataHandler, errHandler Err
 		}
 
 		handler(event)
+		cfg.HandleEvent(message, event)
+		if event.Event == UserDataEventTypeListenKeyExpired && options.onExpired != nil {
+			options.onExpired()
+		}
 	}
 	return wsServe(cfg, wsHandler, errHandler)
 }
@@ -581,13 +644,13 @@ func WsUserDataServe(listenKey string, handler WsUserDataHandler, errHandler Err
 type WsMarketStatHandler func(event *WsMarketStatEvent)
 
 // WsCombinedMarketStatServe is similar to WsMarketStatServe, but it handles multiple symbolx
-func WsCombinedMarketStatServe(symbols []string, handler WsMarketStatHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+func WsCombinedMarketStatServe(symbols []string, handler WsMarketStatHandler, errHandler ErrHandler, opts ...WsOption) (doneC, stopC chan struct{}, err error) {
 	endpoint := getCombinedEndpoint()
 	for s := range symbols {
 		endpoint += fmt.Sprintf("%s@ticker", strings.ToLower(symbols[s])) + "/"
 	}
 	endpoint = endpoint[:len(endpoint)-1]
-	cfg := newWsConfig(endpoint)
+	cfg := newWsConfig(endpoint, opts...)
 
 	wsHandler := func(message []byte) {
 		j, err := newJSON(message)
@@ -613,14 +676,15 @@ func WsCombinedMarketStatServe(symbols []string, handler WsMarketStatHandler, er
 		event.Symbol = strings.ToUpper(symbol)
 
 		handler(event)
+		cfg.HandleEvent(message, event)
 	}
 	return wsServe(cfg, wsHandler, errHandler)
 }
 
 // WsMarketStatServe serve websocket that push 24hr statistics for single market every second
-func WsMarketStatServe(symbol string, handler WsMarketStatHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+func WsMarketStatServe(symbol string, handler WsMarketStatHandler, errHandler ErrHandler, opts ...WsOption) (doneC, stopC chan struct{}, err error) {
 	endpoint := fmt.Sprintf("%s/%s@ticker", getWsEndpoint(), strings.ToLower(symbol))
-	cfg := newWsConfig(endpoint)
+	cfg := newWsConfig(endpoint, opts...)
 	wsHandler := func(message []byte) {
 		var event WsMarketStatEvent
 		err := json.Unmarshal(message, &event)
@@ -629,6 +693,7 @@ func WsMarketStatServe(symbol string, handler WsMarketStatHandler, errHandler Er
 			return
 		}
 		handler(&event)
+		cfg.HandleEvent(message, &event)
 	}
 	return wsServe(cfg, wsHandler, errHandler)
 }
@@ -637,9 +702,9 @@ func WsMarketStatServe(symbol string, handler WsMarketStatHandler, errHandler Er
 type WsAllMarketsStatHandler func(event WsAllMarketsStatEvent)
 
 // WsAllMarketsStatServe serve websocket that push 24hr statistics for all market every second
-func WsAllMarketsStatServe(handler WsAllMarketsStatHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+func WsAllMarketsStatServe(handler WsAllMarketsStatHandler, errHandler ErrHandler, opts ...WsOption) (doneC, stopC chan struct{}, err error) {
 	endpoint := fmt.Sprintf("%s/!ticker@arr", getWsEndpoint())
-	cfg := newWsConfig(endpoint)
+	cfg := newWsConfig(endpoint, opts...)
 	wsHandler := func(message []byte) {
 		var event WsAllMarketsStatEvent
 		err := json.Unmarshal(message, &event)
@@ -648,6 +713,69 @@ func WsAllMarketsStatServe(handler WsAllMarketsStatHandler, errHandler ErrHandle
 			return
 		}
 		handler(event)
+		cfg.HandleEvent(message, event)
+	}
+	return wsServe(cfg, wsHandler, errHandler)
+}
+
+// WsAllMarketTickerOption define option of all market ticker stream
+type WsAllMarketTickerOption func(*wsAllMarketTickerOptions)
+
+type wsAllMarketTickerOptions struct {
+	symbols   map[string]bool
+	wsOptions []WsOption
+}
+
+// WithTickerSymbols deliver only the tickers of symbols. Frames without any
+// of them are not delivered at all.
+func WithTickerSymbols(symbols ...string) WsAllMarketTickerOption {
+	return func(o *wsAllMarketTickerOptions) {
+		o.symbols = make(map[string]bool, len(symbols))
+		for _, symbol := range symbols {
+			o.symbols[strings.ToUpper(symbol)] = true
+		}
+	}
+}
+
+// WithTickerWsOptions set the options of the all market ticker stream
+// connection
+func WithTickerWsOptions(opts ...WsOption) WsAllMarketTickerOption {
+	return func(o *wsAllMarketTickerOptions) {
+		o.wsOptions = append(o.wsOptions, opts...)
+	}
+}
+
+// WsAllMarketTickerServe serve websocket that push 24hr statistics for all
+// market every second, like WsAllMarketsStatServe, but the tickers can be
+// filtered client side with WithTickerSymbols
+func WsAllMarketTickerServe(handler WsAllMarketsStatHandler, errHandler ErrHandler, opts ...WsAllMarketTickerOption) (doneC, stopC chan struct{}, err error) {
+	options := new(wsAllMarketTickerOptions)
+	for _, opt := range opts {
+		opt(options)
+	}
+	endpoint := fmt.Sprintf("%s/!ticker@arr", getWsEndpoint())
+	cfg := newWsConfig(endpoint, options.wsOptions...)
+	wsHandler := func(message []byte) {
+		var event WsAllMarketsStatEvent
+		err := json.Unmarshal(message, &event)
+		if err != nil {
+			errHandler(err)
+			return
+		}
+		if options.symbols != nil {
+			filtered := event[:0]
+			for _, e := range event {
+				if options.symbols[e.Symbol] {
+					filtered = append(filtered, e)
+				}
+			}
+			if len(filtered) == 0 {
+				return
+			}
+			event = filtered
+		}
+		handler(event)
+		cfg.HandleEvent(message, event)
 	}
 	return wsServe(cfg, wsHandler, errHandler)
 }
@@ -686,9 +814,9 @@ type WsMarketStatEvent struct {
 type WsAllMiniMarketsStatServeHandler func(event WsAllMiniMarketsStatEvent)
 
 // WsAllMiniMarketsStatServe serve websocket that push mini version of 24hr statistics for all market every second
-func WsAllMiniMarketsStatServe(handler WsAllMiniMarketsStatServeHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+func WsAllMiniMarketsStatServe(handler WsAllMiniMarketsStatServeHandler, errHandler ErrHandler, opts ...WsOption) (doneC, stopC chan struct{}, err error) {
 	endpoint := fmt.Sprintf("%s/!miniTicker@arr", getWsEndpoint())
-	cfg := newWsConfig(endpoint)
+	cfg := newWsConfig(endpoint, opts...)
 	wsHandler := func(message []byte) {
 		var event WsAllMiniMarketsStatEvent
 		err := json.Unmarshal(message, &event)
@@ -697,6 +825,7 @@ func WsAllMiniMarketsStatServe(handler WsAllMiniMarketsStatServeHandler, errHand
 			return
 		}
 		handler(event)
+		cfg.HandleEvent(message, event)
 	}
 	return wsServe(cfg, wsHandler, errHandler)
 }
@@ -731,9 +860,9 @@ type WsBookTickerEvent struct {
 type WsBookTickerHandler func(event *WsBookTickerEvent)
 
 // WsBookTickerServe serve websocket that pushes updates to the best bid or ask price or quantity in real-time for a specified symbol.
-func WsBookTickerServe(symbol string, handler WsBookTickerHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+func WsBookTickerServe(symbol string, handler WsBookTickerHandler, errHandler ErrHandler, opts ...WsOption) (doneC, stopC chan struct{}, err error) {
 	endpoint := fmt.Sprintf("%s/%s@bookTicker", getWsEndpoint(), strings.ToLower(symbol))
-	cfg := newWsConfig(endpoint)
+	cfg := newWsConfig(endpoint, opts...)
 	wsHandler := func(message []byte) {
 		event := new(WsBookTickerEvent)
 		err := json.Unmarshal(message, &event)
@@ -742,14 +871,15 @@ func WsBookTickerServe(symbol string, handler WsBookTickerHandler, errHandler Er
 			return
 		}
 		handler(event)
+		cfg.HandleEvent(message, event)
 	}
 	return wsServe(cfg, wsHandler, errHandler)
 }
 
 // WsAllBookTickerServe serve websocket that pushes updates to the best bid or ask price or quantity in real-time for all symbols.
-func WsAllBookTickerServe(handler WsBookTickerHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+func WsAllBookTickerServe(handler WsBookTickerHandler, errHandler ErrHandler, opts ...WsOption) (doneC, stopC chan struct{}, err error) {
 	endpoint := fmt.Sprintf("%s/!bookTicker", getWsEndpoint())
-	cfg := newWsConfig(endpoint)
+	cfg := newWsConfig(endpoint, opts...)
 	wsHandler := func(message []byte) {
 		event := new(WsBookTickerEvent)
 		err := json.Unmarshal(message, &event)
@@ -758,6 +888,7 @@ func WsAllBookTickerServe(handler WsBookTickerHandler, errHandler ErrHandler) (d
 			return
 		}
 		handler(event)
+		cfg.HandleEvent(message, event)
 	}
 	return wsServe(cfg, wsHandler, errHandler)
 }
@@ -1,6 +1,7 @@
 package binance
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
 	"testing"
@@ -294,6 +295,25 @@ func (s *websocketServiceTestSuite) TestDepthServe() {
 	<-doneC
 }
 
+func (s *websocketServiceTestSuite) TestDepthServeUpdateSpeed() {
+	var endpoints []string
+	wsServe = func(cfg *WsConfig, handler WsHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+		endpoints = append(endpoints, cfg.Endpoint)
+		return make(chan struct{}), make(chan struct{}), nil
+	}
+	r := s.r()
+	_, _, err := WsDepthServeUpdateSpeed("ETHBTC", DepthUpdateSpeed100Ms, func(*WsDepthEvent) {}, func(error) {})
+	r.NoError(err)
+	_, _, err = WsDepthServeUpdateSpeed("ETHBTC", DepthUpdateSpeed1000Ms, func(*WsDepthEvent) {}, func(error) {})
+	r.NoError(err)
+	_, _, err = WsDepthServeUpdateSpeed("ETHBTC", "250ms", func(*WsDepthEvent) {}, func(error) {})
+	r.Error(err)
+	r.Equal([]string{
+		getWsEndpoint() + "/ethbtc@depth@100ms",
+		getWsEndpoint() + "/ethbtc@depth",
+	}, endpoints)
+}
+
 func (s *websocketServiceTestSuite) TestDepthServe100Ms() {
 	data := []byte(`{
         "e": "depthUpdate",
@@ -795,6 +815,7 @@ func (s *websocketServiceTestSuite) assertUserDataEvent(e, a *WsUserDataEvent) {
 	}
 	s.assertOrderUpdate(&e.OrderUpdate, &a.OrderUpdate)
 	s.assertBalanceUpdate(&e.BalanceUpdate, &a.BalanceUpdate)
+	r.Equal(e.OCOUpdate, a.OCOUpdate, "OCOUpdate")
 }
 
 func (s *websocketServiceTestSuite) testWsUserDataServe(data []byte, expectedEvent *WsUserDataEvent) {
@@ -914,6 +935,98 @@ func (s *websocketServiceTestSuite) TestWsUserDataServeOrderUpdate() {
 	s.testWsUserDataServe(data, expectedEvent)
 }
 
+func (s *websocketServiceTestSuite) TestWsUserDataServeOnExpired() {
+	data := []byte(`{
+		"e": "listenKeyExpired",
+		"E": 1576653824250
+	}`)
+	s.mockWsServe(data, nil)
+	defer s.assertWsServe()
+
+	expired := 0
+	var event *WsUserDataEvent
+	doneC, stopC, err := WsUserDataServe("fakeListenKey", func(e *WsUserDataEvent) {
+		event = e
+	}, func(err error) {
+		s.r().FailNow("unexpected error", err)
+	}, WithOnExpired(func() {
+		expired++
+	}))
+
+	r := s.r()
+	r.NoError(err)
+	r.Equal(1, expired)
+	r.Equal(UserDataEventTypeListenKeyExpired, event.Event)
+	stopC <- struct{}{}
+	<-doneC
+}
+
+func (s *websocketServiceTestSuite) TestWsUserDataServeBalanceUpdate() {
+	data := []byte(`{
+	   "e":"balanceUpdate",
+	   "E":1573200697110,
+	   "a":"BTC",
+	   "d":"100.00000000",
+	   "T":1573200697068
+	}`)
+	expectedEvent := &WsUserDataEvent{
+		Event:           "balanceUpdate",
+		Time:            1573200697110,
+		TransactionTime: 1573200697068,
+		BalanceUpdate: WsBalanceUpdate{
+			Asset:  "BTC",
+			Change: "100.00000000",
+		},
+	}
+	s.testWsUserDataServe(data, expectedEvent)
+}
+
+func (s *websocketServiceTestSuite) TestWsUserDataServeOCOUpdate() {
+	data := []byte(`{
+	   "e":"listStatus",
+	   "E":1564035303637,
+	   "s":"ETHBTC",
+	   "g":2,
+	   "c":"OCO",
+	   "l":"EXEC_STARTED",
+	   "L":"EXECUTING",
+	   "r":"NONE",
+	   "C":"F4QN4G8DlFATFlIUQ0cjdD",
+	   "T":1564035303625,
+	   "O":[
+	      {
+	         "s":"ETHBTC",
+	         "i":17,
+	         "c":"AJYsMjErWJesZvqlJCTUgL"
+	      },
+	      {
+	         "s":"ETHBTC",
+	         "i":18,
+	         "c":"bfYPSQdLoqAJeNrOr9adzq"
+	      }
+	   ]
+	}`)
+	expectedEvent := &WsUserDataEvent{
+		Event:           "listStatus",
+		Time:            1564035303637,
+		TransactionTime: 1564035303625,
+		OCOUpdate: WsOCOUpdate{
+			Symbol:          "ETHBTC",
+			OrderListId:     2,
+			ContingencyType: "OCO",
+			ListStatusType:  "EXEC_STARTED",
+			ListOrderStatus: "EXECUTING",
+			RejectReason:    "NONE",
+			ClientOrderId:   "F4QN4G8DlFATFlIUQ0cjdD",
+			Orders: []WsOCOOrder{
+				{Symbol: "ETHBTC", OrderId: 17, ClientOrderId: "AJYsMjErWJesZvqlJCTUgL"},
+				{Symbol: "ETHBTC", OrderId: 18, ClientOrderId: "bfYPSQdLoqAJeNrOr9adzq"},
+			},
+		},
+	}
+	s.testWsUserDataServe(data, expectedEvent)
+}
+
 func (s *websocketServiceTestSuite) TestWsMarketStatServe() {
 	data := []byte(`{
   		"e": "24hrTicker",
@@ -1074,6 +1187,71 @@ func (s *websocketServiceTestSuite) assertWsMarketStatEventEqual(e, a *WsMarketS
 	r.Equal(e.Symbol, a.Symbol, "Symbol")
 }
 
+func (s *websocketServiceTestSuite) TestWsAllMarketTickerServe() {
+	data := []byte(`[
+		{"e": "24hrTicker", "E": 123456789, "s": "BNBBTC", "c": "0.0025"},
+		{"e": "24hrTicker", "E": 123456789, "s": "ETHBTC", "c": "0.0700"},
+		{"e": "24hrTicker", "E": 123456789, "s": "BTCUSDT", "c": "27000.00"}
+	]`)
+	s.mockWsServe(data, nil)
+	defer s.assertWsServe()
+
+	var events []WsAllMarketsStatEvent
+	doneC, stopC, err := WsAllMarketTickerServe(func(event WsAllMarketsStatEvent) {
+		events = append(events, event)
+	}, func(err error) {
+		s.r().FailNow("unexpected error", "%v", err)
+	})
+	s.r().NoError(err)
+	stopC <- struct{}{}
+	<-doneC
+
+	s.r().Len(events, 1)
+	s.r().Len(events[0], 3)
+}
+
+func (s *websocketServiceTestSuite) TestWsAllMarketTickerServeSymbols() {
+	data := []byte(`[
+		{"e": "24hrTicker", "E": 123456789, "s": "BNBBTC", "c": "0.0025"},
+		{"e": "24hrTicker", "E": 123456789, "s": "ETHBTC", "c": "0.0700"},
+		{"e": "24hrTicker", "E": 123456789, "s": "BTCUSDT", "c": "27000.00"}
+	]`)
+	s.mockWsServe(data, nil)
+	defer s.assertWsServe()
+
+	var events []WsAllMarketsStatEvent
+	doneC, stopC, err := WsAllMarketTickerServe(func(event WsAllMarketsStatEvent) {
+		events = append(events, event)
+	}, func(err error) {
+		s.r().FailNow("unexpected error", "%v", err)
+	}, WithTickerSymbols("btcusdt", "BNBBTC"))
+	s.r().NoError(err)
+	stopC <- struct{}{}
+	<-doneC
+
+	s.r().Len(events, 1)
+	s.r().Len(events[0], 2)
+	s.r().Equal("BNBBTC", events[0][0].Symbol)
+	s.r().Equal("0.0025", events[0][0].LastPrice)
+	s.r().Equal("BTCUSDT", events[0][1].Symbol)
+	s.r().Equal("27000.00", events[0][1].LastPrice)
+}
+
+func (s *websocketServiceTestSuite) TestWsAllMarketTickerServeNoMatch() {
+	data := []byte(`[{"e": "24hrTicker", "E": 123456789, "s": "BNBBTC", "c": "0.0025"}]`)
+	s.mockWsServe(data, nil)
+	defer s.assertWsServe()
+
+	doneC, stopC, err := WsAllMarketTickerServe(func(event WsAllMarketsStatEvent) {
+		s.r().FailNow("unexpected event", "%v", event)
+	}, func(err error) {
+		s.r().FailNow("unexpected error", "%v", err)
+	}, WithTickerSymbols("ETHBTC"))
+	s.r().NoError(err)
+	stopC <- struct{}{}
+	<-doneC
+}
+
 func (s *websocketServiceTestSuite) TestWsAllMarketsStatServe() {
 	data := []byte(`[{
   		"e": "24hrTicker",
@@ -1236,6 +1414,35 @@ func (s *websocketServiceTestSuite) TestWsTradeServe() {
 	<-doneC
 }
 
+func (s *websocketServiceTestSuite) TestWsTradeServeEventHandler() {
+	data := []byte(`{"e":"trade","E":123456789,"s":"BNBBTC","t":12345,"p":"0.001","q":"100","X":"MARKET"}`)
+	s.mockWsServe(data, nil)
+	defer s.assertWsServe()
+
+	var wsEvent *WsEvent
+	var typed *WsTradeEvent
+	doneC, stopC, err := WsTradeServe("BNBBTC", func(event *WsTradeEvent) {
+		typed = event
+	}, func(err error) {}, WithWsEventHandler(func(event *WsEvent) {
+		wsEvent = event
+	}))
+	r := s.r()
+	r.NoError(err)
+	stopC <- struct{}{}
+	<-doneC
+
+	r.NotNil(wsEvent)
+	r.Equal(typed, wsEvent.Event)
+	r.Equal("0.001", wsEvent.Event.(*WsTradeEvent).Price)
+	r.JSONEq(string(data), string(wsEvent.Raw))
+	// fields not modeled by WsTradeEvent are still available
+	var extra struct {
+		Type string `json:"X"`
+	}
+	r.NoError(json.Unmarshal(wsEvent.Raw, &extra))
+	r.Equal("MARKET", extra.Type)
+}
+
 func (s *websocketServiceTestSuite) assertWsTradeEventEqual(e, a *WsTradeEvent) {
 	r := s.r()
 	r.Equal(e.Event, a.Event, "Event")
@@ -0,0 +1,60 @@
+package binance
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"time"
+
+	"github.com/gorilla/websocket"
+)
+
+// wsTestHandler upgrade every request to a websocket connection running serve
+func wsTestHandler(serve func(c *websocket.Conn)) http.Handler {
+	upgrader := websocket.Upgrader{}
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		c, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		defer c.Close()
+		serve(c)
+	})
+}
+
+// newWsTestServer start a websocket server running serve on every connection
+func newWsTestServer(serve func(c *websocket.Conn)) (srv *httptest.Server, endpoint string) {
+	srv = httptest.NewServer(wsTestHandler(serve))
+	return srv, "ws" + strings.TrimPrefix(srv.URL, "http")
+}
+
+func (s *websocketServiceTestSuite) TestWsOptionsPerServeCall() {
+	var configs []*WsConfig
+	wsServe = func(cfg *WsConfig, handler WsHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+		configs = append(configs, cfg)
+		return make(chan struct{}), make(chan struct{}), nil
+	}
+
+	r := s.r()
+	_, _, err := WsDepthServe100Ms("BNBBTC", func(event *WsDepthEvent) {}, func(err error) {},
+		WithWsReadTimeout(time.Second), WithWsKeepalive(time.Minute))
+	r.NoError(err)
+	_, _, err = WsTradeServe("BNBBTC", func(event *WsTradeEvent) {}, func(err error) {})
+	r.NoError(err)
+	_, _, err = WsUserDataServe("listenKey", func(event *WsUserDataEvent) {}, func(err error) {},
+		WithUserDataWsOptions(WithWsReadTimeout(2*time.Second)))
+	r.NoError(err)
+	_, _, err = WsAllMarketTickerServe(func(event WsAllMarketsStatEvent) {}, func(err error) {},
+		WithTickerSymbols("BNBBTC"), WithTickerWsOptions(WithWsReadTimeout(3*time.Second)))
+	r.NoError(err)
+
+	r.Len(configs, 4)
+	r.Equal(time.Second, configs[0].ReadTimeout)
+	r.True(configs[0].Keepalive)
+	r.Equal(time.Minute, configs[0].Timeout)
+	// options of a serve call do not leak into the others
+	r.Zero(configs[1].ReadTimeout)
+	r.Equal(WebsocketKeepalive, configs[1].Keepalive)
+	r.Equal(2*time.Second, configs[2].ReadTimeout)
+	r.Equal(3*time.Second, configs[3].ReadTimeout)
+}
@@ -3,7 +3,9 @@ package binance
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"net/http"
+	"time"
 )
 
 // CreateWithdrawService submits a withdraw request.
@@ -152,6 +154,11 @@ func (s *ListWithdrawsService) StartTime(startTime int64) *ListWithdrawsService
 	return s
 }
 
+// StartTimeFrom set startTime from t, converted to epoch milliseconds
+func (s *ListWithdrawsService) StartTimeFrom(t time.Time) *ListWithdrawsService {
+	return s.StartTime(FormatTimestamp(t))
+}
+
 // EndTime sets the endTime parameter.
 // If present, StartTime MUST be specified. The difference between EndTime - StartTime MUST be between 0-90 days.
 func (s *ListWithdrawsService) EndTime(endTime int64) *ListWithdrawsService {
@@ -159,6 +166,11 @@ func (s *ListWithdrawsService) EndTime(endTime int64) *ListWithdrawsService {
 	return s
 }
 
+// EndTimeFrom set endTime from t, converted to epoch milliseconds
+func (s *ListWithdrawsService) EndTimeFrom(t time.Time) *ListWithdrawsService {
+	return s.EndTime(FormatTimestamp(t))
+}
+
 // Offset set offset
 func (s *ListWithdrawsService) Offset(offset int) *ListWithdrawsService {
 	s.offset = &offset
@@ -227,3 +239,48 @@ type Withdraw struct {
 	Info            string `json:"info"`
 	TxID            string `json:"txId"`
 }
+
+// IsFinal reports whether the withdraw reached a terminal status
+func (w *Withdraw) IsFinal() bool {
+	switch WithdrawStatusType(w.Status) {
+	case WithdrawStatusTypeCancelled, WithdrawStatusTypeRejected,
+		WithdrawStatusTypeFailure, WithdrawStatusTypeCompleted:
+		return true
+	}
+	return false
+}
+
+// WithdrawAndWait submits the withdraw built by s and polls the withdraw history
+// every pollInterval until the withdraw reaches a final status, which is returned.
+// Polling stops with an error when ctx is done or after maxAttempts polls,
+// a maxAttempts <= 0 means no limit.
+func (c *Client) WithdrawAndWait(ctx context.Context, s *CreateWithdrawService, pollInterval time.Duration, maxAttempts int) (*Withdraw, error) {
+	created, err := s.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var last *Withdraw
+	for attempt := 0; maxAttempts <= 0 || attempt < maxAttempts; attempt++ {
+		if attempt > 0 {
+			select {
+			case <-ctx.Done():
+				return last, ctx.Err()
+			case <-time.After(pollInterval):
+			}
+		}
+		withdraws, err := c.NewListWithdrawsService().Coin(s.coin).Do(ctx)
+		if err != nil {
+			return last, err
+		}
+		for _, w := range withdraws {
+			if w.ID == created.ID {
+				last = w
+				break
+			}
+		}
+		if last != nil && last.IsFinal() {
+			return last, nil
+		}
+	}
+	return last, fmt.Errorf("withdraw %s not final after %d attempts", created.ID, maxAttempts)
+}
@@ -2,6 +2,7 @@ package binance
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/suite"
 )
@@ -159,6 +160,32 @@ func (s *withdrawServiceTestSuite) TestListWithdraws() {
 	}, withdraws[1])
 }
 
+func (s *withdrawServiceTestSuite) TestWithdrawAndWait() {
+	created := []byte(`{"id":"7213fea8e94b4a5593d507237e5a555b"}`)
+	processing := []byte(`[
+		{"coin": "USDT", "id": "7213fea8e94b4a5593d507237e5a555b", "status": 4}
+	]`)
+	completed := []byte(`[
+		{"coin": "USDT", "id": "7213fea8e94b4a5593d507237e5a555b", "status": 6, "txId": "0xb5ef"}
+	]`)
+	s.client.Client.do = s.client.do
+	s.client.On("do", anyHTTPRequest()).Return(newHTTPResponse(created, 200), nil).Once()
+	s.client.On("do", anyHTTPRequest()).Return(newHTTPResponse(processing, 200), nil).Once()
+	s.client.On("do", anyHTTPRequest()).Return(newHTTPResponse(completed, 200), nil).Once()
+	defer s.assertDo()
+
+	service := s.client.NewCreateWithdrawService().
+		Coin("USDT").
+		Address("myaddress").
+		Amount("0.01")
+	res, err := s.client.WithdrawAndWait(newContext(), service, time.Millisecond, 5)
+	r := s.r()
+	r.NoError(err)
+	r.Equal(int(WithdrawStatusTypeCompleted), res.Status)
+	r.Equal("0xb5ef", res.TxID)
+	s.client.AssertNumberOfCalls(s.T(), "do", 3)
+}
+
 func (s *withdrawServiceTestSuite) assertWithdrawEqual(e, a *Withdraw) {
 	r := s.r()
 	r.Equal(e.Address, a.Address, "Address")